@@ -0,0 +1,231 @@
+package oid
+
+/*
+allocate.go deals with the reservation of numeric ranges beneath a base arc, and the allocation of new arcs from them.
+*/
+
+import "sort"
+
+/*
+Reservation describes a range of number forms, Low through High inclusive, set aside beneath a base arc for a particular purpose, e.g. 1 through 99 for attribute types.
+*/
+type Reservation struct {
+	Label string
+	Low   uint
+	High  uint
+}
+
+/*
+RangeCapacity describes the utilization of a Reservation.
+*/
+type RangeCapacity struct {
+	Reservation
+	Used      uint
+	Remaining uint
+}
+
+/*
+Reserve sets aside the number forms low through high, inclusive, beneath base for allocation under label. The range must not overlap any existing reservation beneath base, and label must be unique among them.
+*/
+func (r *Registry) Reserve(base *ObjectIdentifier, label string, low, high uint) (err error) {
+	if r == nil || r.plans == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if !base.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid base %T", base)
+		return
+	} else if len(label) == 0 || low > high {
+		err = errorw(ErrInvalidValue, "Bad reservation '%s' [%d-%d]", label, low, high)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := base.DotNotation()
+	plan := r.plans[key]
+	for i := 0; i < len(plan); i++ {
+		if eq(plan[i].Label, label) {
+			err = errorw(ErrConflict, "Reservation '%s' already exists beneath %s", label, key)
+			return
+		} else if low <= plan[i].High && plan[i].Low <= high {
+			err = errorw(ErrConflict, "Reservation '%s' [%d-%d] overlaps '%s' [%d-%d] beneath %s",
+				label, low, high, plan[i].Label, plan[i].Low, plan[i].High, key)
+			return
+		}
+	}
+
+	plan = append(plan, Reservation{Label: label, Low: low, High: high})
+	sort.Slice(plan, func(i, j int) bool {
+		return plan[i].Low < plan[j].Low
+	})
+	r.plans[key] = plan
+
+	return
+}
+
+/*
+Reservations returns the reservations made beneath base, ordered by number form.
+*/
+func (r *Registry) Reservations(base *ObjectIdentifier) []Reservation {
+	if r == nil || base.IsZero() {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]Reservation(nil), r.plans[base.DotNotation()]...)
+}
+
+/*
+Allocate assigns the lowest unused number form within the reservation bearing label beneath base, registering and returning the resulting *ObjectIdentifier. If name is non-zero, it is used as the principal name of the new OID, and as its key within the receiver; otherwise the dotNotation is used as the key.
+
+If label is a zero string, the lowest unused number form greater than zero which falls outside of all reservations beneath base is assigned.
+
+An error wrapping ErrConflict is returned, and no number form consumed, should the key already be assigned within the receiver. An error wrapping ErrLimitExceeded is returned should the reservation bearing label have no number forms remaining.
+*/
+func (r *Registry) Allocate(base *ObjectIdentifier, label, name string) (o *ObjectIdentifier, err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if !base.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid base %T", base)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var key string
+	var t *ObjectIdentifier
+	if t, err = r.allocate(r.oids, base, label, name); err != nil {
+		return
+	} else if key, t, err = r.place(r.oids, t.Key(), t); err == nil {
+		r.oids[key] = t
+		r.record(AuditAllocate, key, t)
+		o = t
+	}
+
+	return
+}
+
+/*
+allocate returns a new, unregistered *ObjectIdentifier for the next unused number form within the reservation bearing label beneath base, considering the contents of oids. The result is to be assigned by way of place. The caller must hold the lock.
+*/
+func (r *Registry) allocate(oids ObjectIdentifierMap, base *ObjectIdentifier, label, name string) (o *ObjectIdentifier, err error) {
+	key := base.DotNotation()
+	plan := r.plans[key]
+	used := childArcs(oids, base)
+
+	var num uint
+	var found bool
+	if len(label) == 0 {
+		num, found = nextUnreserved(plan, used)
+	} else {
+		var res *Reservation
+		for i := 0; i < len(plan) && res == nil; i++ {
+			if eq(plan[i].Label, label) {
+				res = &plan[i]
+			}
+		}
+		if res == nil {
+			err = errorw(ErrNotFound, "No reservation '%s' beneath %s", label, key)
+			return
+		}
+
+		for n := res.Low; ; n++ {
+			if !used[n] {
+				num, found = n, true
+				break
+			} else if n == res.High {
+				break
+			}
+		}
+	}
+
+	if !found {
+		err = errorw(ErrLimitExceeded, "No number forms remain for '%s' beneath %s", label, key)
+		return
+	}
+
+	arcs := base.arcs()
+	o = newObjectIdentifier(len(arcs) + 1)
+	o.nANF = append(o.nANF, arcs...)
+	o.nANF = append(o.nANF, NameAndNumberForm{primaryIdentifier: num})
+	o.name = name
+
+	if x, taken := oids[o.Key()]; taken {
+		err = errorw(ErrConflict, "Key '%s' is already assigned to %s", o.Key(), x.DotNotation())
+		o = nil
+	}
+
+	return
+}
+
+/*
+Capacity returns the utilization of each reservation made beneath base, ordered by number form.
+*/
+func (r *Registry) Capacity(base *ObjectIdentifier) (caps []RangeCapacity) {
+	if r == nil || base.IsZero() {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	plan := r.plans[base.DotNotation()]
+	used := childArcs(r.oids, base)
+	for i := 0; i < len(plan); i++ {
+		c := RangeCapacity{Reservation: plan[i]}
+		for n := range used {
+			if plan[i].Low <= n && n <= plan[i].High {
+				c.Used++
+			}
+		}
+		c.Remaining = plan[i].High - plan[i].Low + 1 - c.Used
+		caps = append(caps, c)
+	}
+
+	return
+}
+
+/*
+childArcs returns the set of number forms in use by the immediate children of base within oids.
+*/
+func childArcs(oids ObjectIdentifierMap, base *ObjectIdentifier) map[uint]bool {
+	used := make(map[uint]bool)
+	for _, v := range oids {
+		if v.isChildOf(base.arcs()) {
+			if last := v.NameAndNumberForm(); last.huge == nil {
+				used[last.primaryIdentifier] = true
+			}
+		}
+	}
+
+	return used
+}
+
+/*
+nextUnreserved returns the lowest number form greater than zero which is neither in use nor reserved by plan, which must be ordered by number form.
+*/
+func nextUnreserved(plan []Reservation, used map[uint]bool) (num uint, found bool) {
+	num = 1
+	for i := 0; i < len(plan); i++ {
+		for ; num < plan[i].Low; num++ {
+			if !used[num] {
+				return num, true
+			}
+		}
+		if plan[i].High == ^uint(0) {
+			return 0, false
+		} else if num <= plan[i].High {
+			num = plan[i].High + 1
+		}
+	}
+
+	for ; used[num]; num++ {
+	}
+
+	return num, true
+}
@@ -0,0 +1,89 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAllocateKeyCollision(t *testing.T) {
+	r := NewRegistry()
+	dod, _ := NewFromDot(`1.3.6`)
+	internet, _ := NewFromDot(`1.3.6.1`)
+	if err := r.Set(`foo`, internet); err != nil {
+		t.Fatal(err)
+	}
+
+	if o, err := r.Allocate(dod, ``, `foo`); err == nil {
+		t.Errorf("Allocate under assigned key: expected error, got %s", o.DotNotation())
+	} else if o != nil {
+		t.Errorf("Allocate under assigned key: got %s alongside error", o.DotNotation())
+	} else if got, _ := r.Get(`foo`); got != internet {
+		t.Errorf("Allocate under assigned key: existing entry clobbered")
+	}
+
+	err := r.Batch(func(tx RegistryTx) (err error) {
+		_, err = tx.Allocate(dod, ``, `foo`)
+		return
+	})
+	if err == nil {
+		t.Errorf("Batch Allocate under assigned key: expected error")
+	}
+
+	if o, err := r.Allocate(dod, ``, `bar`); err != nil {
+		t.Fatal(err)
+	} else if got := o.DotNotation(); got != `1.3.6.2` {
+		t.Errorf("Allocate: got %s, want 1.3.6.2", got)
+	}
+}
+
+func TestReserveAllocate(t *testing.T) {
+	r := NewRegistry()
+	base := NewEnterpriseOID(56521)
+	for _, tc := range []struct {
+		label     string
+		low, high uint
+		err       error
+	}{
+		{`attributes`, 1, 3, nil},
+		{`classes`, 10, 19, nil},
+		{`attributes`, 30, 39, ErrConflict},
+		{`overlap`, 15, 25, ErrConflict},
+		{`inverted`, 9, 5, ErrInvalidValue},
+		{``, 40, 49, ErrInvalidValue},
+	} {
+		if err := r.Reserve(base, tc.label, tc.low, tc.high); !errors.Is(err, tc.err) {
+			t.Errorf("Reserve(%q, %d, %d): got %v, want %v", tc.label, tc.low, tc.high, err, tc.err)
+		}
+	}
+
+	for i, tc := range []struct {
+		label, name string
+		dot         string
+		err         error
+	}{
+		{`attributes`, `first`, `1.3.6.1.4.1.56521.1`, nil},
+		{`attributes`, ``, `1.3.6.1.4.1.56521.2`, nil},
+		{`attributes`, `third`, `1.3.6.1.4.1.56521.3`, nil},
+		{`attributes`, `fourth`, ``, ErrLimitExceeded},
+		{`classes`, `first`, ``, ErrConflict},
+		{`classes`, `person`, `1.3.6.1.4.1.56521.10`, nil},
+		{``, `misc`, `1.3.6.1.4.1.56521.4`, nil},
+		{`bogus`, `x`, ``, ErrNotFound},
+	} {
+		o, err := r.Allocate(base, tc.label, tc.name)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("#%d Allocate(%q): got %v, want %v", i, tc.label, err, tc.err)
+		} else if err == nil && (o.DotNotation() != tc.dot || o.Name() != tc.name) {
+			t.Errorf("#%d Allocate(%q): got %s (%s), want %s (%s)", i, tc.label, o, o.Name(), tc.dot, tc.name)
+		}
+	}
+
+	for i, want := range []RangeCapacity{
+		{Reservation{`attributes`, 1, 3}, 3, 0},
+		{Reservation{`classes`, 10, 19}, 1, 9},
+	} {
+		if caps := r.Capacity(base); len(caps) != 2 || caps[i] != want {
+			t.Errorf("Capacity #%d: got %v, want %v", i, caps, want)
+		}
+	}
+}
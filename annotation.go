@@ -0,0 +1,103 @@
+package oid
+
+/*
+annotation.go deals with the free-text annotations that may be attached to individual arcs of an ObjectIdentifier.
+*/
+
+/*
+Annotation returns the free-text annotation of the receiver, e.g. "assigned to Platform team 2021-04", or a zero string if unset. Annotations play no part in comparison or in the ASN.1 NameAndNumberForm sequence returned by String.
+*/
+func (nanf NameAndNumberForm) Annotation() string {
+	return nanf.annotation
+}
+
+/*
+Arc returns the NameAndNumberForm of the arc at index i of the receiver, the root arc being at index zero (0). A zero instance is returned if the receiver is nil or i is out of range.
+*/
+func (o *ObjectIdentifier) Arc(i int) (nanf NameAndNumberForm) {
+	if arcs := o.arcs(); 0 <= i && i < len(arcs) {
+		nanf = arcs[i]
+	}
+
+	return
+}
+
+/*
+SetArcAnnotation attaches the free-text annotation text to the arc at index i of the receiver, the root arc being at index zero (0), e.g.:
+
+	o, _ := oid.NewFromDot(`1.3.6.1.4.1.56521`)
+	err := o.SetArcAnnotation(6, `assigned to Platform team 2021-04`)
+
+A zero string clears the annotation. Annotations may be retrieved using the Annotation method of the NameAndNumberForm returned by Arc, and are included within VerboseString, rendered trees and the JSON representation of the receiver.
+*/
+func (o *ObjectIdentifier) SetArcAnnotation(i int, text string) (err error) {
+	if o.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", o)
+		return
+	} else if i < 0 || i >= o.len() {
+		err = errorw(ErrInvalidValue, "Arc index %d out of range [0, %d)", i, o.len())
+		return
+	}
+
+	o.lock()
+	arcs := o.copyArcs()
+	arcs[i].annotation = text
+	o.nANF = arcs
+	o.unlock()
+
+	return
+}
+
+/*
+VerboseString returns the ASN.1 NameAndNumberForm sequence of the receiver with one arc per line, each annotated arc being followed by its annotation as an ASN.1 comment, e.g.:
+
+	{
+		iso(1)
+		identified-organization(3)
+		dod(6)
+		internet(1)
+		private(4)
+		enterprise(1)
+		example(56521) -- assigned to Platform team 2021-04
+	}
+
+Line breaks within annotations are collapsed to spaces, and consecutive hyphens to a single hyphen, such that the result remains a valid sequence which may be read using Tokenize and NewFromTokens. A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) VerboseString() string {
+	if o.IsZero() {
+		return ``
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	dst := []byte("{\n")
+	for i := 0; i < len(o.nANF); i++ {
+		arc := o.nANF[i]
+		if i == len(o.nANF)-1 && len(arc.identifier) == 0 && isIdentifier(o.name) {
+			arc.identifier = o.name
+		}
+
+		dst = append(dst, '\t')
+		dst = arc.AppendString(dst)
+		if text := annotationComment(o.nANF[i].annotation); len(text) > 0 {
+			dst = append(dst, ` -- `...)
+			dst = append(dst, text...)
+		}
+		dst = append(dst, '\n')
+	}
+
+	return string(append(dst, '}'))
+}
+
+/*
+annotationComment returns text in a form suitable for use within an ASN.1 comment.
+*/
+func annotationComment(text string) string {
+	text = join(fields(text), ` `)
+	for contains(text, `--`) {
+		text = replaceAll(text, `--`, `-`)
+	}
+
+	return text
+}
@@ -0,0 +1,93 @@
+package oid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestSetArcAnnotation(t *testing.T) {
+	for _, tc := range []struct {
+		i    int
+		text string
+		err  error
+	}{
+		{6, `assigned to Platform team 2021-04`, nil},
+		{0, `root`, nil},
+		{6, ``, nil},
+		{7, `out of range`, ErrInvalidValue},
+		{-1, `out of range`, ErrInvalidValue},
+	} {
+		o := mustDot(t, `1.3.6.1.4.1.56521`)
+		if err := o.SetArcAnnotation(tc.i, tc.text); !errors.Is(err, tc.err) {
+			t.Errorf("%d: got error %v, want %v", tc.i, err, tc.err)
+		} else if err == nil {
+			if got := o.Arc(tc.i).Annotation(); got != tc.text {
+				t.Errorf("%d: got %q, want %q", tc.i, got, tc.text)
+			}
+		}
+	}
+
+	var nilOID *ObjectIdentifier
+	if err := nilOID.SetArcAnnotation(0, `x`); !errors.Is(err, ErrInvalidRoot) {
+		t.Errorf("nil receiver: got error %v, want %v", err, ErrInvalidRoot)
+	}
+	if arc := nilOID.Arc(0); !arc.IsZero() {
+		t.Errorf("nil receiver: got non-zero arc %v", arc)
+	}
+}
+
+func TestVerboseString(t *testing.T) {
+	for _, tc := range []struct {
+		text, want string
+	}{
+		{``, "{\n\t1\n\t3\n\tdod(6)\n}"},
+		{`assigned 2021`, "{\n\t1\n\t3\n\tdod(6) -- assigned 2021\n}"},
+		{"two\nlines", "{\n\t1\n\t3\n\tdod(6) -- two lines\n}"},
+		{`a -- b --- c`, "{\n\t1\n\t3\n\tdod(6) -- a - b - c\n}"},
+	} {
+		o, err := NewObjectIdentifier(`{ 1 3 dod(6) }`)
+		if err != nil {
+			t.Fatal(err)
+		} else if err = o.SetArcAnnotation(2, tc.text); err != nil {
+			t.Fatal(err)
+		}
+
+		v := o.VerboseString()
+		if v != tc.want {
+			t.Errorf("%q: got %q, want %q", tc.text, v, tc.want)
+			continue
+		}
+
+		// the verbose form must remain a readable sequence
+		toks, err := Tokenize(v)
+		if err != nil {
+			t.Errorf("%q: %v", tc.text, err)
+		} else if p, err := NewFromTokens(toks); err != nil {
+			t.Errorf("%q: %v", tc.text, err)
+		} else if p.DotNotation() != `1.3.6` {
+			t.Errorf("%q: got %s", tc.text, p.DotNotation())
+		}
+	}
+}
+
+func TestArcAnnotationJSON(t *testing.T) {
+	o := mustDot(t, `1.3.6.1.4.1.56521`)
+	if err := o.SetArcAnnotation(6, `assigned to Platform team`); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var p ObjectIdentifier
+	if err = json.Unmarshal(b, &p); err != nil {
+		t.Fatal(err)
+	} else if text := p.Arc(6).Annotation(); text != `assigned to Platform team` {
+		t.Errorf("got %q after round trip of %s", text, b)
+	} else if text = p.Arc(5).Annotation(); text != `` {
+		t.Errorf("unexpected annotation %q on arc #5", text)
+	}
+}
@@ -0,0 +1,131 @@
+package oid
+
+/*
+arcnames.go contains the identifiers sanctioned by ITU-T Rec. X.660 for the root and second-level arcs of the OID tree, and the means by which they are checked and assigned.
+*/
+
+/*
+rootArcNames contains the sanctioned identifiers of each root arc, indexed by number form. The first identifier of each is preferred.
+*/
+var rootArcNames = [3][]string{
+	{`itu-t`, `ccitt`, `itu-r`},
+	{`iso`},
+	{`joint-iso-itu-t`, `joint-iso-ccitt`},
+}
+
+/*
+secondArcNames contains the standard identifiers of the second-level arcs beneath each root arc, indexed by the number form of the root arc. The first identifier of each is preferred.
+*/
+var secondArcNames = [3]map[uint][]string{
+	{
+		0: {`recommendation`},
+		1: {`question`},
+		2: {`administration`},
+		3: {`network-operator`},
+		4: {`identified-organization`},
+		5: {`r-recommendation`},
+		9: {`data`},
+	},
+	{
+		0: {`standard`},
+		1: {`registration-authority`},
+		2: {`member-body`},
+		3: {`identified-organization`},
+	},
+	{
+		0:   {`presentation`},
+		1:   {`asn1`},
+		2:   {`association-control`},
+		3:   {`reliable-transfer`},
+		4:   {`remote-operations`},
+		5:   {`ds`},
+		6:   {`mhs`, `mhs-motis`},
+		7:   {`ccr`},
+		8:   {`oda`},
+		9:   {`ms`, `osi-management`},
+		10:  {`transaction-processing`},
+		11:  {`dor`},
+		12:  {`reference-data-transfer`},
+		13:  {`network-layer`},
+		14:  {`transport-layer`},
+		15:  {`datalink-layer`},
+		16:  {`country`},
+		17:  {`registration-procedures`},
+		18:  {`physical-layer`},
+		19:  {`physical-layer-management`},
+		20:  {`mheg`},
+		21:  {`generic-upper-layers-security`},
+		22:  {`transport-layer-security-protocol`},
+		23:  {`international-organizations`},
+		24:  {`sios`},
+		25:  {`uuid`},
+		26:  {`odp`},
+		27:  {`tag-based`},
+		28:  {`its`},
+		40:  {`upu`},
+		41:  {`bip`},
+		42:  {`telebiometrics`},
+		48:  {`cybersecurity`},
+		49:  {`alerting`},
+		50:  {`ors`},
+		999: {`example`},
+	},
+}
+
+/*
+standardArcNames returns the sanctioned identifiers of the arc at index i (0 or 1) of arcs, or nil if the arc has no standard identifier. The arcs are presumed to be valid.
+*/
+func standardArcNames(arcs []NameAndNumberForm, i int) []string {
+	root := arcs[0].primaryIdentifier
+	switch {
+	case i == 0:
+		return rootArcNames[root]
+	case i == 1 && len(arcs) > 1 && arcs[1].huge == nil:
+		return secondArcNames[root][arcs[1].primaryIdentifier]
+	}
+
+	return nil
+}
+
+/*
+checkArcNames returns an error if the identifier of the root arc of o, or of its second arc, is present but not among those sanctioned for its number form. Second arcs which bear no standard identifier are not checked. The receiver is presumed to be valid.
+*/
+func (o *ObjectIdentifier) checkArcNames() (err error) {
+	arcs := o.arcs()
+	for i := 0; i < 2 && i < len(arcs); i++ {
+		arc := arcs[i]
+		names := standardArcNames(arcs, i)
+		if len(arc.identifier) == 0 || len(names) == 0 || strInSlice(arc.identifier, names) {
+			continue
+		}
+
+		err = errorw(ErrInvalidIdentifier, "Bad arc #%d identifier '%s' [hint: must be one of %s]",
+			i, arc, join(names, `, `))
+		return
+	}
+
+	return
+}
+
+/*
+setStandardArcNames assigns the preferred standard identifier to the root arc and second arc of o, where either lacks an identifier. The receiver is presumed to be valid. Its arcs are replaced rather than modified in place; see arcs.
+*/
+func (o *ObjectIdentifier) setStandardArcNames() {
+	o.lock()
+	defer o.unlock()
+
+	var arcs []NameAndNumberForm
+	for i := 0; i < 2 && i < len(o.nANF); i++ {
+		if names := standardArcNames(o.nANF, i); len(o.nANF[i].identifier) == 0 && len(names) > 0 {
+			if arcs == nil {
+				arcs = o.copyArcs()
+			}
+			arcs[i].identifier = names[0]
+		}
+	}
+
+	if arcs != nil {
+		o.nANF = arcs
+		o.invalidate()
+	}
+}
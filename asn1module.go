@@ -0,0 +1,124 @@
+package oid
+
+/*
+asn1module.go deals with the export of ObjectIdentifierMap contents as an ASN.1 module.
+*/
+
+import "io"
+
+/*
+ExportASN1Module writes an ASN.1 module named module to w, containing an OBJECT IDENTIFIER value assignment for each ObjectIdentifier within the receiver that is equal to, or a descendant of, base. If base is nil, all ObjectIdentifier instances are exported.
+
+The value reference name of each assignment is, in order of preference, the map key, the principal name, the identifier of the final arc or the first alt name that qualifies as an ASN.1 identifier. Failing all of these, a name is synthesized from the dotNotation, e.g. "oid-1-3-6-1".
+
+When an ancestor of an ObjectIdentifier is also exported, the assignment references the ancestor by name rather than repeating its arcs, e.g.:
+
+	Example DEFINITIONS ::=
+	BEGIN
+
+	internet OBJECT IDENTIFIER ::= { iso(1) identified-organization(3) dod(6) internet(1) }
+	private OBJECT IDENTIFIER ::= { internet private(4) }
+
+	END
+*/
+func (o ObjectIdentifierMap) ExportASN1Module(w io.Writer, module string, base *ObjectIdentifier) (err error) {
+	if !isModuleReference(module) {
+		err = errorw(ErrInvalidIdentifier, "Bad ASN.1 module reference '%s' [hint: must start with uppercase alpha]", module)
+		return
+	}
+
+	var keys []string
+	o.Range(func(k string, v *ObjectIdentifier) bool {
+		if base == nil || v.under(base) {
+			keys = append(keys, k)
+		}
+		return true
+	})
+
+	names := make(map[string]string, len(keys)) // dot -> name
+	used := make(map[string]string, len(keys))  // name -> dot
+
+	body := ``
+	for i := 0; i < len(keys); i++ {
+		v := o[keys[i]]
+		dot := v.DotNotation()
+		if _, done := names[dot]; done {
+			continue
+		}
+
+		name := asn1ValueName(keys[i], *v)
+		if other, taken := used[name]; taken {
+			err = errorw(ErrConflict, "ASN.1 value reference '%s' is assigned to both %s and %s", name, other, dot)
+			return
+		}
+		names[dot] = name
+		used[name] = dot
+
+		// Find the nearest exported ancestor, if any.
+		start := 0
+		var comps []string
+		arcs := v.arcs()
+		for j := len(arcs) - 1; j > 0; j-- {
+			if anc, found := names[dotArcs(arcs[:j])]; found {
+				comps = append(comps, anc)
+				start = j
+				break
+			}
+		}
+
+		for j := start; j < len(arcs); j++ {
+			comps = append(comps, arcs[j].String())
+		}
+
+		body += sprintf("%s OBJECT IDENTIFIER ::= { %s }\n", name, join(comps, ` `))
+	}
+
+	_, err = io.WriteString(w, sprintf("%s DEFINITIONS ::=\nBEGIN\n\n%s\nEND\n", module, body))
+
+	return
+}
+
+/*
+asn1ValueName returns a suitable ASN.1 value reference for the provided ObjectIdentifier.
+*/
+func asn1ValueName(key string, o ObjectIdentifier) string {
+	if isIdentifier(key) {
+		return key
+	} else if name := o.Name(); isIdentifier(name) {
+		return name
+	} else if id := o.NameAndNumberForm().Identifier(); isIdentifier(id) {
+		return id
+	}
+
+	aka := o.AltNames()
+	for i := 0; i < len(aka); i++ {
+		if isIdentifier(aka[i]) {
+			return aka[i]
+		}
+	}
+
+	return `oid-` + join(split(o.DotNotation(), `.`), `-`)
+}
+
+/*
+is 'val' a valid ASN.1 identifier?
+*/
+func isIdentifier(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+
+	valid, _ := identifierIsValid(val, false)
+	return valid && !contains(val, `--`)
+}
+
+/*
+is 'val' a valid ASN.1 modulereference?
+*/
+func isModuleReference(val string) bool {
+	if len(val) == 0 || !('A' <= val[0] && val[0] <= 'Z') {
+		return false
+	}
+
+	return isIdentifier(string(val[0]-'A'+'a') + val[1:])
+}
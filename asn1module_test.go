@@ -0,0 +1,51 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExportASN1Module(t *testing.T) {
+	internet, _ := NewFromNaNF(`{ iso(1) identified-organization(3) dod(6) internet(1) }`)
+	private := mustDot(t, `1.3.6.1.4`)
+	private.SetName(`private`)
+	m := ObjectIdentifierMap{
+		`internet`:    internet,
+		`1.3.6.1.4`:   private,
+		`1.3.6.1.4.1`: mustDot(t, `1.3.6.1.4.1`),
+		`x`:           mustDot(t, `2.5.4.3`),
+	}
+
+	for _, tc := range []struct {
+		name   string
+		module string
+		base   *ObjectIdentifier
+		want   string
+		err    error
+	}{
+		{`subtree`, `Example`, internet, "Example DEFINITIONS ::=\nBEGIN\n\n" +
+			"internet OBJECT IDENTIFIER ::= { iso(1) identified-organization(3) dod(6) internet(1) }\n" +
+			"private OBJECT IDENTIFIER ::= { internet 4 }\n" +
+			"oid-1-3-6-1-4-1 OBJECT IDENTIFIER ::= { private 1 }\n" +
+			"\nEND\n", nil},
+		{`all`, `Example`, nil, "x OBJECT IDENTIFIER ::= { 2 5 4 3 }\n", nil},
+		{`bad module`, `example`, nil, ``, ErrInvalidIdentifier},
+	} {
+		var b strings.Builder
+		err := m.ExportASN1Module(&b, tc.module, tc.base)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.err)
+		} else if !strings.Contains(b.String(), tc.want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", tc.name, b.String(), tc.want)
+		}
+	}
+
+	dup := ObjectIdentifierMap{`a`: mustDot(t, `1.3`), `b`: mustDot(t, `1.3.6`)}
+	dup[`a`].SetName(`same`)
+	dup[`b`].SetName(`same`)
+	dup = ObjectIdentifierMap{`1.3`: dup[`a`], `1.3.6`: dup[`b`]}
+	if err := dup.ExportASN1Module(new(strings.Builder), `Example`, nil); !errors.Is(err, ErrConflict) {
+		t.Errorf("duplicate names: got %v, want %v", err, ErrConflict)
+	}
+}
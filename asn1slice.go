@@ -0,0 +1,63 @@
+package oid
+
+/*
+asn1slice.go deals with the bulk conversion of ObjectIdentifier instances to and from slices of asn1.ObjectIdentifier, such as those found within certificate and LDAP structures.
+*/
+
+import (
+	"context"
+	"encoding/asn1"
+)
+
+/*
+FromASN1Slice returns a new *ObjectIdentifier for each of the asn1.ObjectIdentifier instances within x, in the same order, alongside an error. If r is non-nil, the identifier of each arc is looked up using r in the manner of NewObjectIdentifierContext, e.g.:
+
+	policies, err := oid.FromASN1Slice(cert.PolicyIdentifiers, oid.WellKnown())
+
+An error is returned should any element of x fail to qualify as an ObjectIdentifier, or should r return an error other than one wrapping ErrNotFound. A nil slice is returned when x is empty.
+*/
+func FromASN1Slice(x []asn1.ObjectIdentifier, r Resolver) (oids []*ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		return
+	}
+
+	ctx := context.Background()
+	s := make([]*ObjectIdentifier, len(x))
+	for i := 0; i < len(x); i++ {
+		if s[i], err = NewFromInts([]int(x[i])); err != nil {
+			err = errorc(err, "Element #%d (%s)", i, x[i])
+			return
+		} else if r == nil {
+			continue
+		} else if err = s[i].resolveNames(ctx, r); err != nil {
+			return
+		}
+	}
+	oids = s
+
+	return
+}
+
+/*
+ToASN1Slice returns an asn1.ObjectIdentifier for each of the *ObjectIdentifier instances within x, in the same order, alongside an error. Unlike the return value of ObjectIdentifier.ASN1, each asn1.ObjectIdentifier is a copy which may be freely modified by the caller.
+
+An error is returned should any element of x be nil, or bear an arc which cannot be represented by an int on the current platform. A nil slice is returned when x is empty.
+*/
+func ToASN1Slice(x []*ObjectIdentifier) (a []asn1.ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		return
+	}
+
+	s := make([]asn1.ObjectIdentifier, len(x))
+	for i := 0; i < len(x); i++ {
+		var e asn1.ObjectIdentifier
+		if e, err = x[i].ASN1E(); err != nil {
+			err = errorc(err, "Element #%d", i)
+			return
+		}
+		s[i] = append(asn1.ObjectIdentifier(nil), e...)
+	}
+	a = s
+
+	return
+}
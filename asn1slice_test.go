@@ -0,0 +1,78 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFromASN1Slice(t *testing.T) {
+	ev := asn1.ObjectIdentifier{2, 23, 140, 1, 1}
+	anyPolicy := asn1.ObjectIdentifier{2, 5, 29, 32, 0}
+	errBackend := errors.New("backend unavailable")
+
+	for _, tc := range []struct {
+		name string
+		x    []asn1.ObjectIdentifier
+		r    Resolver
+		want string // space-delimited NaNF final arcs
+		err  error
+	}{
+		{`bare`, []asn1.ObjectIdentifier{ev, anyPolicy}, nil, `1 0`, nil},
+		{`resolved`, []asn1.ObjectIdentifier{ev, anyPolicy}, WellKnown(), `ev-guidelines(1) anyPolicy(0)`, nil},
+		{`empty`, nil, nil, ``, nil},
+		{`invalid`, []asn1.ObjectIdentifier{ev, {3, 1}}, nil, ``, ErrInvalidRoot},
+		{`resolver failure`, []asn1.ObjectIdentifier{ev}, failingResolver{errBackend}, ``, errBackend},
+	} {
+		oids, err := FromASN1Slice(tc.x, tc.r)
+		if !errors.Is(err, tc.err) || (err != nil && oids != nil) {
+			t.Errorf("%s: got %v (%v), want %v", tc.name, oids, err, tc.err)
+			continue
+		}
+
+		var got []string
+		for _, o := range oids {
+			got = append(got, o.NameAndNumberForm().String())
+		}
+		if s := strings.Join(got, ` `); s != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, s, tc.want)
+		}
+	}
+}
+
+func TestToASN1Slice(t *testing.T) {
+	cn := mustDot(t, `2.5.4.3`)
+	huge := mustDot(t, `2.25.329800735698586629295641978511506172918`)
+
+	for _, tc := range []struct {
+		name string
+		x    []*ObjectIdentifier
+		want []asn1.ObjectIdentifier
+		err  error
+	}{
+		{`converted`, []*ObjectIdentifier{cn, mustDot(t, `2.5.4.4`)},
+			[]asn1.ObjectIdentifier{{2, 5, 4, 3}, {2, 5, 4, 4}}, nil},
+		{`empty`, nil, nil, nil},
+		{`nil element`, []*ObjectIdentifier{cn, nil}, nil, ErrInvalidRoot},
+		{`huge arc`, []*ObjectIdentifier{huge}, nil, ErrInvalidNumberForm},
+	} {
+		a, err := ToASN1Slice(tc.x)
+		if !errors.Is(err, tc.err) || len(a) != len(tc.want) {
+			t.Errorf("%s: got %v (%v), want %v (%v)", tc.name, a, err, tc.want, tc.err)
+			continue
+		}
+		for i := 0; i < len(a); i++ {
+			if !a[i].Equal(tc.want[i]) {
+				t.Errorf("%s: got %s at #%d, want %s", tc.name, a[i], i, tc.want[i])
+			}
+		}
+	}
+
+	// the result must be a copy
+	a, _ := ToASN1Slice([]*ObjectIdentifier{cn})
+	a[0][3] = 99
+	if !cn.ASN1().Equal(asn1.ObjectIdentifier{2, 5, 4, 3}) {
+		t.Errorf("ToASN1Slice: modification of the result altered the receiver")
+	}
+}
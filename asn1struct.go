@@ -0,0 +1,265 @@
+package oid
+
+/*
+asn1struct.go deals with the use of ObjectIdentifier instances within structs marshaled and unmarshaled by way of encoding/asn1.
+*/
+
+import (
+	"encoding/asn1"
+	"reflect"
+	"sync"
+)
+
+var (
+	oidType     = reflect.TypeOf(ObjectIdentifier{})
+	oidPtrType  = reflect.TypeOf((*ObjectIdentifier)(nil))
+	asn1OIDType = reflect.TypeOf(asn1.ObjectIdentifier{})
+)
+
+/*
+asn1Mirrors caches the mirror type of each type passed through the ASN.1 helpers.
+*/
+var asn1Mirrors sync.Map // reflect.Type -> reflect.Type
+
+/*
+MarshalASN1 returns the DER encoding of val alongside an error, in the manner of asn1.Marshal, except that ObjectIdentifier and *ObjectIdentifier values are encoded as OBJECT IDENTIFIER values. Such values may appear as struct fields, slice elements or val itself, at any depth. This permits protocol structs to bear *ObjectIdentifier fields directly, rather than duplicate asn1.ObjectIdentifier fields, e.g.:
+
+	type AlgorithmIdentifier struct {
+		Algorithm  *oid.ObjectIdentifier
+		Parameters asn1.RawValue `asn1:"optional"`
+	}
+
+	der, err := oid.MarshalASN1(AlgorithmIdentifier{Algorithm: sha256})
+
+Struct tags are honored as they would be by asn1.Marshal. Structs bearing an ObjectIdentifier must not contain unexported fields, and recursive types are not converted beyond their first occurrence.
+*/
+func MarshalASN1(val any) ([]byte, error) {
+	return MarshalASN1WithParams(val, ``)
+}
+
+/*
+MarshalASN1WithParams is equivalent to MarshalASN1, but allows field parameters to be specified for the top-level element, in the manner of asn1.MarshalWithParams.
+*/
+func MarshalASN1WithParams(val any, params string) (b []byte, err error) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() {
+		return asn1.MarshalWithParams(val, params)
+	}
+
+	var m reflect.Type
+	if m, err = asn1MirrorType(v.Type()); err != nil {
+		return
+	}
+
+	var mv reflect.Value
+	if mv, err = toASN1Mirror(v, m); err == nil {
+		b, err = asn1.MarshalWithParams(mv.Interface(), params)
+	}
+
+	return
+}
+
+/*
+UnmarshalASN1 parses the DER encoded data b into the value pointed to by val, returning any data remaining alongside an error, in the manner of asn1.Unmarshal. ObjectIdentifier and *ObjectIdentifier values are decoded from OBJECT IDENTIFIER values, as described by MarshalASN1.
+
+The resulting ObjectIdentifier instances bear no names; see ObjectIdentifierMap.Resolve to recover them.
+*/
+func UnmarshalASN1(b []byte, val any) (rest []byte, err error) {
+	return UnmarshalASN1WithParams(b, val, ``)
+}
+
+/*
+UnmarshalASN1WithParams is equivalent to UnmarshalASN1, but allows field parameters to be specified for the top-level element, in the manner of asn1.UnmarshalWithParams.
+*/
+func UnmarshalASN1WithParams(b []byte, val any, params string) (rest []byte, err error) {
+	v := reflect.ValueOf(val)
+	if !v.IsValid() || v.Kind() != reflect.Pointer || v.IsNil() {
+		err = errorw(ErrUnsupportedType, "Unsupported %T input type; must be a non-nil pointer", val)
+		return
+	}
+
+	var m reflect.Type
+	if m, err = asn1MirrorType(v.Elem().Type()); err != nil {
+		return
+	}
+
+	mv := reflect.New(m)
+	if rest, err = asn1.UnmarshalWithParams(b, mv.Interface(), params); err == nil {
+		err = fromASN1Mirror(mv.Elem(), v.Elem())
+	}
+
+	return
+}
+
+/*
+asn1MirrorType returns the type corresponding to t in which each ObjectIdentifier and *ObjectIdentifier is replaced by asn1.ObjectIdentifier. Should t not bear any such types, t itself is returned.
+*/
+func asn1MirrorType(t reflect.Type) (m reflect.Type, err error) {
+	if c, found := asn1Mirrors.Load(t); found {
+		m = c.(reflect.Type)
+		return
+	}
+
+	if m, err = buildASN1Mirror(t, make(map[reflect.Type]bool)); err == nil {
+		asn1Mirrors.Store(t, m)
+	}
+
+	return
+}
+
+/*
+buildASN1Mirror implements asn1MirrorType. Types present within seen are under construction, and are returned unchanged to avoid unbounded recursion.
+*/
+func buildASN1Mirror(t reflect.Type, seen map[reflect.Type]bool) (m reflect.Type, err error) {
+	m = t
+	if t == oidType || t == oidPtrType {
+		m = asn1OIDType
+		return
+	} else if seen[t] {
+		return
+	}
+
+	seen[t] = true
+	defer delete(seen, t)
+
+	switch t.Kind() {
+	case reflect.Slice:
+		var e reflect.Type
+		if e, err = buildASN1Mirror(t.Elem(), seen); err == nil && e != t.Elem() {
+			m = reflect.SliceOf(e)
+		}
+	case reflect.Struct:
+		fields := make([]reflect.StructField, t.NumField())
+		var changed, unexported bool
+		for i := 0; i < len(fields); i++ {
+			fields[i] = t.Field(i)
+			if !fields[i].IsExported() {
+				unexported = true
+				continue
+			}
+
+			var ft reflect.Type
+			if ft, err = buildASN1Mirror(fields[i].Type, seen); err != nil {
+				return
+			} else if ft != fields[i].Type {
+				fields[i].Type = ft
+				fields[i].Anonymous = false
+				changed = true
+			}
+		}
+
+		if changed && unexported {
+			err = errorw(ErrUnsupportedType, "%s bears an %T but also unexported fields", t, ObjectIdentifier{})
+		} else if changed {
+			m = reflect.StructOf(fields)
+		}
+	}
+
+	return
+}
+
+/*
+toASN1Mirror returns v converted to the mirror type m.
+*/
+func toASN1Mirror(v reflect.Value, m reflect.Type) (mv reflect.Value, err error) {
+	if v.Type() == m {
+		mv = v
+		return
+	}
+
+	switch v.Type() {
+	case oidPtrType:
+		mv = reflect.Zero(m)
+		if !v.IsNil() {
+			var a asn1.ObjectIdentifier
+			if a, err = v.Interface().(*ObjectIdentifier).ASN1E(); err == nil {
+				mv = reflect.ValueOf(a)
+			}
+		}
+		return
+	case oidType:
+		o := v.Interface().(ObjectIdentifier)
+		var a asn1.ObjectIdentifier
+		if a, err = o.ASN1E(); err == nil {
+			mv = reflect.ValueOf(a)
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		mv = reflect.Zero(m)
+		if v.IsNil() {
+			return
+		}
+		mv = reflect.MakeSlice(m, v.Len(), v.Len())
+		for i := 0; i < v.Len() && err == nil; i++ {
+			var e reflect.Value
+			if e, err = toASN1Mirror(v.Index(i), m.Elem()); err == nil {
+				mv.Index(i).Set(e)
+			}
+		}
+	case reflect.Struct:
+		mv = reflect.New(m).Elem()
+		for i := 0; i < v.NumField() && err == nil; i++ {
+			var f reflect.Value
+			if f, err = toASN1Mirror(v.Field(i), m.Field(i).Type); err == nil {
+				mv.Field(i).Set(f)
+			}
+		}
+	default:
+		err = errorw(ErrUnsupportedType, "Unsupported %s conversion to %s", v.Type(), m)
+	}
+
+	return
+}
+
+/*
+fromASN1Mirror assigns mv, an instance of the mirror type of dst, to dst following its conversion.
+*/
+func fromASN1Mirror(mv, dst reflect.Value) (err error) {
+	if mv.Type() == dst.Type() {
+		dst.Set(mv)
+		return
+	}
+
+	switch dst.Type() {
+	case oidPtrType, oidType:
+		a := mv.Interface().(asn1.ObjectIdentifier)
+		if len(a) == 0 {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+
+		var o *ObjectIdentifier
+		if o, err = NewFromInts([]int(a)); err != nil {
+			return
+		} else if dst.Type() == oidPtrType {
+			dst.Set(reflect.ValueOf(o))
+		} else {
+			dst.Set(reflect.ValueOf(*o))
+		}
+		return
+	}
+
+	switch dst.Kind() {
+	case reflect.Slice:
+		if mv.IsNil() {
+			dst.Set(reflect.Zero(dst.Type()))
+			return
+		}
+		s := reflect.MakeSlice(dst.Type(), mv.Len(), mv.Len())
+		for i := 0; i < mv.Len() && err == nil; i++ {
+			err = fromASN1Mirror(mv.Index(i), s.Index(i))
+		}
+		dst.Set(s)
+	case reflect.Struct:
+		for i := 0; i < dst.NumField() && err == nil; i++ {
+			err = fromASN1Mirror(mv.Field(i), dst.Field(i))
+		}
+	default:
+		err = errorw(ErrUnsupportedType, "Unsupported %s conversion to %s", mv.Type(), dst.Type())
+	}
+
+	return
+}
@@ -0,0 +1,95 @@
+package oid
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testAlgorithmIdentifier struct {
+	Algorithm  *ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type testExtKeyUsage struct {
+	Critical bool `asn1:"optional"`
+	Usages   []ObjectIdentifier
+}
+
+type testUnexported struct {
+	Algorithm *ObjectIdentifier
+	secret    int
+}
+
+func TestMarshalASN1(t *testing.T) {
+	sha256 := mustDot(t, `2.16.840.1.101.3.4.2.1`)
+	serverAuth := mustDot(t, `1.3.6.1.5.5.7.3.1`)
+	clientAuth := mustDot(t, `1.3.6.1.5.5.7.3.2`)
+
+	for _, tc := range []struct {
+		name   string
+		val    any        // the value to marshal
+		mirror any        // the equivalent value bearing asn1.ObjectIdentifier instances
+		empty  func() any // returns a pointer to a zero instance of the type of val
+	}{
+		{`pointer field`, testAlgorithmIdentifier{Algorithm: sha256},
+			struct{ Algorithm asn1.ObjectIdentifier }{sha256.ASN1()},
+			func() any { return new(testAlgorithmIdentifier) }},
+		{`slice field`, testExtKeyUsage{Usages: []ObjectIdentifier{*serverAuth, *clientAuth}},
+			struct {
+				Critical bool `asn1:"optional"`
+				Usages   []asn1.ObjectIdentifier
+			}{Usages: []asn1.ObjectIdentifier{serverAuth.ASN1(), clientAuth.ASN1()}},
+			func() any { return new(testExtKeyUsage) }},
+		{`top level`, []*ObjectIdentifier{serverAuth, clientAuth},
+			[]asn1.ObjectIdentifier{serverAuth.ASN1(), clientAuth.ASN1()},
+			func() any { return new([]*ObjectIdentifier) }},
+		{`no OIDs`, struct{ N int }{42}, struct{ N int }{42},
+			func() any { return new(struct{ N int }) }},
+	} {
+		b, err := MarshalASN1(tc.val)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+
+		want, _ := asn1.Marshal(tc.mirror)
+		if !bytes.Equal(b, want) {
+			t.Errorf("%s: got %x, want %x", tc.name, b, want)
+		}
+
+		dst := tc.empty()
+		if rest, err := UnmarshalASN1(b, dst); err != nil || len(rest) > 0 {
+			t.Errorf("%s: got %v with %d trailing octets", tc.name, err, len(rest))
+		} else if got, _ := MarshalASN1(reflect.ValueOf(dst).Elem().Interface()); !bytes.Equal(got, b) {
+			t.Errorf("%s: round trip yields %x, want %x", tc.name, got, b)
+		}
+	}
+}
+
+func TestMarshalASN1Errors(t *testing.T) {
+	huge := mustDot(t, `2.25.329800735698586629295641978511506172918`)
+
+	for _, tc := range []struct {
+		name string
+		fn   func() error
+		err  error
+	}{
+		{`unexported`, func() error { _, err := MarshalASN1(testUnexported{}); return err }, ErrUnsupportedType},
+		{`huge arc`, func() error {
+			_, err := MarshalASN1(testAlgorithmIdentifier{Algorithm: huge})
+			return err
+		}, ErrInvalidNumberForm},
+		{`non-pointer`, func() error {
+			_, err := UnmarshalASN1([]byte{0x06, 0x01, 0x2a}, testAlgorithmIdentifier{})
+			return err
+		}, ErrUnsupportedType},
+		{`nil`, func() error { _, err := UnmarshalASN1([]byte{0x06, 0x01, 0x2a}, nil); return err }, ErrUnsupportedType},
+	} {
+		if err := tc.fn(); !errors.Is(err, tc.err) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package oid
+
+/*
+audit.go deals with the append-only audit trail of Registry mutations.
+*/
+
+import (
+	"sync"
+	"time"
+)
+
+/*
+AuditAction describes the kind of Registry mutation recorded by an AuditRecord.
+*/
+type AuditAction uint8
+
+const (
+	AuditSet      AuditAction = iota // an OID was assigned by Set
+	AuditDelete                      // an OID was removed by Delete
+	AuditAllocate                    // an OID was assigned by Allocate
+	AuditRollback                    // an OID was restored by Rollback
+	AuditExpire                      // an OID was flagged as obsolete by Sweep
+)
+
+var auditActionNames []string = []string{`set`, `delete`, `allocate`, `rollback`, `expire`}
+
+/*
+String returns the string name of the receiver, e.g. "allocate".
+*/
+func (a AuditAction) String() string {
+	if int(a) < len(auditActionNames) {
+		return auditActionNames[a]
+	}
+	return sprintf("AuditAction(%d)", uint8(a))
+}
+
+/*
+AuditRecord describes a single Registry mutation: who performed it, when, and what was affected.
+*/
+type AuditRecord struct {
+	Time      time.Time
+	Principal string
+	Action    AuditAction
+	Key       string
+	Dot       string
+}
+
+/*
+AuditLog is an append-only trail of Registry mutations. Records cannot be altered or removed once appended.
+
+The zero value is not ready for use; see NewAuditLog.
+*/
+type AuditLog struct {
+	mu        sync.Mutex
+	principal func() string
+	records   []AuditRecord
+}
+
+/*
+NewAuditLog returns a new, empty instance of *AuditLog. The principal function, which may be nil, is called upon each mutation to identify the party responsible for it, e.g. the authenticated user of an application.
+*/
+func NewAuditLog(principal func() string) *AuditLog {
+	return &AuditLog{principal: principal}
+}
+
+/*
+Len returns the number of records present within the receiver.
+*/
+func (a *AuditLog) Len() int {
+	if a == nil {
+		return 0
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.records)
+}
+
+/*
+Records returns all records present within the receiver, in the order in which they were appended.
+*/
+func (a *AuditLog) Records() []AuditRecord {
+	if a == nil {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]AuditRecord(nil), a.records...)
+}
+
+/*
+Trail returns the records present within the receiver which concern o, in the order in which they were appended.
+*/
+func (a *AuditLog) Trail(o *ObjectIdentifier) (trail []AuditRecord) {
+	if a == nil || o.IsZero() {
+		return
+	}
+
+	dot := o.DotNotation()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for i := 0; i < len(a.records); i++ {
+		if a.records[i].Dot == dot {
+			trail = append(trail, a.records[i])
+		}
+	}
+
+	return
+}
+
+/*
+append adds a record of action upon x, keyed by key, to the receiver.
+*/
+func (a *AuditLog) append(action AuditAction, key string, x *ObjectIdentifier) {
+	if a == nil {
+		return
+	}
+
+	rec := AuditRecord{
+		Time:   time.Now(),
+		Action: action,
+		Key:    key,
+		Dot:    x.DotNotation(),
+	}
+	if a.principal != nil {
+		rec.Principal = a.principal()
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.records = append(a.records, rec)
+}
+
+/*
+SetAuditLog assigns log as the audit trail of the receiver, to which all subsequent Set, Delete, Allocate and Rollback operations are recorded. A nil log disables auditing.
+*/
+func (r *Registry) SetAuditLog(log *AuditLog) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.audit = log
+}
+
+/*
+AuditLog returns the audit trail of the receiver, or nil if auditing is disabled.
+*/
+func (r *Registry) AuditLog() *AuditLog {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.audit
+}
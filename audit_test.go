@@ -0,0 +1,59 @@
+package oid
+
+import "testing"
+
+func TestAuditLog(t *testing.T) {
+	r := NewRegistry()
+	log := NewAuditLog(func() string { return `admin` })
+	r.SetAuditLog(log)
+	r.EnableHistory(true)
+
+	base := NewEnterpriseOID(56521)
+	_ = r.Set(`base`, base)
+	_, _ = r.Allocate(base, ``, `child`)
+	r.Delete(`child`)
+	_ = r.Rollback(`child`, 1)
+
+	want := []struct {
+		action AuditAction
+		key    string
+		dot    string
+	}{
+		{AuditSet, `base`, `1.3.6.1.4.1.56521`},
+		{AuditAllocate, `child`, `1.3.6.1.4.1.56521.1`},
+		{AuditDelete, `child`, `1.3.6.1.4.1.56521.1`},
+		{AuditRollback, `child`, `1.3.6.1.4.1.56521.1`},
+	}
+
+	recs := log.Records()
+	if log.Len() != len(want) || len(recs) != len(want) {
+		t.Fatalf("got %d records, want %d", len(recs), len(want))
+	}
+	for i, tc := range want {
+		if rec := recs[i]; rec.Action != tc.action || rec.Key != tc.key || rec.Dot != tc.dot || rec.Principal != `admin` || rec.Time.IsZero() {
+			t.Errorf("record #%d: got %+v, want %s %s %s", i, rec, tc.action, tc.key, tc.dot)
+		}
+	}
+
+	if trail := log.Trail(mustDot(t, `1.3.6.1.4.1.56521.1`)); len(trail) != 3 {
+		t.Errorf("Trail: got %d records, want 3", len(trail))
+	}
+
+	for _, tc := range []struct {
+		action AuditAction
+		want   string
+	}{
+		{AuditSet, `set`},
+		{AuditExpire, `expire`},
+		{AuditAction(99), `AuditAction(99)`},
+	} {
+		if got := tc.action.String(); got != tc.want {
+			t.Errorf("String: got %s, want %s", got, tc.want)
+		}
+	}
+
+	r.SetAuditLog(nil)
+	if r.AuditLog() != nil {
+		t.Errorf("SetAuditLog(nil): auditing still enabled")
+	}
+}
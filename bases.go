@@ -0,0 +1,105 @@
+package oid
+
+/*
+bases.go contains ready-made ObjectIdentifier instances for the root arcs and commonly used bases, as well as the Append method used to extend them.
+*/
+
+/*
+The following ready-made bases are used internally, and must not be modified. Callers obtain fresh copies of them by way of the functions below.
+*/
+var (
+	itutBase         = mustBase(`{ itu-t(0) }`)
+	isoBase          = mustBase(`{ iso(1) }`)
+	jointISOITUTBase = mustBase(`{ joint-iso-itu-t(2) }`)
+	internetBase     = mustBase(`{ iso(1) identified-organization(3) dod(6) internet(1) }`)
+	enterpriseBase   = mustBase(`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) }`)
+	pkixBase         = mustBase(`{ iso(1) identified-organization(3) dod(6) internet(1) security(5) mechanisms(5) pkix(7) }`)
+	ldapBase         = mustBase(`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 1466 }`)
+)
+
+/*
+mustBase returns the *ObjectIdentifier described by the NameAndNumberForm sequence x, panicking should x be malformed, which indicates a corrupt build.
+*/
+func mustBase(x string) *ObjectIdentifier {
+	o, err := NewFromNaNF(x)
+	if err != nil {
+		panic(err)
+	}
+
+	return o
+}
+
+/*
+ITUT returns a new instance of *ObjectIdentifier bearing the itu-t(0) root arc.
+*/
+func ITUT() *ObjectIdentifier { return itutBase.clone() }
+
+/*
+ISO returns a new instance of *ObjectIdentifier bearing the iso(1) root arc.
+*/
+func ISO() *ObjectIdentifier { return isoBase.clone() }
+
+/*
+JointISOITUT returns a new instance of *ObjectIdentifier bearing the joint-iso-itu-t(2) root arc.
+*/
+func JointISOITUT() *ObjectIdentifier { return jointISOITUTBase.clone() }
+
+/*
+Internet returns a new instance of *ObjectIdentifier bearing the Internet arc, 1.3.6.1, per RFC 1155.
+*/
+func Internet() *ObjectIdentifier { return internetBase.clone() }
+
+/*
+Enterprise returns a new instance of *ObjectIdentifier bearing the IANA Private Enterprise Number arc, 1.3.6.1.4.1. See also NewEnterpriseOID and PEN.
+*/
+func Enterprise() *ObjectIdentifier { return enterpriseBase.clone() }
+
+/*
+PKIX returns a new instance of *ObjectIdentifier bearing the id-pkix arc, 1.3.6.1.5.5.7, per RFC 5280.
+*/
+func PKIX() *ObjectIdentifier { return pkixBase.clone() }
+
+/*
+LDAP returns a new instance of *ObjectIdentifier bearing the arc beneath which the syntaxes, controls and extensions of RFC 4511 and RFC 4517 reside, 1.3.6.1.4.1.1466.
+*/
+func LDAP() *ObjectIdentifier { return ldapBase.clone() }
+
+/*
+Append returns a new instance of *ObjectIdentifier bearing the arcs of the receiver followed by those of x, alongside an error. The receiver is not modified, thus Append may be used upon shared instances, such as those of the WellKnown database, e.g.:
+
+	o, err := oid.Enterprise().Append(`example(56521)`, 1, 5)
+
+... returns:
+
+	{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) example(56521) 1 5 }
+
+Each of x may be of any type accepted by NewNameAndNumberForm. Names, alt names and other metadata of the receiver are not inherited, as they describe the receiver and not its descendants.
+*/
+func (o *ObjectIdentifier) Append(x ...any) (c *ObjectIdentifier, err error) {
+	if !o.valid() {
+		err = errorw(ErrInvalidRoot, "%T base did not pass validity checks", o)
+		return
+	} else if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No arcs for Append to read")
+		return
+	}
+
+	o.rlock()
+	t := newObjectIdentifier(len(o.nANF) + len(x))
+	t.nANF = append(t.nANF, o.nANF...)
+	o.runlock()
+
+	for i := 0; i < len(x); i++ {
+		var nanf *NameAndNumberForm
+		if nanf, err = NewNameAndNumberForm(x[i]); err != nil {
+			return
+		}
+		t.nANF = append(t.nANF, *nanf)
+	}
+
+	if err = t.checkValid(); err == nil {
+		c = t
+	}
+
+	return
+}
@@ -0,0 +1,80 @@
+package oid
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBases(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		base func() *ObjectIdentifier
+		dot  string
+	}{
+		{`ITUT`, ITUT, `0`},
+		{`ISO`, ISO, `1`},
+		{`JointISOITUT`, JointISOITUT, `2`},
+		{`Internet`, Internet, `1.3.6.1`},
+		{`Enterprise`, Enterprise, `1.3.6.1.4.1`},
+		{`PKIX`, PKIX, `1.3.6.1.5.5.7`},
+		{`LDAP`, LDAP, `1.3.6.1.4.1.1466`},
+	} {
+		o := tc.base()
+		if o.DotNotation() != tc.dot {
+			t.Errorf("%s: got %s, want %s", tc.name, o.DotNotation(), tc.dot)
+		} else if o == tc.base() {
+			t.Errorf("%s: returned a shared instance", tc.name)
+		} else if s := fmt.Sprint(o); s != o.String() {
+			t.Errorf("%s: printed as %s, want %s", tc.name, s, o.String())
+		}
+
+		// modifying a copy must not affect those obtained later
+		if err := o.SetName(`modified`); err != nil {
+			t.Fatal(err)
+		} else if name := tc.base().Name(); name != `` {
+			t.Errorf("%s: got name %q after modifying a copy", tc.name, name)
+		}
+	}
+
+	x, err := Enterprise().Append(56521, 1)
+	if err != nil {
+		t.Fatal(err)
+	} else if !x.IsUnderInternet() || !x.IsUnderEnterprise() {
+		t.Errorf("%s: not beneath the Internet and Enterprise arcs", x.DotNotation())
+	}
+}
+
+func TestAppend(t *testing.T) {
+	for _, tc := range []struct {
+		base *ObjectIdentifier
+		x    []any
+		want string
+		err  error
+	}{
+		{Enterprise(), []any{`example(56521)`, 1, 5}, `{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) example(56521) 1 5 }`, nil},
+		{ISO(), []any{3, `6`}, `{ iso(1) 3 6 }`, nil},
+		{JointISOITUT(), []any{uint(999)}, `{ joint-iso-itu-t(2) 999 }`, nil},
+		{JointISOITUT(), []any{uint64(999)}, ``, ErrUnsupportedType},
+		{Enterprise(), nil, ``, ErrEmptyInput},
+		{Enterprise(), []any{`Bad(1)`}, ``, ErrInvalidIdentifier},
+		{Enterprise(), []any{-1}, ``, ErrInvalidNumberForm},
+		{nil, []any{1}, ``, ErrInvalidRoot},
+	} {
+		c, err := tc.base.Append(tc.x...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%v: got error %v, want %v", tc.x, err, tc.err)
+		} else if err == nil && c.String() != tc.want {
+			t.Errorf("%v: got %s, want %s", tc.x, c.String(), tc.want)
+		}
+	}
+
+	// the receiver must not be modified
+	w, _ := WellKnown().Get(`commonName`)
+	before := w.DotNotation()
+	if _, err := w.Append(1); err != nil {
+		t.Fatal(err)
+	} else if w.DotNotation() != before {
+		t.Errorf("Append modified its receiver: got %s, want %s", w.DotNotation(), before)
+	}
+}
@@ -0,0 +1,118 @@
+package oid
+
+/*
+batch.go deals with the atomic application of multiple Registry mutations.
+*/
+
+/*
+RegistryTx is the set of operations available within a Registry batch. See Batch for details.
+
+*Registry also satisfies RegistryTx, allowing code to be written once for use both within and outside of a batch.
+*/
+type RegistryTx interface {
+	Set(key string, x *ObjectIdentifier) error
+	Delete(key string) bool
+	Allocate(base *ObjectIdentifier, label, name string) (*ObjectIdentifier, error)
+	Get(term any) (*ObjectIdentifier, bool)
+}
+
+/*
+registryTx implements RegistryTx by staging mutations against a copy of the contents of a Registry.
+*/
+type registryTx struct {
+	r      *Registry
+	oids   ObjectIdentifierMap
+	audits []stagedAudit
+}
+
+/*
+stagedAudit is an audit record awaiting the commit of a batch.
+*/
+type stagedAudit struct {
+	action AuditAction
+	key    string
+	x      *ObjectIdentifier
+}
+
+/*
+Batch calls fn with a RegistryTx through which any number of Set, Delete and Allocate operations may be staged. Operations performed through the RegistryTx observe those staged before them.
+
+Should fn return nil, all staged operations are committed to the receiver atomically and recorded to its audit trail and history, if enabled. Should fn return an error, or panic, none are committed, and the receiver is left unchanged.
+
+The receiver is locked for the duration of fn, thus fn must not call methods of the receiver directly.
+*/
+func (r *Registry) Batch(fn func(tx RegistryTx) error) (err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if fn == nil {
+		err = errorw(ErrNilInstance, "No batch function provided")
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tx := &registryTx{r: r, oids: make(ObjectIdentifierMap, len(r.oids))}
+	for k, v := range r.oids {
+		tx.oids[k] = v
+	}
+
+	if err = fn(tx); err != nil {
+		return
+	}
+
+	r.oids = tx.oids
+	for i := 0; i < len(tx.audits); i++ {
+		r.record(tx.audits[i].action, tx.audits[i].key, tx.audits[i].x)
+	}
+
+	return
+}
+
+func (tx *registryTx) Set(key string, x *ObjectIdentifier) (err error) {
+	if x.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", x)
+		return
+	}
+
+	if key, x, err = tx.r.place(tx.oids, key, x); err == nil {
+		tx.oids[key] = x
+		tx.audits = append(tx.audits, stagedAudit{AuditSet, key, x})
+	}
+
+	return
+}
+
+func (tx *registryTx) Delete(key string) (deleted bool) {
+	var x *ObjectIdentifier
+	if x, deleted = tx.oids[key]; deleted {
+		delete(tx.oids, key)
+		tx.audits = append(tx.audits, stagedAudit{AuditDelete, key, x})
+	}
+
+	return
+}
+
+func (tx *registryTx) Allocate(base *ObjectIdentifier, label, name string) (o *ObjectIdentifier, err error) {
+	if !base.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid base %T", base)
+		return
+	}
+
+	var key string
+	var t *ObjectIdentifier
+	if t, err = tx.r.allocate(tx.oids, base, label, name); err != nil {
+		return
+	} else if key, t, err = tx.r.place(tx.oids, t.Key(), t); err == nil {
+		tx.oids[key] = t
+		tx.audits = append(tx.audits, stagedAudit{AuditAllocate, key, t})
+		o = t
+	}
+
+	return
+}
+
+func (tx *registryTx) Get(term any) (*ObjectIdentifier, bool) {
+	return tx.oids.Get(term)
+}
@@ -0,0 +1,65 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	base := NewEnterpriseOID(56521)
+	errAbort := errors.New(`abort`)
+
+	for _, tc := range []struct {
+		name string
+		fn   func(RegistryTx) error
+		err  error
+		keys []string
+	}{
+		{`commit`, func(tx RegistryTx) (err error) {
+			if err = tx.Set(`a`, mustDot(t, `1.3.6.1.4.1.56521.7`)); err == nil {
+				_, err = tx.Allocate(base, ``, `b`)
+			}
+			tx.Delete(`old`)
+			return
+		}, nil, []string{`a`, `b`, `base`}},
+		{`staged reads`, func(tx RegistryTx) error {
+			_ = tx.Set(`a`, mustDot(t, `1.3.6.1.4.1.56521.7`))
+			if _, found := tx.Get(`a`); !found {
+				return errAbort
+			}
+			return nil
+		}, nil, []string{`a`, `base`, `old`}},
+		{`abort`, func(tx RegistryTx) error {
+			_ = tx.Set(`a`, mustDot(t, `1.3.6.1.4.1.56521.7`))
+			tx.Delete(`old`)
+			return errAbort
+		}, errAbort, []string{`base`, `old`}},
+		{`nil instance`, func(tx RegistryTx) error { return tx.Set(`a`, nil) }, ErrInvalidRoot, []string{`base`, `old`}},
+		{`no function`, nil, ErrNilInstance, []string{`base`, `old`}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRegistry()
+			_ = r.Set(`base`, base)
+			_ = r.Set(`old`, mustDot(t, `1.3.6.1.4.1.56521.99`))
+			r.EnableHistory(true)
+
+			if err := r.Batch(tc.fn); !errors.Is(err, tc.err) {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			}
+
+			keys := r.Map().SortedKeys()
+			if len(keys) != len(tc.keys) {
+				t.Fatalf("got keys %v, want %v", keys, tc.keys)
+			}
+			for _, k := range tc.keys {
+				if _, found := r.Get(k); !found {
+					t.Errorf("key %s missing from %v", k, keys)
+				}
+			}
+
+			if tc.err != nil && len(r.History(`a`)) != 0 {
+				t.Errorf("aborted batch recorded history")
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package oid
+
+/*
+bounds.go contains the structural bounds imposed upon number forms by ITU-T Rec. X.660 and X.690, which are used throughout validation and exposed for the benefit of tooling that presents the same constraints, e.g. within a user interface.
+*/
+
+const (
+	// MaxRootArc is the greatest number form permitted for the
+	// root arc: itu-t(0), iso(1) or joint-iso-itu-t(2).
+	MaxRootArc = 2
+
+	// MaxSecondArc is the greatest number form permitted for the
+	// second arc beneath the itu-t(0) and iso(1) root arcs. No
+	// such bound applies beneath joint-iso-itu-t(2).
+	MaxSecondArc = 39
+)
+
+/*
+ArcBound returns the greatest number form permitted for an immediate child of parent, alongside a Boolean value indicative of whether any such bound applies, e.g.:
+
+	max, bounded := oid.ArcBound(nil)       // 2, true (root arc)
+	max, bounded = oid.ArcBound(oid.ISO())  // 39, true
+	max, bounded = oid.ArcBound(oid.PKIX()) // 0, false
+
+A nil or empty parent denotes the root arc. Number forms are otherwise unbounded, save for any Limits in effect. Zero and false are returned should parent fail the Valid method.
+*/
+func ArcBound(parent *ObjectIdentifier) (max uint, bounded bool) {
+	if parent.IsZero() {
+		return MaxRootArc, true
+	}
+
+	arcs := parent.arcs()
+	switch {
+	case len(arcs) == 0:
+		max, bounded = MaxRootArc, true
+	case !parent.valid():
+	case len(arcs) == 1 && arcs[0].primaryIdentifier < MaxRootArc:
+		max, bounded = MaxSecondArc, true
+	}
+
+	return
+}
+
+/*
+secondArcExceeds returns a Boolean value indicative of whether the second arc y exceeds MaxSecondArc beneath the root arc x, which is presumed to be valid.
+*/
+func secondArcExceeds(x, y NameAndNumberForm) bool {
+	return x.primaryIdentifier < MaxRootArc && (y.huge != nil || y.primaryIdentifier > MaxSecondArc)
+}
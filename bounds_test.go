@@ -0,0 +1,37 @@
+package oid
+
+import "testing"
+
+func TestArcBound(t *testing.T) {
+	invalid := mustDot(t, `1.3`)
+	invalid.nANF[0].primaryIdentifier = MaxRootArc + 1
+
+	for _, tc := range []struct {
+		name    string
+		parent  *ObjectIdentifier
+		max     uint
+		bounded bool
+	}{
+		{`root`, nil, MaxRootArc, true},
+		{`itu-t`, ITUT(), MaxSecondArc, true},
+		{`iso`, ISO(), MaxSecondArc, true},
+		{`joint-iso-itu-t`, JointISOITUT(), 0, false},
+		{`pkix`, PKIX(), 0, false},
+		{`invalid`, invalid, 0, false},
+	} {
+		if max, bounded := ArcBound(tc.parent); max != tc.max || bounded != tc.bounded {
+			t.Errorf("%s: got %d, %t, want %d, %t", tc.name, max, bounded, tc.max, tc.bounded)
+		}
+	}
+}
+
+func TestSecondArcBound(t *testing.T) {
+	strict := []Option{WithStrict()}
+	checkOptionCases(t, []optionCase{
+		{[]int{1, MaxSecondArc}, strict, `1.39`, nil},
+		{[]int{1, MaxSecondArc + 1}, strict, ``, ErrInvalidNumberForm},
+		{[]int{0, MaxSecondArc + 1}, strict, ``, ErrInvalidNumberForm},
+		{[]int{2, 999}, strict, `2.999`, nil},
+		{[]int{MaxRootArc + 1, 1}, nil, ``, ErrInvalidRoot},
+	})
+}
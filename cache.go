@@ -0,0 +1,27 @@
+package oid
+
+/*
+cache.go deals with the caching of ObjectIdentifier string and asn1.ObjectIdentifier renderings.
+*/
+
+import (
+	"encoding/asn1"
+	"sync"
+)
+
+/*
+renderCache stores the representations of an ObjectIdentifier that are computed on first use. Copies of an ObjectIdentifier share the same *renderCache, which must therefore be invalidated whenever the arcs of the ObjectIdentifier are changed.
+*/
+type renderCache struct {
+	mu   sync.Mutex
+	dot  string
+	nanf string
+	asn1 asn1.ObjectIdentifier
+}
+
+/*
+invalidate discards any cached renderings of the receiver. It must be called by any method that alters the arcs of the receiver.
+*/
+func (o *ObjectIdentifier) invalidate() {
+	o.cache = new(renderCache)
+}
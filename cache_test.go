@@ -0,0 +1,55 @@
+package oid
+
+import "testing"
+
+/*
+TestRenderCacheInvalidation verifies that cached renderings are discarded when the arcs of an instance, or of a copy sharing its contents, are altered.
+*/
+func TestRenderCacheInvalidation(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		dot    string
+		mutate func(*ObjectIdentifier) error
+		str    string
+		wdot   string
+		arcs   int
+		shared bool // whether a prior copy shares the result
+	}{
+		{`infer`, `1.3.6.1`,
+			func(o *ObjectIdentifier) error { return o.InferNames() },
+			`{ iso(1) identified-organization(3) dod(6) internet(1) }`, `1.3.6.1`, 4, true},
+		{`unmarshal`, `1.3.6.1`,
+			func(o *ObjectIdentifier) error { return o.UnmarshalJSON([]byte(`{"dotNotation":"2.5.4.3"}`)) },
+			`{ 2 5 4 3 }`, `2.5.4.3`, 4, false},
+		{`rename`, `2.999`,
+			func(o *ObjectIdentifier) error { return o.SetName(`example`) },
+			`{ 2 example(999) }`, `2.999`, 2, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o := mustDot(t, tc.dot)
+			cp := *o
+
+			// prime the cache
+			_, _, _ = o.String(), o.DotNotation(), o.ASN1()
+			if err := tc.mutate(o); err != nil {
+				t.Fatal(err)
+			}
+
+			check := []ObjectIdentifier{*o}
+			if tc.shared {
+				check = append(check, cp)
+			}
+			for _, x := range check {
+				if s := x.String(); s != tc.str {
+					t.Errorf("String: got %q, want %q", s, tc.str)
+				}
+				if d := x.DotNotation(); d != tc.wdot {
+					t.Errorf("DotNotation: got %q, want %q", d, tc.wdot)
+				}
+				if n := len(x.ASN1()); n != tc.arcs {
+					t.Errorf("ASN1: got %d arcs, want %d", n, tc.arcs)
+				}
+			}
+		})
+	}
+}
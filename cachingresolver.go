@@ -0,0 +1,155 @@
+package oid
+
+/*
+cachingresolver.go contains CachingResolver, a read-through cache decorating any Resolver.
+*/
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+CachingResolver is a Resolver which caches the answers of another Resolver, e.g. one performing remote OID Resolution System (ORS) or HTTP lookups, such that lookups of hot OIDs are not repeated.
+
+The cache is bounded in size, evicting the least recently used entry when full, and entries expire after a fixed time-to-live. Answers wrapping ErrNotFound are cached as well; all other errors are not.
+
+Instances are safe for concurrent use.
+*/
+type CachingResolver struct {
+	r    Resolver
+	size int
+	ttl  time.Duration
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+/*
+resolverCacheEntry is a single cached answer.
+*/
+type resolverCacheEntry struct {
+	dot     string
+	oid     *ObjectIdentifier
+	err     error
+	expires time.Time
+}
+
+/*
+NewCachingResolver returns a new instance of *CachingResolver which caches up to size answers of r, each for the duration of ttl. A size of zero (0) or less imposes no bound, while a ttl of zero (0) or less means entries never expire.
+*/
+func NewCachingResolver(r Resolver, size int, ttl time.Duration) *CachingResolver {
+	return &CachingResolver{
+		r:     r,
+		size:  size,
+		ttl:   ttl,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+/*
+Resolve returns the cached answer for dot if present and unexpired; otherwise the underlying Resolver is consulted and its answer cached. This method satisfies the Resolver interface.
+*/
+func (c *CachingResolver) Resolve(ctx context.Context, dot string) (o *ObjectIdentifier, err error) {
+	if c == nil || c.r == nil {
+		err = errorw(ErrNilInstance, "%T is nil", c)
+		return
+	}
+
+	c.mu.Lock()
+	if el, found := c.items[dot]; found {
+		ent := el.Value.(*resolverCacheEntry)
+		if ent.expires.IsZero() || time.Now().Before(ent.expires) {
+			c.ll.MoveToFront(el)
+			c.mu.Unlock()
+			c.hits.Add(1)
+			return ent.oid, ent.err
+		}
+		c.ll.Remove(el)
+		delete(c.items, dot)
+	}
+	c.mu.Unlock()
+
+	c.misses.Add(1)
+	if o, err = c.r.Resolve(ctx, dot); err != nil && !errors.Is(err, ErrNotFound) {
+		return
+	}
+
+	ent := &resolverCacheEntry{dot: dot, oid: o, err: err}
+	if c.ttl > 0 {
+		ent.expires = time.Now().Add(c.ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, found := c.items[dot]; found {
+		c.ll.Remove(el)
+	}
+	c.items[dot] = c.ll.PushFront(ent)
+	for c.size > 0 && c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*resolverCacheEntry).dot)
+	}
+
+	return
+}
+
+/*
+Hits returns the number of lookups answered from the cache.
+*/
+func (c *CachingResolver) Hits() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.hits.Load()
+}
+
+/*
+Misses returns the number of lookups passed to the underlying Resolver.
+*/
+func (c *CachingResolver) Misses() uint64 {
+	if c == nil {
+		return 0
+	}
+	return c.misses.Load()
+}
+
+/*
+Len returns the number of answers currently cached, including any which have expired but have yet to be evicted.
+*/
+func (c *CachingResolver) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.ll.Len()
+}
+
+/*
+Purge discards all cached answers. The hit and miss counters are not reset.
+*/
+func (c *CachingResolver) Purge() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
@@ -0,0 +1,81 @@
+package oid
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+/*
+countingResolver wraps an ObjectIdentifierMap, counting the lookups it receives and failing any for which fail is true.
+*/
+type countingResolver struct {
+	m     ObjectIdentifierMap
+	calls int
+	fail  bool
+}
+
+func (r *countingResolver) Resolve(ctx context.Context, dot string) (*ObjectIdentifier, error) {
+	r.calls++
+	if r.fail {
+		return nil, errors.New("backend unavailable")
+	}
+	return r.m.Resolve(ctx, dot)
+}
+
+func TestCachingResolver(t *testing.T) {
+	m := ObjectIdentifierMap{
+		`2.5.4.3`: mustDot(t, `2.5.4.3`),
+		`2.5.4.4`: mustDot(t, `2.5.4.4`),
+		`2.5.4.5`: mustDot(t, `2.5.4.5`),
+	}
+
+	for _, tc := range []struct {
+		name    string
+		size    int
+		ttl     time.Duration
+		fail    bool
+		lookups []string
+		calls   int
+		hits    uint64
+		len     int
+	}{
+		{`hits`, 0, 0, false, []string{`2.5.4.3`, `2.5.4.3`, `2.5.4.4`, `2.5.4.3`}, 2, 2, 2},
+		{`not found`, 0, 0, false, []string{`2.5.4.9`, `2.5.4.9`}, 1, 1, 1},
+		{`evicted`, 2, 0, false, []string{`2.5.4.3`, `2.5.4.4`, `2.5.4.3`, `2.5.4.5`, `2.5.4.4`}, 4, 1, 2},
+		{`expired`, 0, time.Nanosecond, false, []string{`2.5.4.3`, `2.5.4.3`}, 2, 0, 1},
+		{`failure`, 0, 0, true, []string{`2.5.4.3`, `2.5.4.3`}, 2, 0, 0},
+	} {
+		r := &countingResolver{m: m, fail: tc.fail}
+		c := NewCachingResolver(r, tc.size, tc.ttl)
+		for _, dot := range tc.lookups {
+			if tc.ttl > 0 {
+				time.Sleep(time.Millisecond)
+			}
+			o, err := c.Resolve(context.Background(), dot)
+			if err == nil && o.DotNotation() != dot {
+				t.Errorf("%s: got %s, want %s", tc.name, o.DotNotation(), dot)
+			}
+		}
+
+		if r.calls != tc.calls || c.Misses() != uint64(tc.calls) {
+			t.Errorf("%s: got %d calls and %d misses, want %d", tc.name, r.calls, c.Misses(), tc.calls)
+		}
+		if h := c.Hits(); h != tc.hits {
+			t.Errorf("%s: got %d hits, want %d", tc.name, h, tc.hits)
+		}
+		if n := c.Len(); n != tc.len {
+			t.Errorf("%s: got Len %d, want %d", tc.name, n, tc.len)
+		}
+
+		c.Purge()
+		if n := c.Len(); n != 0 {
+			t.Errorf("%s: got Len %d following Purge", tc.name, n)
+		}
+	}
+
+	if _, err := (*CachingResolver)(nil).Resolve(context.Background(), `2.5`); !errors.Is(err, ErrNilInstance) {
+		t.Errorf("Resolve: got %v, want %v", err, ErrNilInstance)
+	}
+}
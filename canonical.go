@@ -0,0 +1,139 @@
+package oid
+
+/*
+canonical.go contains the optional process-wide table of canonical ObjectIdentifier instances.
+*/
+
+import (
+	"encoding/asn1"
+	"sync"
+)
+
+/*
+canonicalCache maps the DER content octets of an OID to its shared *ObjectIdentifier instance.
+*/
+var canonicalCache struct {
+	sync.RWMutex
+	enabled bool
+	m       map[string]*ObjectIdentifier
+}
+
+/*
+UseCanonicalCache enables or disables the process-wide canonical cache consulted by Canonical and CanonicalOf. Disabling the cache also empties it. The cache is disabled by default.
+*/
+func UseCanonicalCache(enabled bool) {
+	canonicalCache.Lock()
+	defer canonicalCache.Unlock()
+
+	canonicalCache.enabled = enabled
+	canonicalCache.m = nil
+	if enabled {
+		canonicalCache.m = make(map[string]*ObjectIdentifier)
+	}
+}
+
+/*
+CanonicalLen returns the number of ObjectIdentifier instances held by the canonical cache.
+*/
+func CanonicalLen() int {
+	canonicalCache.RLock()
+	defer canonicalCache.RUnlock()
+
+	return len(canonicalCache.m)
+}
+
+/*
+Canonical parses x and returns the shared *ObjectIdentifier instance bearing the same DER encoding, alongside an error. Supported input types are those of NewObjectIdentifier, as well as dotNotation strings and asn1.ObjectIdentifier instances.
+
+When the canonical cache is enabled (see UseCanonicalCache), repeated parsing of the same OID returns the same instance without further allocation. As instances are shared process-wide, they must be treated as immutable; callers must not use SetAltNames or any other method that alters them. The identifiers of the first instance cached for a given OID are those returned for all subsequent matches.
+
+When the canonical cache is disabled, a new instance is returned on each call.
+*/
+func Canonical(x any) (o *ObjectIdentifier, err error) {
+	switch tv := x.(type) {
+	case string:
+		p := AcquireParser()
+		defer ReleaseParser(p)
+
+		var t *ObjectIdentifier
+		if isDotNotation(tv) {
+			t, err = p.ParseDot(tv)
+		} else {
+			t, err = p.Parse(tv)
+		}
+
+		if err == nil {
+			o = canonicalOf(t, true)
+		}
+	case asn1.ObjectIdentifier:
+		if o, err = NewObjectIdentifier([]int(tv)); err == nil {
+			o = CanonicalOf(o)
+		}
+	default:
+		if o, err = NewObjectIdentifier(x); err == nil {
+			o = CanonicalOf(o)
+		}
+	}
+
+	return
+}
+
+/*
+CanonicalOf returns the shared *ObjectIdentifier instance bearing the same DER encoding as o. If the canonical cache is enabled and holds no such instance, o is cached and returned. If the cache is disabled, or o cannot be DER encoded, o is returned as-is.
+*/
+func CanonicalOf(o *ObjectIdentifier) *ObjectIdentifier {
+	return canonicalOf(o, false)
+}
+
+/*
+canonicalOf implements CanonicalOf. If transient is true, o is owned by a *Parser and a copy is cached in its place.
+*/
+func canonicalOf(o *ObjectIdentifier, transient bool) *ObjectIdentifier {
+	var scratch [32]byte
+	key, err := o.appendDERContent(scratch[:0])
+
+	canonicalCache.RLock()
+	enabled := canonicalCache.enabled
+	shared, found := canonicalCache.m[string(key)]
+	canonicalCache.RUnlock()
+
+	if found {
+		return shared
+	} else if !enabled || err != nil {
+		if transient {
+			return o.clone()
+		}
+		return o
+	}
+
+	if transient {
+		o = o.clone()
+	}
+
+	canonicalCache.Lock()
+	defer canonicalCache.Unlock()
+	if shared, found = canonicalCache.m[string(key)]; found {
+		return shared
+	} else if canonicalCache.m != nil {
+		canonicalCache.m[string(key)] = o
+	}
+
+	return o
+}
+
+/*
+is 'val' a dotNotation value, e.g. 1.3.6.1?
+*/
+func isDotNotation(val string) bool {
+	if len(val) == 0 || val[0] == '.' || val[len(val)-1] == '.' {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		if !('0' <= val[i] && val[i] <= '9') && val[i] != '.' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	t.Cleanup(func() { UseCanonicalCache(false) })
+
+	inputs := []any{
+		`1.3.6.1.5.5.7.3.1`,
+		`{ iso(1) identified-organization(3) dod(6) internet(1) security(5) mechanisms(5) pkix(7) kp(3) serverAuth(1) }`,
+		asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 3, 1},
+		[]int{1, 3, 6, 1, 5, 5, 7, 3, 1},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		enabled bool
+		size    int
+	}{
+		{`disabled`, false, 0},
+		{`enabled`, true, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			UseCanonicalCache(tc.enabled)
+
+			var first *ObjectIdentifier
+			for i, x := range inputs {
+				o, err := Canonical(x)
+				if err != nil {
+					t.Fatalf("%v: %v", x, err)
+				} else if d := o.DotNotation(); d != `1.3.6.1.5.5.7.3.1` {
+					t.Errorf("%v: got %s", x, d)
+				}
+
+				if i == 0 {
+					first = o
+				} else if shared := o == first; shared != tc.enabled {
+					t.Errorf("%v: got shared %t, want %t", x, shared, tc.enabled)
+				}
+			}
+
+			if n := CanonicalLen(); n != tc.size {
+				t.Errorf("CanonicalLen: got %d, want %d", n, tc.size)
+			}
+			if _, err := Canonical(`3.1`); err == nil {
+				t.Errorf("Canonical: expected error for invalid input")
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package oid
+
+/*
+canonicalize.go deals with the normalization of the names and identifiers of an ObjectIdentifier against the WellKnown database.
+*/
+
+/*
+Canonicalize returns a copy of the receiver whose names and identifiers have been normalized against the WellKnown database, such that registries built from heterogeneous sources become consistent:
+
+  - Each arc lacking an identifier is assigned the preferred identifier of the corresponding well-known OID, if any
+  - Each arc whose identifier is a synonym of the corresponding well-known OID is assigned its preferred identifier instead
+  - A missing principal name is filled in using that of the corresponding well-known OID, and a principal name which is a synonym is replaced by it
+  - Alt names are deduplicated in a case-insensitive manner, and any alt name equal to the principal name is removed
+
+The preferred identifier of a well-known OID is the identifier of its final arc, or else the first of its names that qualifies as an ASN.1 identifier. Arc identifiers and names unknown to the WellKnown database are left as-is.
+
+A nil instance is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) Canonicalize() (c *ObjectIdentifier) {
+	if o.IsZero() {
+		return
+	}
+
+	c = o.clone()
+	c.noFold = o.noFold
+	c.descrNames = o.descrNames
+
+	dot := make([]byte, 0, 32)
+	for i := 0; i < len(c.nANF); i++ {
+		if i > 0 {
+			dot = append(dot, '.')
+		}
+		dot = c.nANF[i].appendNumber(dot)
+
+		wk, found := WellKnown()[string(dot)]
+		if !found {
+			continue
+		}
+
+		if id := wk.preferredIdentifier(); len(id) > 0 {
+			if cur := c.nANF[i].identifier; len(cur) == 0 || wk.isSynonym(cur) {
+				c.nANF[i].identifier = id
+			}
+		}
+
+		if name := wk.Name(); i == len(c.nANF)-1 && len(name) > 0 {
+			if len(c.name) == 0 || wk.isSynonym(c.name) {
+				c.name = name
+			}
+		}
+	}
+
+	aka := c.aka
+	c.aka = nil
+	for i := 0; i < len(aka); i++ {
+		if len(aka[i]) == 0 || eq(aka[i], c.name) {
+			continue
+		}
+
+		var dup bool
+		for j := 0; j < len(c.aka) && !dup; j++ {
+			dup = eq(c.aka[j], aka[i])
+		}
+		if !dup {
+			c.aka = append(c.aka, aka[i])
+		}
+	}
+
+	return
+}
+
+/*
+preferredIdentifier returns the identifier of the final arc of the receiver or, if unset, the first of its names that qualifies as an ASN.1 identifier.
+*/
+func (o *ObjectIdentifier) preferredIdentifier() string {
+	if id := o.NameAndNumberForm().identifier; len(id) > 0 {
+		return id
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	if isIdentifier(o.name) {
+		return o.name
+	}
+
+	for i := 0; i < len(o.aka); i++ {
+		if isIdentifier(o.aka[i]) {
+			return o.aka[i]
+		}
+	}
+
+	return ``
+}
+
+/*
+isSynonym returns a Boolean value indicative of whether name matches, in a case-insensitive manner, the identifier of the final arc, the principal name or any alt name of the receiver.
+*/
+func (o *ObjectIdentifier) isSynonym(name string) bool {
+	if eq(o.NameAndNumberForm().identifier, name) {
+		return true
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	if eq(o.name, name) {
+		return true
+	}
+
+	for i := 0; i < len(o.aka); i++ {
+		if eq(o.aka[i], name) {
+			return true
+		}
+	}
+
+	return false
+}
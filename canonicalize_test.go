@@ -0,0 +1,51 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		name string
+		aka  []string
+		str  string
+		want string // the expected principal name
+		alts string // the expected alt names, comma-delimited
+	}{
+		{`{ 2 5 4 3 }`, ``, nil,
+			`{ 2 5 4 cn(3) }`, `cn`, ``},
+		{`{ 2 5 4 CN(3) }`, `COMMONNAME`, []string{`cn`, `CommonName`, `label`, `LABEL`},
+			`{ 2 5 4 cn(3) }`, `cn`, `CommonName,label`},
+		{`{ 2 5 4 myCN(3) }`, `myCommonName`, nil,
+			`{ 2 5 4 myCN(3) }`, `myCommonName`, ``},
+		{`{ 2 999 example(1) }`, `example`, []string{`EXAMPLE`, `sample`},
+			`{ 2 999 example(1) }`, `example`, `sample`},
+	} {
+		o, err := NewObjectIdentifier(tc.in, WithCasePolicy(CaseLenient))
+		if err != nil {
+			t.Fatalf("%s: %v", tc.in, err)
+		}
+		o.name = tc.name
+		o.aka = tc.aka
+
+		c := o.Canonicalize()
+		if s := c.String(); s != tc.str {
+			t.Errorf("%s: got %s, want %s", tc.in, s, tc.str)
+		}
+		if n := c.Name(); n != tc.want {
+			t.Errorf("%s: got name %q, want %q", tc.in, n, tc.want)
+		}
+		if a := strings.Join(c.AltNames(), `,`); a != tc.alts {
+			t.Errorf("%s: got alt names %q, want %q", tc.in, a, tc.alts)
+		}
+		if o.Name() != tc.name {
+			t.Errorf("%s: the receiver was altered", tc.in)
+		}
+	}
+
+	if (*ObjectIdentifier)(nil).Canonicalize() != nil {
+		t.Errorf("Canonicalize: got non-nil result for nil receiver")
+	}
+}
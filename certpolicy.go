@@ -0,0 +1,77 @@
+package oid
+
+/*
+certpolicy.go contains the means by which CA/Browser Forum certificate policies are classified. The policy OIDs themselves reside within the "certpolicy" table of wellknown.tsv.
+*/
+
+import "encoding/asn1"
+
+/*
+ValidationLevel describes the subscriber validation level asserted by a CA/Browser Forum certificate policy.
+*/
+type ValidationLevel uint8
+
+const (
+	ValidationUnknown ValidationLevel = iota // not a recognized CA/Browser Forum TLS or EV policy
+	ValidationDV                             // domain validated
+	ValidationIV                             // individual validated
+	ValidationOV                             // organization validated
+	ValidationEV                             // extended validation
+)
+
+var validationLevelNames []string = []string{`unknown`, `DV`, `IV`, `OV`, `EV`}
+
+/*
+String returns the string name of the receiver, e.g. "EV".
+*/
+func (v ValidationLevel) String() string {
+	if int(v) < len(validationLevelNames) {
+		return validationLevelNames[v]
+	}
+	return sprintf("ValidationLevel(%d)", uint8(v))
+}
+
+/*
+PolicyValidationLevel returns the ValidationLevel asserted by the certificate policy x, per the CA/Browser Forum Baseline Requirements and EV Guidelines. ValidationUnknown is returned for any other policy, including those defined by individual CAs.
+*/
+func PolicyValidationLevel(x asn1.ObjectIdentifier) ValidationLevel {
+	switch x.String() {
+	case `2.23.140.1.1`, `2.23.140.1.3`:
+		return ValidationEV
+	case `2.23.140.1.2.1`:
+		return ValidationDV
+	case `2.23.140.1.2.2`:
+		return ValidationOV
+	case `2.23.140.1.2.3`:
+		return ValidationIV
+	}
+
+	return ValidationUnknown
+}
+
+/*
+CertificateValidationLevel returns the highest ValidationLevel asserted by any of the provided certificate policies, such as those found within the PolicyIdentifiers field of a parsed x509.Certificate. Levels are ranked DV, IV, OV and EV, in ascending order.
+*/
+func CertificateValidationLevel(policies []asn1.ObjectIdentifier) (level ValidationLevel) {
+	for i := 0; i < len(policies); i++ {
+		if l := PolicyValidationLevel(policies[i]); l > level {
+			level = l
+		}
+	}
+
+	return
+}
+
+/*
+IsAnyPolicy returns a Boolean value indicative of whether x is the RFC 5280 anyPolicy identifier.
+*/
+func IsAnyPolicy(x asn1.ObjectIdentifier) bool {
+	return x.String() == `2.5.29.32.0`
+}
+
+/*
+IsCABFPolicy returns a Boolean value indicative of whether x resides beneath the CA/Browser Forum certificate policies arc (2.23.140.1).
+*/
+func IsCABFPolicy(x asn1.ObjectIdentifier) bool {
+	return len(x) > 4 && x[0] == 2 && x[1] == 23 && x[2] == 140 && x[3] == 1
+}
@@ -0,0 +1,81 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"testing"
+)
+
+func TestPolicyValidationLevel(t *testing.T) {
+	for _, tc := range []struct {
+		policy asn1.ObjectIdentifier
+		level  ValidationLevel
+		anyPol bool
+		cabf   bool
+	}{
+		{asn1.ObjectIdentifier{2, 23, 140, 1, 1}, ValidationEV, false, true},
+		{asn1.ObjectIdentifier{2, 23, 140, 1, 3}, ValidationEV, false, true},
+		{asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}, ValidationDV, false, true},
+		{asn1.ObjectIdentifier{2, 23, 140, 1, 2, 2}, ValidationOV, false, true},
+		{asn1.ObjectIdentifier{2, 23, 140, 1, 2, 3}, ValidationIV, false, true},
+		{asn1.ObjectIdentifier{2, 23, 140, 1, 4, 1}, ValidationUnknown, false, true},
+		{asn1.ObjectIdentifier{2, 23, 140, 1}, ValidationUnknown, false, false},
+		{asn1.ObjectIdentifier{2, 5, 29, 32, 0}, ValidationUnknown, true, false},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 6449, 1, 2, 1, 5, 1}, ValidationUnknown, false, false},
+		{nil, ValidationUnknown, false, false},
+	} {
+		if l := PolicyValidationLevel(tc.policy); l != tc.level {
+			t.Errorf("%s: got %s, want %s", tc.policy, l, tc.level)
+		}
+		if a := IsAnyPolicy(tc.policy); a != tc.anyPol {
+			t.Errorf("%s: got IsAnyPolicy %t, want %t", tc.policy, a, tc.anyPol)
+		}
+		if c := IsCABFPolicy(tc.policy); c != tc.cabf {
+			t.Errorf("%s: got IsCABFPolicy %t, want %t", tc.policy, c, tc.cabf)
+		}
+	}
+
+	if s := ValidationLevel(9).String(); s != `ValidationLevel(9)` {
+		t.Errorf("String: got %q, want ValidationLevel(9)", s)
+	}
+}
+
+func TestCertificateValidationLevel(t *testing.T) {
+	var (
+		ev  = asn1.ObjectIdentifier{2, 23, 140, 1, 1}
+		dv  = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1}
+		ov  = asn1.ObjectIdentifier{2, 23, 140, 1, 2, 2}
+		cps = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 2, 1}
+	)
+
+	for _, tc := range []struct {
+		policies []asn1.ObjectIdentifier
+		want     ValidationLevel
+	}{
+		{[]asn1.ObjectIdentifier{dv}, ValidationDV},
+		{[]asn1.ObjectIdentifier{dv, ov}, ValidationOV},
+		{[]asn1.ObjectIdentifier{ev, ov, dv}, ValidationEV},
+		{[]asn1.ObjectIdentifier{cps}, ValidationUnknown},
+		{nil, ValidationUnknown},
+	} {
+		if l := CertificateValidationLevel(tc.policies); l != tc.want {
+			t.Errorf("%v: got %s, want %s", tc.policies, l, tc.want)
+		}
+	}
+}
+
+func TestCertificatePolicyNames(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		dot  string
+	}{
+		{`anyPolicy`, `2.5.29.32.0`},
+		{`id-qt-cps`, `1.3.6.1.5.5.7.2.1`},
+		{`unotice`, `1.3.6.1.5.5.7.2.2`},
+		{`domain-validated`, `2.23.140.1.2.1`},
+		{`extended-validation`, `2.23.140.1.1`},
+	} {
+		if o, found := WellKnown().Get(tc.name); !found || o.DotNotation() != tc.dot {
+			t.Errorf("Get(%s): got %v, want %s", tc.name, o, tc.dot)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package oid
+
+/*
+cluster.go deals with measuring the distance between OIDs and grouping OIDs by their registered ancestors.
+*/
+
+import "sort"
+
+/*
+CommonPrefixLen returns the number of leading arcs, compared by number form, shared by a and b. Zero (0) is returned if either is nil.
+*/
+func CommonPrefixLen(a, b *ObjectIdentifier) (n int) {
+	x, y := a.arcs(), b.arcs()
+	for n < len(x) && n < len(y) && x[n].cmp(y[n]) == 0 {
+		n++
+	}
+
+	return
+}
+
+/*
+Cluster is a group of OIDs sharing the same nearest registered ancestor.
+*/
+type Cluster struct {
+	// Ancestor is the nearest registered ancestor shared by all
+	// members, or nil for members having no registered ancestor.
+	Ancestor *ObjectIdentifier
+
+	// Members contains the OIDs of the cluster, in their original
+	// order.
+	Members []*ObjectIdentifier
+}
+
+/*
+Cluster groups oids by their nearest ancestor registered within the receiver, e.g. in order to report certificate extensions by vendor arc. Only strict ancestors are considered, thus an OID registered within the receiver is not its own ancestor.
+
+Clusters are ordered by ancestor, with any cluster of OIDs having no registered ancestor appearing last. Nil members of oids are skipped.
+*/
+func (o ObjectIdentifierMap) Cluster(oids []*ObjectIdentifier) (clusters []Cluster) {
+	byDot := make(map[string]*ObjectIdentifier, len(o))
+	for _, v := range o {
+		if !v.IsZero() {
+			byDot[v.DotNotation()] = v
+		}
+	}
+
+	index := make(map[*ObjectIdentifier]int)
+	orphans := -1
+	for i := 0; i < len(oids); i++ {
+		if oids[i].IsZero() {
+			continue
+		}
+
+		anc := nearestAncestor(oids[i], byDot)
+		if anc == nil {
+			if orphans == -1 {
+				orphans = len(clusters)
+				clusters = append(clusters, Cluster{})
+			}
+			clusters[orphans].Members = append(clusters[orphans].Members, oids[i])
+			continue
+		}
+
+		idx, found := index[anc]
+		if !found {
+			idx = len(clusters)
+			index[anc] = idx
+			clusters = append(clusters, Cluster{Ancestor: anc})
+		}
+		clusters[idx].Members = append(clusters[idx].Members, oids[i])
+	}
+
+	sort.SliceStable(clusters, func(i, j int) bool {
+		a, b := clusters[i].Ancestor, clusters[j].Ancestor
+		if a == nil || b == nil {
+			return b == nil && a != nil
+		}
+		return compareArcs(a, b) < 0
+	})
+
+	return
+}
+
+/*
+nearestAncestor returns the longest strict ancestor of x present within byDot, or nil if none is found.
+*/
+func nearestAncestor(x *ObjectIdentifier, byDot map[string]*ObjectIdentifier) (anc *ObjectIdentifier) {
+	dot := x.DotNotation()
+	for i := len(dot) - 1; i > 0; i-- {
+		if dot[i] != '.' {
+			continue
+		}
+		if anc = byDot[dot[:i]]; anc != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,77 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommonPrefixLen(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{`1.3.6.1.4.1.311`, `1.3.6.1.4.1.11129`, 6},
+		{`1.3.6.1`, `1.3.6.1.4`, 4},
+		{`1.3.6.1`, `1.3.6.1`, 4},
+		{`1.3`, `2.5`, 0},
+		{`1.3.6.1`, ``, 0},
+	} {
+		var a, b *ObjectIdentifier
+		if len(tc.a) > 0 {
+			a = mustDot(t, tc.a)
+		}
+		if len(tc.b) > 0 {
+			b = mustDot(t, tc.b)
+		}
+
+		if n := CommonPrefixLen(a, b); n != tc.want {
+			t.Errorf("%s, %s: got %d, want %d", tc.a, tc.b, n, tc.want)
+		} else if n = CommonPrefixLen(b, a); n != tc.want {
+			t.Errorf("%s, %s: got %d in reverse, want %d", tc.a, tc.b, n, tc.want)
+		}
+	}
+}
+
+func TestCluster(t *testing.T) {
+	m := ObjectIdentifierMap{
+		`microsoft`:  mustDot(t, `1.3.6.1.4.1.311`),
+		`google`:     mustDot(t, `1.3.6.1.4.1.11129`),
+		`enterprise`: mustDot(t, `1.3.6.1.4.1`),
+		`ms-ca`:      mustDot(t, `1.3.6.1.4.1.311.21`),
+	}
+
+	for _, tc := range []struct {
+		name string
+		oids []string
+		want string // ancestor:members, space-delimited
+	}{
+		{`vendors`, []string{`1.3.6.1.4.1.11129.2.4.2`, `1.3.6.1.4.1.311.20.2`, `1.3.6.1.4.1.311.21.7`, `1.3.6.1.4.1.311.10`},
+			`1.3.6.1.4.1.311:1.3.6.1.4.1.311.20.2,1.3.6.1.4.1.311.10 ` +
+				`1.3.6.1.4.1.311.21:1.3.6.1.4.1.311.21.7 ` +
+				`1.3.6.1.4.1.11129:1.3.6.1.4.1.11129.2.4.2`},
+		{`orphans last`, []string{`2.5.29.17`, `1.3.6.1.4.1.99`, `2.5.29.19`},
+			`1.3.6.1.4.1:1.3.6.1.4.1.99 :2.5.29.17,2.5.29.19`},
+		{`strict ancestor`, []string{`1.3.6.1.4.1.311`},
+			`1.3.6.1.4.1:1.3.6.1.4.1.311`},
+		{`empty`, nil, ``},
+	} {
+		var oids []*ObjectIdentifier
+		for _, dot := range tc.oids {
+			oids = append(oids, mustDot(t, dot))
+		}
+		oids = append(oids, nil)
+
+		var got []string
+		for _, c := range m.Cluster(oids) {
+			var members []string
+			for _, x := range c.Members {
+				members = append(members, x.DotNotation())
+			}
+			got = append(got, c.Ancestor.DotNotation()+`:`+strings.Join(members, `,`))
+		}
+
+		if s := strings.Join(got, ` `); s != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, s, tc.want)
+		}
+	}
+}
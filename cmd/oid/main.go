@@ -0,0 +1,228 @@
+/*
+Command oid converts, looks up and validates ASN.1 object identifiers from the command line.
+
+Usage:
+
+	oid convert [-to form] [-der] oid ...
+	oid lookup name-or-oid ...
+	oid validate [-strict] oid ...
+	oid registry [-f file] add [-desc text] oid [name]
+	oid registry [-f file] delete key ...
+	oid registry [-f file] reserve base label low high
+	oid registry [-f file] allocate [-label label] base [name]
+	oid registry [-f file] import [-format json|csv] file
+	oid registry [-f file] export [-format json|csv]
+	oid registry [-f file] tree [-ascii] [base]
+
+Input may be given in any textual form accepted by oid.ParseAny, e.g. dotNotation, an ASN.1 NameAndNumberForm sequence, an OID-IRI, a URN or a descriptor known to the well-known database. When -der is given to convert, input is instead read as the hexadecimal DER encoding of an OBJECT IDENTIFIER, e.g. 06032b0601.
+
+The registry subcommands maintain a registry of OIDs stored as JSON within the file named by -f, "oids.json" by default, which is created upon first modification. This allows the arcs beneath an assigned OID to be managed without writing any Go, e.g.:
+
+	oid registry reserve 1.3.6.1.4.1.56521 attributes 1 99
+	oid registry allocate -label attributes 1.3.6.1.4.1.56521 exampleAttr
+	oid registry tree
+
+Entries may be imported from, and exported to, the JSON form of the registry file or CSV. Imports replace entries bearing the same key, but do not import reservations.
+
+The exit status is zero (0) upon success, one (1) if any input could not be processed and two (2) upon a usage error.
+*/
+package main
+
+import (
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+/*
+run executes the subcommand named by args[0], returning the exit status.
+*/
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	cmds := map[string]func([]string, io.Writer, io.Writer) int{
+		`convert`:  convert,
+		`lookup`:   lookup,
+		`validate`: validate,
+		`registry`: registry,
+	}
+
+	cmd, found := cmds[args[0]]
+	if !found {
+		fmt.Fprintf(stderr, "oid: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	return cmd(args[1:], stdout, stderr)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprint(w, `usage:
+	oid convert [-to form] [-der] oid ...
+	oid lookup name-or-oid ...
+	oid validate [-strict] oid ...
+	oid registry [-f file] add [-desc text] oid [name]
+	oid registry [-f file] delete key ...
+	oid registry [-f file] reserve base label low high
+	oid registry [-f file] allocate [-label label] base [name]
+	oid registry [-f file] import [-format json|csv] file
+	oid registry [-f file] export [-format json|csv]
+	oid registry [-f file] tree [-ascii] [base]
+`)
+}
+
+/*
+forms maps each output form supported by convert to its renderer.
+*/
+var forms = map[string]func(*oid.ObjectIdentifier) string{
+	`dot`: (*oid.ObjectIdentifier).DotNotation,
+	`nanf`: func(o *oid.ObjectIdentifier) string {
+		return o.StringAs(oid.FormNaNF)
+	},
+	`iri`: (*oid.ObjectIdentifier).IRI,
+	`urn`: (*oid.ObjectIdentifier).URN,
+	`der`: func(o *oid.ObjectIdentifier) string {
+		return hex.EncodeToString(o.AppendDER(nil, true))
+	},
+}
+
+var formOrder = []string{`dot`, `nanf`, `iri`, `urn`, `der`}
+
+func convert(args []string, stdout, stderr io.Writer) (status int) {
+	fs := flag.NewFlagSet(`convert`, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	to := fs.String(`to`, `all`, `output form: dot, nanf, iri, urn, der or all`)
+	der := fs.Bool(`der`, false, `read input as hexadecimal DER`)
+	if fs.Parse(args) != nil {
+		return 2
+	}
+
+	render, found := forms[*to]
+	if !found && *to != `all` {
+		fmt.Fprintf(stderr, "oid convert: unknown form %q\n", *to)
+		return 2
+	}
+
+	for _, arg := range fs.Args() {
+		o, err := parseInput(arg, *der)
+		if err != nil {
+			fmt.Fprintf(stderr, "oid convert: %s: %v\n", arg, err)
+			status = 1
+			continue
+		}
+
+		if found {
+			fmt.Fprintln(stdout, render(o))
+			continue
+		}
+
+		for _, form := range formOrder {
+			if val := forms[form](o); len(val) > 0 {
+				fmt.Fprintf(stdout, "%s\t%s\n", form, val)
+			}
+		}
+	}
+
+	return
+}
+
+func lookup(args []string, stdout, stderr io.Writer) (status int) {
+	for _, arg := range args {
+		o, found := oid.WellKnown().Get(arg)
+		if !found {
+			if p, err := oid.ParseAny(arg); err == nil {
+				o, found = oid.WellKnown().Get(p.DotNotation())
+			}
+		}
+
+		if !found {
+			fmt.Fprintf(stderr, "oid lookup: %s: not found\n", arg)
+			status = 1
+			continue
+		}
+
+		fmt.Fprintf(stdout, "%s\t%s", o.DotNotation(), o.Name())
+		if aka := o.AltNames(); len(aka) > 0 {
+			fmt.Fprintf(stdout, "\t%s", strings.Join(aka, `,`))
+		}
+		fmt.Fprintln(stdout)
+	}
+
+	return
+}
+
+func validate(args []string, stdout, stderr io.Writer) (status int) {
+	fs := flag.NewFlagSet(`validate`, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	strict := fs.Bool(`strict`, false, `enforce X.660 and X.680 rules, e.g. no leading zeros`)
+	if fs.Parse(args) != nil {
+		return 2
+	}
+
+	for _, arg := range fs.Args() {
+		var err error
+		if *strict {
+			err = validateStrict(arg)
+		} else {
+			_, err = oid.ParseAny(arg)
+		}
+
+		if err != nil {
+			fmt.Fprintf(stdout, "%s\tinvalid\t%v\n", arg, err)
+			status = 1
+			continue
+		}
+		fmt.Fprintf(stdout, "%s\tvalid\n", arg)
+	}
+
+	return
+}
+
+/*
+validateStrict validates arg under the oid.WithStrict option. Inputs in dotNotation or NameAndNumberForm are checked directly; other forms are checked following their conversion to NameAndNumberForm.
+*/
+func validateStrict(arg string) (err error) {
+	arg = strings.TrimSpace(arg)
+	if len(arg) > 0 && strings.Trim(arg, `0123456789.`) == `` {
+		_, err = oid.NewObjectIdentifier(strings.Split(arg, `.`), oid.WithStrict())
+		return
+	} else if strings.HasPrefix(arg, `{`) {
+		_, err = oid.NewObjectIdentifier(arg, oid.WithStrict())
+		return
+	}
+
+	var o *oid.ObjectIdentifier
+	if o, err = oid.ParseAny(arg); err == nil {
+		_, err = oid.NewObjectIdentifier(o.StringAs(oid.FormNaNF), oid.WithStrict())
+	}
+
+	return
+}
+
+/*
+parseInput parses arg as any textual OID form or, if der is true, as hexadecimal DER.
+*/
+func parseInput(arg string, der bool) (*oid.ObjectIdentifier, error) {
+	if !der {
+		return oid.ParseAny(arg)
+	}
+
+	b, err := hex.DecodeString(strings.TrimSpace(arg))
+	if err != nil {
+		return nil, err
+	}
+	return oid.NewFromDER(b)
+}
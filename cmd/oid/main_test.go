@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		args   []string
+		status int
+		stdout string
+		stderr string
+	}{
+		{`no command`, nil, 2, ``, `usage:`},
+		{`unknown command`, []string{`bogus`}, 2, ``, `unknown command "bogus"`},
+		{`convert all`, []string{`convert`, `1.3.6.1`}, 0, "dot\t1.3.6.1\nnanf\t{ 1 3 6 1 }\niri\t/1/3/6/1\nurn\turn:oid:1.3.6.1\nder\t06032b0601\n", ``},
+		{`convert to`, []string{`convert`, `-to`, `iri`, `{ iso(1) 3 6 }`, `urn:oid:2.5`}, 0, "/1/3/6\n/2/5\n", ``},
+		{`convert der`, []string{`convert`, `-to`, `dot`, `-der`, `06032b0601`}, 0, "1.3.6.1\n", ``},
+		{`convert bad der`, []string{`convert`, `-der`, `zz`}, 1, ``, `oid convert: zz:`},
+		{`convert bad form`, []string{`convert`, `-to`, `x`, `1.3`}, 2, ``, `unknown form "x"`},
+		{`convert bad input`, []string{`convert`, `-to`, `dot`, `1.3`, `bogus!`}, 1, "1.3\n", `oid convert: bogus!:`},
+		{`lookup`, []string{`lookup`, `cn`, `2.5.4.10`}, 0, "2.5.4.3\tcn\tcommonName,id-at-commonName\n2.5.4.10\to\torganizationName,id-at-organizationName\n", ``},
+		{`lookup unknown`, []string{`lookup`, `nope`}, 1, ``, `oid lookup: nope: not found`},
+		{`validate`, []string{`validate`, `1.3.6`, `01.3`}, 0, "1.3.6\tvalid\n01.3\tvalid\n", ``},
+		{`validate strict`, []string{`validate`, `-strict`, `1.03.6`, `1.3.6`}, 1, "1.03.6\tinvalid\t", ``},
+		{`validate bad flag`, []string{`validate`, `-bogus`}, 2, ``, `flag provided but not defined`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if status := run(tc.args, &stdout, &stderr); status != tc.status {
+				t.Errorf("got status %d, want %d (stderr: %s)", status, tc.status, stderr.String())
+			}
+
+			if got := stdout.String(); !strings.HasPrefix(got, tc.stdout) || len(tc.stdout) == 0 && len(got) > 0 {
+				t.Errorf("got stdout %q, want %q", got, tc.stdout)
+			}
+			if got := stderr.String(); !strings.Contains(got, tc.stderr) || len(tc.stderr) == 0 && len(got) > 0 {
+				t.Errorf("got stderr %q, want %q", got, tc.stderr)
+			}
+		})
+	}
+}
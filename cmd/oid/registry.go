@@ -0,0 +1,294 @@
+package main
+
+/*
+registry.go deals with the maintenance of a file-backed oid.Registry by way of the registry subcommand.
+*/
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+/*
+registryCmds maps each registry subcommand to its handler, and indicates whether the handler modifies the registry, requiring it be saved.
+*/
+var registryCmds = map[string]struct {
+	fn     func(*oid.Registry, []string, io.Writer) error
+	modify bool
+}{
+	`add`:      {registryAdd, true},
+	`delete`:   {registryDelete, true},
+	`reserve`:  {registryReserve, true},
+	`allocate`: {registryAllocate, true},
+	`import`:   {registryImport, true},
+	`export`:   {registryExport, false},
+	`tree`:     {registryTree, false},
+}
+
+func registry(args []string, stdout, stderr io.Writer) int {
+	fset := flag.NewFlagSet(`registry`, flag.ContinueOnError)
+	fset.SetOutput(stderr)
+	file := fset.String(`f`, `oids.json`, `registry file`)
+	if fset.Parse(args) != nil {
+		return 2
+	}
+
+	args = fset.Args()
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	sub, found := registryCmds[args[0]]
+	if !found {
+		fmt.Fprintf(stderr, "oid registry: unknown command %q\n", args[0])
+		usage(stderr)
+		return 2
+	}
+
+	r, err := loadRegistry(*file)
+	if err == nil {
+		if err = sub.fn(r, args[1:], stdout); err == nil && sub.modify {
+			err = saveRegistry(*file, r)
+		}
+	}
+
+	var uerr usageError
+	if errors.As(err, &uerr) {
+		fmt.Fprintf(stderr, "oid registry %s: %v\n", args[0], err)
+		usage(stderr)
+		return 2
+	} else if err != nil {
+		fmt.Fprintf(stderr, "oid registry %s: %v\n", args[0], err)
+		return 1
+	}
+
+	return 0
+}
+
+/*
+usageError indicates that a registry subcommand was invoked incorrectly.
+*/
+type usageError string
+
+func (e usageError) Error() string { return string(e) }
+
+/*
+loadRegistry returns the registry stored within file, or a new, empty registry if file does not exist.
+*/
+func loadRegistry(file string) (*oid.Registry, error) {
+	r := oid.NewRegistry()
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return r, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return r, json.Unmarshal(data, r)
+}
+
+/*
+saveRegistry writes r to file, by way of a temporary file which replaces file upon success, such that an interrupted save cannot truncate the registry.
+*/
+func saveRegistry(file string, r *oid.Registry) error {
+	data, err := json.MarshalIndent(r, ``, "\t")
+	if err != nil {
+		return err
+	}
+
+	tmp := file + `.tmp`
+	if err = os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+/*
+registryOID returns the entry of r bearing the key or name arg or, failing that, arg parsed in the manner of oid.ParseAny.
+*/
+func registryOID(r *oid.Registry, arg string) (*oid.ObjectIdentifier, error) {
+	if o, found := r.Get(arg); found {
+		return o, nil
+	}
+	return oid.ParseAny(arg)
+}
+
+func registryAdd(r *oid.Registry, args []string, stdout io.Writer) error {
+	fset := flag.NewFlagSet(`add`, flag.ContinueOnError)
+	fset.SetOutput(io.Discard)
+	desc := fset.String(`desc`, ``, `description`)
+	if err := fset.Parse(args); err != nil || fset.NArg() < 1 || fset.NArg() > 2 {
+		return usageError(`expected [-desc text] oid [name]`)
+	}
+
+	o, err := oid.ParseAny(fset.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if name := fset.Arg(1); len(name) > 0 {
+		if err = o.SetName(name); err != nil {
+			return err
+		}
+	}
+
+	if len(*desc) > 0 {
+		if err = o.SetDescription(*desc); err != nil {
+			return err
+		}
+	}
+
+	if err = r.Set(o.Key(), o); err == nil {
+		fmt.Fprintf(stdout, "%s\t%s\n", o.DotNotation(), o.Key())
+	}
+	return err
+}
+
+func registryDelete(r *oid.Registry, args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return usageError(`expected key ...`)
+	}
+
+	for _, key := range args {
+		if !r.Delete(key) {
+			return fmt.Errorf("%s: %w", key, oid.ErrNotFound)
+		}
+	}
+
+	return nil
+}
+
+func registryReserve(r *oid.Registry, args []string, stdout io.Writer) error {
+	if len(args) != 4 {
+		return usageError(`expected base label low high`)
+	}
+
+	base, err := registryOID(r, args[0])
+	if err != nil {
+		return err
+	}
+
+	var low, high uint64
+	if low, err = strconv.ParseUint(args[2], 10, 0); err != nil {
+		return err
+	} else if high, err = strconv.ParseUint(args[3], 10, 0); err != nil {
+		return err
+	}
+
+	return r.Reserve(base, args[1], uint(low), uint(high))
+}
+
+func registryAllocate(r *oid.Registry, args []string, stdout io.Writer) error {
+	fset := flag.NewFlagSet(`allocate`, flag.ContinueOnError)
+	fset.SetOutput(io.Discard)
+	label := fset.String(`label`, ``, `reservation from which to allocate`)
+	if err := fset.Parse(args); err != nil || fset.NArg() < 1 || fset.NArg() > 2 {
+		return usageError(`expected [-label label] base [name]`)
+	}
+
+	base, err := registryOID(r, fset.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	o, err := r.Allocate(base, *label, fset.Arg(1))
+	if err == nil {
+		fmt.Fprintf(stdout, "%s\t%s\n", o.DotNotation(), o.Key())
+	}
+	return err
+}
+
+func registryImport(r *oid.Registry, args []string, stdout io.Writer) error {
+	fset := flag.NewFlagSet(`import`, flag.ContinueOnError)
+	fset.SetOutput(io.Discard)
+	format := fset.String(`format`, `json`, `input format: json or csv`)
+	if err := fset.Parse(args); err != nil || fset.NArg() != 1 {
+		return usageError(`expected [-format json|csv] file`)
+	}
+
+	data, err := os.ReadFile(fset.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	m := make(oid.ObjectIdentifierMap)
+	switch *format {
+	case `json`:
+		t := oid.NewRegistry()
+		if err = json.Unmarshal(data, t); err == nil {
+			m = t.Map()
+		}
+	case `csv`:
+		_, err = m.ImportCSV(bytes.NewReader(data))
+	default:
+		return usageError(fmt.Sprintf("unknown format %q", *format))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	err = r.Batch(func(tx oid.RegistryTx) (err error) {
+		m.Range(func(k string, x *oid.ObjectIdentifier) bool {
+			err = tx.Set(k, x)
+			return err == nil
+		})
+		return
+	})
+
+	if err == nil {
+		fmt.Fprintf(stdout, "%d imported\n", len(m))
+	}
+	return err
+}
+
+func registryExport(r *oid.Registry, args []string, stdout io.Writer) error {
+	fset := flag.NewFlagSet(`export`, flag.ContinueOnError)
+	fset.SetOutput(io.Discard)
+	format := fset.String(`format`, `json`, `output format: json or csv`)
+	if err := fset.Parse(args); err != nil || fset.NArg() != 0 {
+		return usageError(`expected [-format json|csv]`)
+	}
+
+	switch *format {
+	case `json`:
+		data, err := json.MarshalIndent(r, ``, "\t")
+		if err == nil {
+			_, err = fmt.Fprintf(stdout, "%s\n", data)
+		}
+		return err
+	case `csv`:
+		return r.Map().ExportCSV(stdout, nil)
+	}
+
+	return usageError(fmt.Sprintf("unknown format %q", *format))
+}
+
+func registryTree(r *oid.Registry, args []string, stdout io.Writer) error {
+	fset := flag.NewFlagSet(`tree`, flag.ContinueOnError)
+	fset.SetOutput(io.Discard)
+	ascii := fset.Bool(`ascii`, false, `draw the tree using ASCII characters only`)
+	if err := fset.Parse(args); err != nil || fset.NArg() > 1 {
+		return usageError(`expected [-ascii] [base]`)
+	}
+
+	var base *oid.ObjectIdentifier
+	if fset.NArg() == 1 {
+		var err error
+		if base, err = registryOID(r, fset.Arg(0)); err != nil {
+			return err
+		}
+	}
+
+	return r.Map().RenderTree(stdout, base, *ascii)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+/*
+TestRegistry runs each registry subcommand in turn against a single registry file, thus each step observes the changes made by those before it.
+*/
+func TestRegistry(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, `oids.json`)
+	csv := filepath.Join(dir, `import.csv`)
+	if err := os.WriteFile(csv, []byte("key,oid,name\nother,1.3.6.1.4.1.56522,other\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		args   []string
+		status int
+		stdout string
+		stderr string
+	}{
+		{[]string{`add`, `-desc`, `Example`, `1.3.6.1.4.1.56521`, `example`}, 0, "1.3.6.1.4.1.56521\texample\n", ``},
+		{[]string{`reserve`, `example`, `attributes`, `1`, `9`}, 0, ``, ``},
+		{[]string{`reserve`, `example`, `classes`, `5`, `20`}, 1, ``, `conflict`},
+		{[]string{`allocate`, `-label`, `attributes`, `example`, `exampleAttr`}, 0, "1.3.6.1.4.1.56521.1\texampleAttr\n", ``},
+		{[]string{`allocate`, `-label`, `bogus`, `example`}, 1, ``, `not found`},
+		{[]string{`import`, `-format`, `csv`, csv}, 0, "1 imported\n", ``},
+		{[]string{`export`, `-format`, `csv`}, 0, "key,oid,name,altNames,description,status\nexample,1.3.6.1.4.1.56521,example,,Example,current\nexampleAttr,1.3.6.1.4.1.56521.1,exampleAttr,,,current\nother,1.3.6.1.4.1.56522,other,,,current\n", ``},
+		{[]string{`tree`, `-ascii`, `example`}, 0, "1\n`- 3\n   `- 6\n      `- 1\n         `- 4\n            `- 1\n               `- 56521 [example]\n                  `- 1 [exampleAttr]\n", ``},
+		{[]string{`delete`, `other`}, 0, ``, ``},
+		{[]string{`delete`, `other`}, 1, ``, `other: not found`},
+		{[]string{`add`}, 2, ``, `usage:`},
+		{[]string{`export`, `-format`, `xml`}, 2, ``, `unknown format "xml"`},
+		{[]string{`bogus`}, 2, ``, `unknown command "bogus"`},
+	} {
+		var stdout, stderr bytes.Buffer
+		status := run(append([]string{`registry`, `-f`, file}, tc.args...), &stdout, &stderr)
+		if status != tc.status {
+			t.Errorf("%v: got status %d, want %d (stderr: %s)", tc.args, status, tc.status, stderr.String())
+		}
+
+		if got := stdout.String(); got != tc.stdout {
+			t.Errorf("%v: got stdout %q, want %q", tc.args, got, tc.stdout)
+		}
+		if got := stderr.String(); !strings.Contains(got, tc.stderr) || len(tc.stderr) == 0 && len(got) > 0 {
+			t.Errorf("%v: got stderr %q, want %q", tc.args, got, tc.stderr)
+		}
+	}
+
+	if _, err := os.Stat(file + `.tmp`); !os.IsNotExist(err) {
+		t.Errorf("temporary file left behind: %v", err)
+	}
+}
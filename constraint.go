@@ -0,0 +1,145 @@
+package oid
+
+/*
+constraint.go deals with ParentConstraint, which confines new OIDs to the descendants of a set of permitted bases.
+*/
+
+/*
+ParentConstraint describes the bases beneath which new OIDs must reside, protecting a registry maintained by an organization against the import of OIDs that are not its own, e.g.:
+
+	c, err := oid.NewParentConstraint(oid.NewEnterpriseOID(56521), oid.LDAP())
+
+A ParentConstraint may be enforced by constructors, using WithAllowedParents, and by a Registry, using its SetParentConstraint method. Instances are immutable, and are safe for concurrent use. A nil instance permits all OIDs.
+*/
+type ParentConstraint struct {
+	bases []*ObjectIdentifier
+}
+
+/*
+NewParentConstraint returns an instance of *ParentConstraint permitting only those OIDs that are equal to, or descendants of, at least one of bases, alongside an error should any of bases fail the Valid method. Only the number forms of bases are retained.
+*/
+func NewParentConstraint(bases ...*ObjectIdentifier) (c *ParentConstraint, err error) {
+	if len(bases) == 0 {
+		err = errorw(ErrEmptyInput, "No bases for %T", c)
+		return
+	}
+
+	t := &ParentConstraint{bases: make([]*ObjectIdentifier, 0, len(bases))}
+	for i := 0; i < len(bases); i++ {
+		if !bases[i].valid() {
+			err = errorw(ErrInvalidRoot, "%T base #%d did not pass validity checks", t, i)
+			return
+		}
+		t.bases = append(t.bases, bases[i].Normalize())
+	}
+
+	c = t
+	return
+}
+
+/*
+Bases returns copies of the bases permitted by the receiver, in the order given to NewParentConstraint. A nil slice is returned if the receiver is nil.
+*/
+func (c *ParentConstraint) Bases() (bases []*ObjectIdentifier) {
+	if c == nil {
+		return
+	}
+
+	bases = make([]*ObjectIdentifier, len(c.bases))
+	for i := 0; i < len(c.bases); i++ {
+		bases[i] = c.bases[i].clone()
+	}
+
+	return
+}
+
+/*
+Allows returns a Boolean value indicative of whether o is permitted by the receiver, i.e. whether it is equal to, or a descendant of, any of its bases. True is returned if the receiver is nil, and false if o is nil.
+*/
+func (c *ParentConstraint) Allows(o *ObjectIdentifier) bool {
+	if c == nil {
+		return true
+	}
+
+	for i := 0; i < len(c.bases); i++ {
+		if o.depthBeneath(c.bases[i]) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+Check returns an error wrapping ErrDisallowedParent should o not be permitted by the receiver. See Allows.
+*/
+func (c *ParentConstraint) Check(o *ObjectIdentifier) (err error) {
+	if !c.Allows(o) {
+		err = errorw(ErrDisallowedParent, "%s is not within any of %s", o.DotNotation(), c.String())
+	}
+
+	return
+}
+
+/*
+String returns the dotNotation of each base permitted by the receiver, delimited by commas, e.g. "1.3.6.1.4.1.56521, 1.3.6.1.4.1.1466".
+*/
+func (c *ParentConstraint) String() string {
+	if c == nil {
+		return ``
+	}
+
+	dots := make([]string, len(c.bases))
+	for i := 0; i < len(c.bases); i++ {
+		dots[i] = c.bases[i].DotNotation()
+	}
+
+	return join(dots, `, `)
+}
+
+/*
+checkMap returns an error should any value of m not be permitted by the receiver. Keys are examined in sorted order, such that the error is deterministic.
+*/
+func (c *ParentConstraint) checkMap(m ObjectIdentifierMap) (err error) {
+	if c == nil {
+		return
+	}
+
+	keys := m.SortedKeys()
+	for i := 0; i < len(keys); i++ {
+		if v := m[keys[i]]; !c.Allows(v) {
+			err = errorw(ErrDisallowedParent, "Entry '%s' (%s) is not within any of %s", keys[i], v.DotNotation(), c.String())
+			return
+		}
+	}
+
+	return
+}
+
+/*
+SetParentConstraint confines the receiver to OIDs permitted by c, such that Set, Lease, Allocate, UnmarshalJSON and LoadSubtree, including within a Batch, return an error should they introduce any OID falling outside of its bases. Entries already present are unaffected. A nil c, which is the default, lifts the constraint.
+*/
+func (r *Registry) SetParentConstraint(c *ParentConstraint) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.parents = c
+}
+
+/*
+ParentConstraint returns the *ParentConstraint in effect for the receiver, or nil if none.
+*/
+func (r *Registry) ParentConstraint() *ParentConstraint {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.parents
+}
@@ -0,0 +1,75 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewParentConstraint(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		bases []*ObjectIdentifier
+		str   string
+		err   error
+	}{
+		{`single`, []*ObjectIdentifier{NewEnterpriseOID(56521)}, `1.3.6.1.4.1.56521`, nil},
+		{`multiple`, []*ObjectIdentifier{NewEnterpriseOID(56521), LDAP()}, `1.3.6.1.4.1.56521, 1.3.6.1.4.1.1466`, nil},
+		{`none`, nil, ``, ErrEmptyInput},
+		{`nil base`, []*ObjectIdentifier{LDAP(), nil}, ``, ErrInvalidRoot},
+	} {
+		c, err := NewParentConstraint(tc.bases...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, tc.err)
+		} else if s := c.String(); s != tc.str {
+			t.Errorf("%s: got %q, want %q", tc.name, s, tc.str)
+		}
+	}
+
+	// bases are retained by number form only, and returned as copies
+	base := NewEnterpriseOID(56521)
+	base.SetName(`example`)
+	c := mustConstraint(t, base)
+	if b := c.Bases(); len(b) != 1 || b[0].Name() != `` || b[0] == base {
+		t.Errorf("Bases: got %v", b)
+	} else if b[0].SetName(`modified`); c.Bases()[0].Name() != `` {
+		t.Errorf("Bases: modifying a copy altered the receiver")
+	}
+}
+
+func TestParentConstraintAllows(t *testing.T) {
+	c := mustConstraint(t, NewEnterpriseOID(56521), LDAP())
+	for _, tc := range []struct {
+		o     *ObjectIdentifier
+		c     *ParentConstraint
+		allow bool
+	}{
+		{NewEnterpriseOID(56521), c, true},
+		{NewEnterpriseOID(56521, 1, 5), c, true},
+		{NewEnterpriseOID(1466, 115), c, true},
+		{NewEnterpriseOID(1), c, false},
+		{Enterprise(), c, false},
+		{nil, c, false},
+		{NewEnterpriseOID(1), nil, true},
+	} {
+		if got := tc.c.Allows(tc.o); got != tc.allow {
+			t.Errorf("%s: got %t, want %t", tc.o.DotNotation(), got, tc.allow)
+		}
+
+		err := tc.c.Check(tc.o)
+		if tc.allow && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.o.DotNotation(), err)
+		} else if !tc.allow && !errors.Is(err, ErrDisallowedParent) {
+			t.Errorf("%s: got error %v, want %v", tc.o.DotNotation(), err, ErrDisallowedParent)
+		}
+	}
+}
+
+func TestWithAllowedParents(t *testing.T) {
+	c := []Option{WithAllowedParents(mustConstraint(t, NewEnterpriseOID(56521)))}
+	checkOptionCases(t, []optionCase{
+		{[]int{1, 3, 6, 1, 4, 1, 56521, 1}, c, `1.3.6.1.4.1.56521.1`, nil},
+		{`{ iso(1) 3 6 1 4 1 example(56521) }`, c, `1.3.6.1.4.1.56521`, nil},
+		{[]int{1, 3, 6, 1, 4, 1, 1466}, c, ``, ErrDisallowedParent},
+		{[]int{1, 3, 6, 1, 4, 1}, c, ``, ErrDisallowedParent},
+	})
+}
@@ -0,0 +1,165 @@
+package oid
+
+/*
+corpus.go deals with the golden corpus of standard OIDs embedded within this package.
+*/
+
+//go:generate go run gencorpus.go corpus.tsv
+
+import (
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"io"
+	"sync"
+)
+
+/*
+corpusGZ is the gzip-compressed form of corpus.tsv, which is decompressed upon first use of the golden corpus. Following any change to corpus.tsv, run go generate to refresh it.
+*/
+//go:embed corpus.tsv.gz
+var corpusGZ []byte
+
+/*
+CorpusEntry describes a single standard OID within the golden corpus.
+
+Dot contains the dotNotation form, e.g. 2.5.4.3, while NaNF contains the nameAndNumberForm sequence, e.g. { joint-iso-itu-t(2) ds(5) attributeType(4) commonName(3) }. Arcs which bear no standard identifier appear as bare number forms within NaNF.
+
+Names contains the names by which the OID is known, the first of which is the preferred name.
+*/
+type CorpusEntry struct {
+	Dot   string
+	NaNF  string
+	Names []string
+}
+
+var (
+	corpusTSVOnce sync.Once
+	corpusTSV     string
+
+	corpusOnce    sync.Once
+	corpusEntries []CorpusEntry
+
+	corpusMapOnce sync.Once
+	corpusOIDs    ObjectIdentifierMap
+)
+
+/*
+GoldenCorpus returns a few hundred standard OIDs drawn from X.520, the COSINE pilot schema, PKIX, PKCS, ANSI X9 and NIST, ordered by arc. The corpus is intended for use by downstream test suites wishing to validate their own OID handling against a canonical list.
+
+The return value is a fresh copy and may be freely modified by the caller. A nil slice is returned if DisableEmbeddedTables was called beforehand.
+*/
+func GoldenCorpus() (entries []CorpusEntry) {
+	loadCorpus()
+	if len(corpusEntries) == 0 {
+		return
+	}
+
+	entries = make([]CorpusEntry, len(corpusEntries))
+	for i := 0; i < len(corpusEntries); i++ {
+		entries[i] = corpusEntries[i]
+		entries[i].Names = append([]string(nil), corpusEntries[i].Names...)
+	}
+
+	return
+}
+
+/*
+loadCorpus parses the corpus upon first use.
+*/
+func loadCorpus() {
+	corpusOnce.Do(func() {
+		corpusEntries = parseCorpus(loadCorpusTSV())
+	})
+}
+
+/*
+loadCorpusTSV decompresses the corpus upon first use, returning its TAB-delimited form. A zero string is returned if DisableEmbeddedTables was called beforehand. A corrupt blob indicates a corrupt build and results in a panic.
+*/
+func loadCorpusTSV() string {
+	corpusTSVOnce.Do(func() {
+		if embedDisabled.Load() {
+			return
+		}
+
+		corpusTSV = inflate(corpusGZ)
+	})
+
+	return corpusTSV
+}
+
+/*
+inflate returns the decompressed form of the embedded gzip blob gz. A corrupt blob indicates a corrupt build and results in a panic.
+*/
+func inflate(gz []byte) string {
+	zr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		panic(err)
+	}
+
+	var b []byte
+	if b, err = io.ReadAll(zr); err != nil {
+		panic(err)
+	}
+
+	return string(b)
+}
+
+/*
+corpusMap returns the golden corpus as an ObjectIdentifierMap keyed by dotNotation, bearing the arc identifiers of each NaNF, for use in name inference. The return value is shared and must not be modified.
+*/
+func corpusMap() ObjectIdentifierMap {
+	corpusMapOnce.Do(func() {
+		loadCorpus()
+		corpusOIDs = make(ObjectIdentifierMap, len(corpusEntries))
+		for i := 0; i < len(corpusEntries); i++ {
+			e := corpusEntries[i]
+			toks, err := Tokenize(e.NaNF)
+			if err != nil {
+				panic(err)
+			}
+
+			var o *ObjectIdentifier
+			if o, err = NewFromTokens(toks); err != nil {
+				panic(err)
+			}
+			o.SetName(e.Names[0])
+			o.SetAltNames(e.Names[1:]...)
+			corpusOIDs[e.Dot] = o
+		}
+	})
+
+	return corpusOIDs
+}
+
+/*
+GoldenCorpusTSV returns the raw TAB-delimited form of the golden corpus, as embedded within this package. Lines beginning with a hash (#) are comments. A zero string is returned if DisableEmbeddedTables was called beforehand.
+*/
+func GoldenCorpusTSV() string {
+	return loadCorpusTSV()
+}
+
+/*
+parseCorpus parses the TAB-delimited corpus. A malformed line indicates a corrupt build and results in a panic.
+*/
+func parseCorpus(tsv string) (entries []CorpusEntry) {
+	lines := split(tsv, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := trimR(lines[i], "\r")
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		f := split(line, "\t")
+		if len(f) != 3 {
+			panic(errorw(ErrSyntax, "Malformed golden corpus line #%d: '%s'", i+1, line))
+		}
+		entries = append(entries, CorpusEntry{
+			Dot:   f[0],
+			NaNF:  f[1],
+			Names: split(f[2], ","),
+		})
+	}
+
+	return
+}
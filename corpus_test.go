@@ -0,0 +1,78 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGoldenCorpus(t *testing.T) {
+	entries := GoldenCorpus()
+	if len(entries) < 300 {
+		t.Fatalf("got %d entries, want at least 300", len(entries))
+	}
+
+	byDot := make(map[string]CorpusEntry, len(entries))
+	for i, e := range entries {
+		o, err := NewFromNaNF(e.NaNF)
+		if err != nil {
+			t.Errorf("%s: %v", e.Dot, err)
+			continue
+		} else if d := o.DotNotation(); d != e.Dot {
+			t.Errorf("%s: NaNF yields %s", e.Dot, d)
+		} else if len(e.Names) == 0 || len(e.Names[0]) == 0 {
+			t.Errorf("%s: no names", e.Dot)
+		}
+
+		if i > 0 {
+			prev, _ := NewFromDot(entries[i-1].Dot)
+			if compareArcs(prev, o) >= 0 {
+				t.Errorf("%s: out of order following %s", e.Dot, entries[i-1].Dot)
+			}
+		}
+		byDot[e.Dot] = e
+	}
+
+	for _, tc := range []struct {
+		dot   string
+		names string
+	}{
+		{`2.5.4.3`, `cn,commonName`},
+		{`1.3.6.1.5.5.7.3.1`, `id-kp-serverAuth,serverAuth`},
+		{`2.16.840.1.101.3.4.2.1`, `id-sha256,sha256`},
+	} {
+		if e, found := byDot[tc.dot]; !found {
+			t.Errorf("%s: not found", tc.dot)
+		} else if names := strings.Join(e.Names, `,`); names != tc.names {
+			t.Errorf("%s: got names %s, want %s", tc.dot, names, tc.names)
+		}
+	}
+
+	// the return value must be a copy
+	entries[0].Names[0] = `altered`
+	if GoldenCorpus()[0].Names[0] == `altered` {
+		t.Errorf("GoldenCorpus: modification of the return value altered the corpus")
+	}
+}
+
+func TestParseCorpus(t *testing.T) {
+	for _, tc := range []struct {
+		tsv   string
+		want  int
+		panic bool
+	}{
+		{"# comment\n\n2.5.4.3\t{ 2 5 4 3 }\tcn,commonName\r\n", 1, false},
+		{"2.5.4.3\t{ 2 5 4 3 }\tcn\n2.5.4.4\t{ 2 5 4 4 }\tsn\n", 2, false},
+		{"2.5.4.3\t{ 2 5 4 3 }\n", 0, true},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); (r != nil) != tc.panic {
+					t.Errorf("%q: got panic %v, want %t", tc.tsv, r, tc.panic)
+				}
+			}()
+			if n := len(parseCorpus(tc.tsv)); n != tc.want {
+				t.Errorf("%q: got %d entries, want %d", tc.tsv, n, tc.want)
+			}
+		}()
+	}
+}
@@ -0,0 +1,158 @@
+package oid
+
+/*
+csv.go deals with the export and import of ObjectIdentifierMap contents as CSV, for use with spreadsheets and the like.
+*/
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+/*
+csvHeader is the header row written by ExportCSV.
+*/
+var csvHeader = []string{`key`, `oid`, `name`, `altNames`, `description`, `status`}
+
+/*
+ExportCSV writes a CSV table to w describing each ObjectIdentifier within the receiver that is equal to, or a descendant of, base. If base is nil, all ObjectIdentifier instances are exported. Rows are ordered by OID, and multiple alt names are separated by semicolons, e.g.:
+
+	key,oid,name,altNames,description,status
+	example,1.3.6.1.4.1.56521,example,exampleCorp;ex,"Example, Inc.",current
+
+The result is suitable for use with ImportCSV.
+*/
+func (o ObjectIdentifierMap) ExportCSV(w io.Writer, base *ObjectIdentifier) (err error) {
+	cw := csv.NewWriter(w)
+	if err = cw.Write(csvHeader); err != nil {
+		return
+	}
+
+	o.Range(func(k string, v *ObjectIdentifier) bool {
+		if base != nil && !v.under(base) {
+			return true
+		}
+
+		err = cw.Write([]string{
+			k,
+			v.DotNotation(),
+			v.Name(),
+			join(v.AltNames(), `;`),
+			v.Description(),
+			v.Status().String(),
+		})
+		return err == nil
+	})
+
+	if err == nil {
+		cw.Flush()
+		err = cw.Error()
+	}
+
+	return
+}
+
+/*
+ImportCSV reads a CSV table from r, as produced by ExportCSV, and assigns each of its rows to the receiver, returning the number of rows imported alongside an error.
+
+The first row must be a header. Only the "oid" column is required, and it may bear the dotNotation or NameAndNumberForm of the OID; the remaining columns of ExportCSV are honored if present, in any order. Rows lacking a key are assigned in the manner of ObjectIdentifier.Key. Existing assignments bearing the same key are replaced.
+
+Should any row be malformed, an error describing its line number is returned, and the rows preceding it remain imported.
+*/
+func (o ObjectIdentifierMap) ImportCSV(r io.Reader) (n int, err error) {
+	if o == nil {
+		err = errorw(ErrNilInstance, "%T is nil", o)
+		return
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	var header []string
+	if header, err = cr.Read(); err != nil {
+		return
+	}
+
+	cols := make(map[string]int, len(csvHeader))
+	for i := 0; i < len(header); i++ {
+		for j := 0; j < len(csvHeader); j++ {
+			if eq(trimS(header[i]), csvHeader[j]) {
+				cols[csvHeader[j]] = i
+			}
+		}
+	}
+
+	if _, found := cols[`oid`]; !found {
+		err = errorw(ErrSyntax, "CSV header %v lacks an 'oid' column", header)
+		return
+	}
+
+	var rec []string
+	for line := 2; ; line++ {
+		if rec, err = cr.Read(); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			return
+		}
+
+		field := func(col string) string {
+			if i, found := cols[col]; found && i < len(rec) {
+				return trimS(rec[i])
+			}
+			return ``
+		}
+
+		var x *ObjectIdentifier
+		if x, err = csvObjectIdentifier(field); err != nil {
+			err = errorc(err, "CSV line %d", line)
+			return
+		}
+
+		key := field(`key`)
+		if len(key) == 0 {
+			key = x.Key()
+		}
+		o[key] = x
+		n++
+	}
+
+	return
+}
+
+/*
+csvObjectIdentifier returns a new *ObjectIdentifier assembled from the columns of a single ImportCSV row, as provided by field.
+*/
+func csvObjectIdentifier(field func(string) string) (x *ObjectIdentifier, err error) {
+	val := field(`oid`)
+	if hasPrefix(val, `{`) {
+		x, err = NewFromNaNF(val)
+	} else {
+		x, err = NewFromDot(val)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if st := field(`status`); len(st) > 0 {
+		if x.status, err = ParseStatus(st); err != nil {
+			return
+		}
+	}
+
+	if aka := field(`altNames`); len(aka) > 0 {
+		names := split(aka, `;`)
+		for i := 0; i < len(names); i++ {
+			names[i] = trimS(names[i])
+		}
+		x.SetAltNames(names...)
+	}
+
+	if name := field(`name`); len(name) > 0 {
+		err = x.SetName(name)
+	}
+	x.desc = field(`description`)
+
+	return
+}
@@ -0,0 +1,444 @@
+package oid
+
+/*
+der.go deals with the Distinguished Encoding Rules (DER) encoding of OIDs.
+*/
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+/*
+appendDERContent appends the DER content octets (i.e.: no tag or length) of the receiver to dst. An error is returned if the receiver has fewer than two (2) arcs, or if its second arc exceeds 39 beneath a root arc of 0 or 1.
+*/
+func (o *ObjectIdentifier) appendDERContent(dst []byte) (enc []byte, err error) {
+	arcs := o.arcs()
+	if err = checkDERArcs(arcs); err == nil {
+		enc = appendDERArcs(dst, arcs)
+	}
+
+	return
+}
+
+/*
+appendDERArcs appends the DER content octets of arcs, which must have passed checkDERArcs, to dst.
+*/
+func appendDERArcs(dst []byte, arcs []NameAndNumberForm) []byte {
+	if x, y := arcs[0], arcs[1]; y.huge == nil && uint64(y.primaryIdentifier) <= ^uint64(0)-80 {
+		dst = appendBase128(dst, uint64(x.primaryIdentifier)*40+uint64(y.primaryIdentifier))
+	} else {
+		dst = appendBase128Big(dst, firstSubidentifier(arcs))
+	}
+
+	for i := 2; i < len(arcs); i++ {
+		if arcs[i].huge != nil {
+			dst = appendBase128Big(dst, arcs[i].huge)
+		} else {
+			dst = appendBase128(dst, uint64(arcs[i].primaryIdentifier))
+		}
+	}
+
+	return dst
+}
+
+/*
+checkDER returns an error if the receiver cannot be DER encoded, i.e.: it has fewer than two (2) arcs, or its second arc exceeds 39 beneath a root arc of 0 or 1.
+*/
+func (o *ObjectIdentifier) checkDER() error {
+	return checkDERArcs(o.arcs())
+}
+
+/*
+checkDERArcs implements checkDER upon arcs.
+*/
+func checkDERArcs(arcs []NameAndNumberForm) (err error) {
+	if len(arcs) < 2 {
+		err = errorw(ErrInvalidRoot, "%T requires at least two (2) arcs for DER encoding", (*ObjectIdentifier)(nil))
+		return
+	}
+
+	x, y := arcs[0], arcs[1]
+	if x.huge != nil || x.primaryIdentifier > MaxRootArc {
+		err = errorw(ErrInvalidRoot, "Bad root arc '%s' for DER encoding", x.number())
+	} else if secondArcExceeds(x, y) {
+		err = errorw(ErrInvalidNumberForm, "Bad second arc '%s' beneath root arc %d for DER encoding", y.number(), x.primaryIdentifier)
+	}
+
+	return
+}
+
+/*
+firstSubidentifier returns the combined value of the first two of arcs, as encoded in the first subidentifier of their DER content octets.
+*/
+func firstSubidentifier(arcs []NameAndNumberForm) *big.Int {
+	first := arcs[1].Big()
+	return first.Add(first, big.NewInt(int64(arcs[0].primaryIdentifier*40)))
+}
+
+/*
+EncodedLen returns the number of octets occupied by the complete DER encoding of the receiver, i.e.: its tag, length and content octets. This allows encoders to pre-size buffers prior to calling AppendDER.
+
+-1 is returned if the receiver cannot be DER encoded, e.g. if it has fewer than two (2) arcs.
+*/
+func (o *ObjectIdentifier) EncodedLen() int {
+	n := derContentLen(o.arcs())
+	if n < 0 {
+		return -1
+	}
+	return 1 + derLengthLen(n) + n
+}
+
+/*
+AppendDER appends the DER encoding of the receiver to dst and returns the extended buffer. If withTag is true, the OBJECT IDENTIFIER tag (6) and the length octets are appended ahead of the content octets; otherwise only the content octets are appended, e.g. for use within an IMPLICIT context where the caller writes its own header.
+
+dst is returned unmodified if the receiver cannot be DER encoded; see EncodedLen.
+*/
+func (o *ObjectIdentifier) AppendDER(dst []byte, withTag bool) []byte {
+	arcs := o.arcs()
+	n := derContentLen(arcs)
+	if n < 0 {
+		return dst
+	}
+
+	if withTag {
+		dst = append(dst, 0x06)
+		dst = appendDERLength(dst, n)
+	}
+
+	return appendDERArcs(dst, arcs)
+}
+
+/*
+derContentLen returns the number of DER content octets of arcs, or -1 if they cannot be DER encoded.
+*/
+func derContentLen(arcs []NameAndNumberForm) (n int) {
+	if checkDERArcs(arcs) != nil {
+		return -1
+	}
+
+	if x, y := arcs[0], arcs[1]; y.huge == nil && uint64(y.primaryIdentifier) <= ^uint64(0)-80 {
+		n = base128Len(uint64(x.primaryIdentifier)*40 + uint64(y.primaryIdentifier))
+	} else {
+		n = base128BigLen(firstSubidentifier(arcs))
+	}
+
+	for i := 2; i < len(arcs); i++ {
+		n += arcs[i].EncodedLen()
+	}
+
+	return
+}
+
+/*
+base128Len returns the number of octets needed to encode n as a base-128 integer.
+*/
+func base128Len(n uint64) int {
+	if n == 0 {
+		return 1
+	}
+	return (bits.Len64(n) + 6) / 7
+}
+
+/*
+base128BigLen returns the number of octets needed to encode n as a base-128 integer.
+*/
+func base128BigLen(n *big.Int) int {
+	if n.Sign() == 0 {
+		return 1
+	}
+	return (n.BitLen() + 6) / 7
+}
+
+/*
+derLengthLen returns the number of octets needed to encode the DER length n.
+*/
+func derLengthLen(n int) (l int) {
+	l = 1
+	if n >= 0x80 {
+		for ; n > 0; n >>= 8 {
+			l++
+		}
+	}
+
+	return
+}
+
+/*
+appendDERLength appends the DER length octets for n to dst, using the short form where possible.
+*/
+func appendDERLength(dst []byte, n int) []byte {
+	if n < 0x80 {
+		return append(dst, byte(n))
+	}
+
+	l := derLengthLen(n) - 1
+	dst = append(dst, 0x80|byte(l))
+	for i := l - 1; i >= 0; i-- {
+		dst = append(dst, byte(n>>(8*i)))
+	}
+
+	return dst
+}
+
+/*
+NewFromDER returns an instance of *ObjectIdentifier alongside an error following an attempt to parse b as the complete DER encoding (tag, length and content octets) of an OBJECT IDENTIFIER. Trailing octets are not permitted.
+*/
+func NewFromDER(b []byte) (o *ObjectIdentifier, err error) {
+	var content, rest []byte
+	if content, rest, err = readDERElement(b, 0x06); err != nil {
+		return
+	} else if len(rest) > 0 {
+		err = errorw(ErrMalformedEncoding, "%d trailing octets following DER encoded OID", len(rest))
+		return
+	}
+
+	return parseDERContent(content)
+}
+
+/*
+parseDERContent parses the DER content octets of an OBJECT IDENTIFIER. Non-minimal subidentifiers are rejected.
+*/
+func parseDERContent(content []byte) (o *ObjectIdentifier, err error) {
+	if len(content) == 0 {
+		err = errorw(ErrMalformedEncoding, "No content octets for DER encoded OID")
+		return
+	} else if content[len(content)-1]&0x80 != 0 {
+		err = errorw(ErrMalformedEncoding, "Truncated subidentifier in DER encoded OID")
+		return
+	}
+
+	var n int
+	for i := 0; i < len(content); i++ {
+		if content[i]&0x80 == 0 {
+			n++
+		}
+	}
+
+	t := newObjectIdentifier(n + 1)
+	t.nANF = append(t.nANF, NameAndNumberForm{}, NameAndNumberForm{})
+	for i, sub := 0, 0; i < len(content); sub++ {
+		if content[i] == 0x80 {
+			err = errorw(ErrInvalidNumberForm, "Non-minimal subidentifier #%d in DER encoded OID", sub)
+			return
+		}
+
+		j := i
+		for content[j]&0x80 != 0 {
+			j++
+		}
+
+		var arc NameAndNumberForm
+		if j-i < 9 {
+			var v uint64
+			for k := i; k <= j; k++ {
+				v = v<<7 | uint64(content[k]&0x7F)
+			}
+			if v <= uint64(^uint(0)) {
+				arc.primaryIdentifier = uint(v)
+			} else {
+				arc.setBig(new(big.Int).SetUint64(v))
+			}
+		} else {
+			v := new(big.Int)
+			for k := i; k <= j; k++ {
+				v.Lsh(v, 7)
+				v.Or(v, big.NewInt(int64(content[k]&0x7F)))
+			}
+			arc.setBig(v)
+		}
+
+		if sub > 0 {
+			t.nANF = append(t.nANF, arc)
+		} else {
+			t.nANF[0], t.nANF[1] = splitFirst(arc)
+		}
+
+		i = j + 1
+	}
+
+	o = t
+	return
+}
+
+/*
+splitFirst returns the first two arcs encoded within the first subidentifier first. Values of 80 or more always denote a root arc of 2, as the second arc beneath a root arc of 0 or 1 cannot exceed 39, thus the second arc is first minus 80 rather than first modulo 40.
+*/
+func splitFirst(first NameAndNumberForm) (x, y NameAndNumberForm) {
+	if first.cmp(NameAndNumberForm{primaryIdentifier: 80}) >= 0 {
+		second := first.Big()
+		x.primaryIdentifier = 2
+		y.setBig(second.Sub(second, big.NewInt(80)))
+	} else {
+		x.primaryIdentifier = first.primaryIdentifier / 40
+		y.primaryIdentifier = first.primaryIdentifier % 40
+	}
+
+	return
+}
+
+/*
+SplitFirstSubidentifier returns the root and second arcs encoded within first, the value of the first subidentifier of the DER content octets of an OID, i.e.: 40*X+Y. An error is returned if first is nil or negative.
+
+Values of 80 or more always denote a root arc of 2, since the second arc beneath a root arc of 0 or 1 cannot exceed 39. Thus a first subidentifier of 1079 yields 2.999, rather than the 26.39 a naive division by 40 would produce.
+*/
+func SplitFirstSubidentifier(first *big.Int) (root uint, second *big.Int, err error) {
+	if first == nil || first.Sign() < 0 {
+		err = errorw(ErrInvalidNumberForm, "First subidentifier cannot be nil or negative")
+		return
+	}
+
+	var arc NameAndNumberForm
+	arc.setBig(first)
+	x, y := splitFirst(arc)
+	root, second = x.primaryIdentifier, y.Big()
+
+	return
+}
+
+/*
+SplitDERContent splits the DER content octets (i.e.: no tag or length) of an OBJECT IDENTIFIER into its subidentifiers, each of which is a subslice of content. The first subidentifier encodes the first two arcs combined; see SplitFirstSubidentifier. An error is returned should content be empty, or bear a truncated or non-minimal subidentifier.
+
+This allows the size of each arc of an encoded OID to be examined, e.g. to locate an oversized arc, without decoding the whole.
+*/
+func SplitDERContent(content []byte) (subs [][]byte, err error) {
+	if len(content) == 0 {
+		err = errorw(ErrMalformedEncoding, "No content octets for DER encoded OID")
+		return
+	} else if content[len(content)-1]&0x80 != 0 {
+		err = errorw(ErrMalformedEncoding, "Truncated subidentifier in DER encoded OID")
+		return
+	}
+
+	for i := 0; i < len(content); {
+		if content[i] == 0x80 {
+			err = errorw(ErrInvalidNumberForm, "Non-minimal subidentifier #%d in DER encoded OID", len(subs))
+			subs = nil
+			return
+		}
+
+		j := i
+		for content[j]&0x80 != 0 {
+			j++
+		}
+
+		subs = append(subs, content[i:j+1])
+		i = j + 1
+	}
+
+	return
+}
+
+/*
+SubidentifierLens returns the number of DER content octets occupied by each subidentifier of the receiver. The first element describes the first two arcs, which are encoded together as a single subidentifier, thus the result bears one fewer element than the receiver has arcs. The elements sum to the content length; see EncodedLen.
+
+A nil slice is returned if the receiver cannot be DER encoded.
+*/
+func (o *ObjectIdentifier) SubidentifierLens() (lens []int) {
+	arcs := o.arcs()
+	if checkDERArcs(arcs) != nil {
+		return
+	}
+
+	lens = make([]int, 0, len(arcs)-1)
+	if x, y := arcs[0], arcs[1]; y.huge == nil && uint64(y.primaryIdentifier) <= ^uint64(0)-80 {
+		lens = append(lens, base128Len(uint64(x.primaryIdentifier)*40+uint64(y.primaryIdentifier)))
+	} else {
+		lens = append(lens, base128BigLen(firstSubidentifier(arcs)))
+	}
+
+	for i := 2; i < len(arcs); i++ {
+		lens = append(lens, arcs[i].EncodedLen())
+	}
+
+	return
+}
+
+/*
+EncodedLen returns the number of octets occupied by the receiver when encoded as a base-128 subidentifier, as it would be in the DER content octets of an OID beyond its first two arcs. See ObjectIdentifier.SubidentifierLens for the lengths of a complete OID.
+*/
+func (nanf NameAndNumberForm) EncodedLen() int {
+	if nanf.huge != nil {
+		return base128BigLen(nanf.huge)
+	}
+
+	return base128Len(uint64(nanf.primaryIdentifier))
+}
+
+/*
+readDERElement reads a single DER element bearing tag from the start of b, returning its content octets and any octets which follow it.
+*/
+func readDERElement(b []byte, tag byte) (content, rest []byte, err error) {
+	if len(b) < 2 {
+		err = errorw(ErrMalformedEncoding, "Truncated DER element")
+		return
+	} else if b[0] != tag {
+		err = errorw(ErrMalformedEncoding, "Unexpected DER tag 0x%02x [hint: expected 0x%02x]", b[0], tag)
+		return
+	}
+
+	n, hdr := int(b[1]), 2
+	if n&0x80 != 0 {
+		l := n & 0x7F
+		if l == 0 || l > 4 || len(b) < 2+l {
+			err = errorw(ErrMalformedEncoding, "Unsupported or truncated DER length")
+			return
+		}
+
+		n = 0
+		for i := 0; i < l; i++ {
+			n = n<<8 | int(b[2+i])
+		}
+		if n < 0x80 || derLengthLen(n) != l+1 {
+			err = errorw(ErrMalformedEncoding, "Non-minimal DER length")
+			return
+		}
+		hdr += l
+	}
+
+	if len(b)-hdr < n {
+		err = errorw(ErrMalformedEncoding, "Truncated DER element [hint: %d content octets expected, %d present]", n, len(b)-hdr)
+		return
+	}
+
+	content, rest = b[hdr:hdr+n], b[hdr+n:]
+	return
+}
+
+/*
+appendBase128 appends n to dst as a base-128 integer, with the high bit of all but the final octet set.
+*/
+func appendBase128(dst []byte, n uint64) []byte {
+	var buf [10]byte
+	i := len(buf) - 1
+	buf[i] = byte(n & 0x7F)
+	for n >>= 7; n > 0; n >>= 7 {
+		i--
+		buf[i] = byte(n&0x7F) | 0x80
+	}
+
+	return append(dst, buf[i:]...)
+}
+
+/*
+appendBase128Big appends n to dst as a base-128 integer, with the high bit of all but the final octet set.
+*/
+func appendBase128Big(dst []byte, n *big.Int) []byte {
+	groups := (n.BitLen() + 6) / 7
+	if groups == 0 {
+		return append(dst, 0)
+	}
+
+	for i := groups - 1; i >= 0; i-- {
+		var b byte
+		for j := 6; j >= 0; j-- {
+			b = b<<1 | byte(n.Bit(i*7+j))
+		}
+		if i > 0 {
+			b |= 0x80
+		}
+		dst = append(dst, b)
+	}
+
+	return dst
+}
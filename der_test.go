@@ -0,0 +1,167 @@
+package oid
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestAppendDER(t *testing.T) {
+	for _, tc := range []struct {
+		dot string
+		der string
+	}{
+		{`1.3.6.1`, `06032b0601`},
+		{`0.9.2342.19200300.100.1.25`, `060a0992268993f22c640119`},
+		{`1.2.840.113549`, `06062a864886f70d`},
+		{`2.999.3`, `0603883703`},
+		{`2.25.340282366920938463463374607431768211455`, `06146983ffffffffffffffffffffffffffffffffff7f`},
+	} {
+		o, err := NewFromDot(tc.dot)
+		if err != nil {
+			t.Errorf("%s: %v", tc.dot, err)
+			continue
+		}
+
+		want, _ := hex.DecodeString(tc.der)
+		if n := o.EncodedLen(); n != len(want) {
+			t.Errorf("%s: EncodedLen got %d, want %d", tc.dot, n, len(want))
+		}
+		if got := o.AppendDER([]byte{0xff}, true); string(got) != "\xff"+string(want) {
+			t.Errorf("%s: AppendDER got %x, want ff%x", tc.dot, got, want)
+		}
+		if got := o.AppendDER(nil, false); string(got) != string(want[2:]) {
+			t.Errorf("%s: AppendDER content got %x, want %x", tc.dot, got, want[2:])
+		}
+
+		if back, err := NewFromDER(want); err != nil || back.DotNotation() != tc.dot {
+			t.Errorf("%s: NewFromDER got %v (%v)", tc.dot, back, err)
+		}
+	}
+
+	if root, _ := NewFromDot(`2`); root.EncodedLen() != -1 || len(root.AppendDER(nil, true)) != 0 {
+		t.Errorf("single arc: expected no DER encoding")
+	}
+}
+
+func TestNewFromDERErrors(t *testing.T) {
+	for _, tc := range []struct {
+		der string
+		err error
+	}{
+		{``, ErrMalformedEncoding},
+		{`0400`, ErrMalformedEncoding},
+		{`0600`, ErrMalformedEncoding},
+		{`06022b`, ErrMalformedEncoding},
+		{`06012b00`, ErrMalformedEncoding},
+		{`06022b86`, ErrMalformedEncoding},
+		{`0681012b`, ErrMalformedEncoding},
+		{`06032b8001`, ErrInvalidNumberForm},
+	} {
+		b, _ := hex.DecodeString(tc.der)
+		if _, err := NewFromDER(b); !errors.Is(err, tc.err) {
+			t.Errorf("%q: got %v, want %v", tc.der, err, tc.err)
+		}
+	}
+}
+
+func TestDERSequence(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		dots []string
+		der  string
+	}{
+		{`empty`, nil, `3000`},
+		{`extKeyUsage`, []string{`1.3.6.1.5.5.7.3.1`, `1.3.6.1.5.5.7.3.2`}, `301406082b0601050507030106082b06010505070302`},
+	} {
+		oids := make([]*ObjectIdentifier, len(tc.dots))
+		for i := range tc.dots {
+			oids[i], _ = NewFromDot(tc.dots[i])
+		}
+
+		enc, err := MarshalDERSequence(oids)
+		if err != nil || hex.EncodeToString(enc) != tc.der {
+			t.Errorf("%s: MarshalDERSequence got %x (%v), want %s", tc.name, enc, err, tc.der)
+		}
+
+		back, err := ParseDERSequence(enc)
+		if err != nil || len(back) != len(tc.dots) {
+			t.Errorf("%s: ParseDERSequence got %d OIDs (%v), want %d", tc.name, len(back), err, len(tc.dots))
+			continue
+		}
+		for i := range back {
+			if back[i].DotNotation() != tc.dots[i] {
+				t.Errorf("%s: element #%d got %s, want %s", tc.name, i, back[i], tc.dots[i])
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		der string
+		err error
+	}{
+		{`0603 2b0601`, ErrMalformedEncoding},
+		{`3000 00`, ErrMalformedEncoding},
+		{`3003 0400 00`, ErrMalformedEncoding},
+		{`3004 06022b`, ErrMalformedEncoding},
+	} {
+		b, _ := hex.DecodeString(replaceAll(tc.der, ` `, ``))
+		if oids, err := ParseDERSequence(b); !errors.Is(err, tc.err) || oids != nil {
+			t.Errorf("%q: got %v (%v), want %v", tc.der, oids, err, tc.err)
+		}
+	}
+}
+
+func TestSubidentifiers(t *testing.T) {
+	for _, tc := range []struct {
+		dot  string
+		lens []int
+	}{
+		{`1.3`, []int{1}},
+		{`1.3.6.1.4.1.56521`, []int{1, 1, 1, 1, 1, 3}},
+		{`2.999.3`, []int{2, 1}},
+		{`1.2.840.113549.1.1.11`, []int{1, 2, 3, 1, 1, 1}},
+	} {
+		o, _ := NewFromDot(tc.dot)
+		lens := o.SubidentifierLens()
+		subs, err := SplitDERContent(o.AppendDER(nil, false))
+		if err != nil || len(lens) != len(tc.lens) || len(subs) != len(tc.lens) {
+			t.Errorf("%s: got lens %v and %d subidentifiers (%v), want %v", tc.dot, lens, len(subs), err, tc.lens)
+			continue
+		}
+
+		for i := range tc.lens {
+			if lens[i] != tc.lens[i] || len(subs[i]) != tc.lens[i] {
+				t.Errorf("%s: subidentifier #%d got %d and %d octets, want %d", tc.dot, i, lens[i], len(subs[i]), tc.lens[i])
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		first  int64
+		root   uint
+		second int64
+		fail   bool
+	}{
+		{43, 1, 3, false},
+		{39, 0, 39, false},
+		{80, 2, 0, false},
+		{1079, 2, 999, false},
+		{-1, 0, 0, true},
+	} {
+		root, second, err := SplitFirstSubidentifier(big.NewInt(tc.first))
+		if (err != nil) != tc.fail {
+			t.Errorf("%d: got error %v, want failure %t", tc.first, err, tc.fail)
+		} else if !tc.fail && (root != tc.root || second.Int64() != tc.second) {
+			t.Errorf("%d: got %d.%s, want %d.%d", tc.first, root, second, tc.root, tc.second)
+		}
+	}
+
+	for _, content := range []string{``, `2b86`, `2b8001`} {
+		b, _ := hex.DecodeString(content)
+		if subs, err := SplitDERContent(b); err == nil || subs != nil {
+			t.Errorf("%q: got %v, expected error", content, subs)
+		}
+	}
+}
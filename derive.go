@@ -0,0 +1,49 @@
+package oid
+
+/*
+derive.go deals with the deterministic derivation of child arcs from arbitrary strings.
+*/
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+/*
+DeriveObjectIdentifier returns a new child of base alongside an error. The number form of the child arc is derived from name using the following algorithm:
+
+  - Compute the SHA-256 digest of the UTF-8 octets of name
+  - Read the first four (4) octets of the digest as a big-endian unsigned integer
+  - Clear the most significant bit, yielding a value between 0 and 2147483647
+
+The result fits within a signed 32-bit integer, and is therefore safe for use with asn1.ObjectIdentifier on any platform. The same name always yields the same arc, allowing stable OIDs to be minted for internal identifiers without the need of a central allocator. Callers should be mindful that distinct names may, albeit rarely, yield the same arc.
+
+If name qualifies as an ASN.1 identifier, it is used as the identifier of the child arc.
+*/
+func DeriveObjectIdentifier(base ObjectIdentifier, name string) (o *ObjectIdentifier, err error) {
+	if !base.valid() {
+		err = errorw(ErrInvalidRoot, "%T base did not pass validity checks", base)
+		return
+	} else if len(name) == 0 {
+		err = errorw(ErrEmptyInput, "No name for DeriveObjectIdentifier to read")
+		return
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	child := NameAndNumberForm{
+		primaryIdentifier: uint(binary.BigEndian.Uint32(sum[:4]) & 0x7FFFFFFF),
+	}
+
+	if valid, _ := identifierIsValid(name, false); valid {
+		child.identifier = name
+	}
+
+	// Names and other metadata describe base rather than
+	// its descendants, thus only the arcs are inherited.
+	arcs := base.arcs()
+	o = newObjectIdentifier(len(arcs) + 1)
+	o.nANF = append(o.nANF, arcs...)
+	o.nANF = append(o.nANF, child)
+
+	return
+}
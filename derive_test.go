@@ -0,0 +1,66 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeriveObjectIdentifierNamedBase(t *testing.T) {
+	b, err := NewFromNaNF(`{ iso(1) identified-organization(3) dod(6) }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetName(`dodBase`)
+	b.SetAltNames(`dodAlias`)
+	b.SetDescription(`US Department of Defense`)
+	b.SetStatus(StatusDeprecated)
+
+	o, err := DeriveObjectIdentifier(*b, `Widget_X`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if name := o.Name(); name != `` {
+		t.Errorf("Name: got %q, want none", name)
+	} else if aka := o.AltNames(); len(aka) != 0 {
+		t.Errorf("AltNames: got %v, want none", aka)
+	} else if desc := o.Description(); desc != `` {
+		t.Errorf("Description: got %q, want none", desc)
+	} else if st := o.Status(); st != StatusCurrent {
+		t.Errorf("Status: got %s, want %s", st, StatusCurrent)
+	}
+
+	if got, want := o.String(), `{ iso(1) identified-organization(3) dod(6) 978071453 }`; got != want {
+		t.Errorf("String: got %s, want %s", got, want)
+	}
+
+	// the base must be left untouched
+	if b.Name() != `dodBase` || b.len() != 3 {
+		t.Errorf("base modified: %s (%s)", b, b.Name())
+	}
+}
+
+func TestDeriveObjectIdentifier(t *testing.T) {
+	base := mustDot(t, `1.3.6.1.4.1.56521`)
+	for _, tc := range []struct {
+		name string
+		nanf string
+		err  error
+	}{
+		{`widget`, `{ 1 3 6 1 4 1 56521 widget(180437198) }`, nil},
+		{`Widget`, `{ 1 3 6 1 4 1 56521 2111283852 }`, nil},
+		{`user:42`, `{ 1 3 6 1 4 1 56521 1782567995 }`, nil},
+		{``, ``, ErrEmptyInput},
+	} {
+		o, err := DeriveObjectIdentifier(*base, tc.name)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got %v, want %v", tc.name, err, tc.err)
+		} else if err == nil && o.StringAs(FormNaNF) != tc.nanf {
+			t.Errorf("%q: got %s, want %s", tc.name, o.StringAs(FormNaNF), tc.nanf)
+		}
+	}
+
+	if _, err := DeriveObjectIdentifier(ObjectIdentifier{}, `widget`); !errors.Is(err, ErrInvalidRoot) {
+		t.Errorf("zero base: got %v, want %v", err, ErrInvalidRoot)
+	}
+}
@@ -0,0 +1,63 @@
+package oid
+
+/*
+derseq.go deals with the DER encoding of SEQUENCE OF OBJECT IDENTIFIER values, such as the ExtKeyUsageSyntax of RFC 5280.
+*/
+
+/*
+AppendDERSequence appends the DER encoding of a SEQUENCE OF OBJECT IDENTIFIER bearing oids, in order, to dst and returns the extended buffer alongside an error. An error is returned if any of oids cannot be DER encoded.
+*/
+func AppendDERSequence(dst []byte, oids []*ObjectIdentifier) (enc []byte, err error) {
+	var n int
+	for i := 0; i < len(oids); i++ {
+		if err = oids[i].checkDER(); err != nil {
+			return
+		}
+		n += oids[i].EncodedLen()
+	}
+
+	enc = append(dst, 0x30)
+	enc = appendDERLength(enc, n)
+	for i := 0; i < len(oids); i++ {
+		enc = oids[i].AppendDER(enc, true)
+	}
+
+	return
+}
+
+/*
+MarshalDERSequence returns the DER encoding of a SEQUENCE OF OBJECT IDENTIFIER bearing oids, in order, alongside an error, e.g. the value of an extended key usage certificate extension.
+*/
+func MarshalDERSequence(oids []*ObjectIdentifier) ([]byte, error) {
+	return AppendDERSequence(nil, oids)
+}
+
+/*
+ParseDERSequence returns the OIDs present within b, which must be the complete DER encoding of a SEQUENCE OF OBJECT IDENTIFIER, alongside an error. Trailing octets are not permitted.
+*/
+func ParseDERSequence(b []byte) (oids []*ObjectIdentifier, err error) {
+	var content, rest []byte
+	if content, rest, err = readDERElement(b, 0x30); err != nil {
+		return
+	} else if len(rest) > 0 {
+		err = errorw(ErrMalformedEncoding, "%d trailing octets following DER encoded SEQUENCE", len(rest))
+		return
+	}
+
+	for len(content) > 0 {
+		var elem []byte
+		if elem, content, err = readDERElement(content, 0x06); err != nil {
+			oids = nil
+			return
+		}
+
+		var o *ObjectIdentifier
+		if o, err = parseDERContent(elem); err != nil {
+			oids = nil
+			return
+		}
+		oids = append(oids, o)
+	}
+
+	return
+}
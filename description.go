@@ -0,0 +1,35 @@
+package oid
+
+/*
+description.go deals with the free-form description of an ObjectIdentifier.
+*/
+
+/*
+SetDescription assigns desc as the description of the receiver, e.g. the DESCRIPTION clause of a MIB object or schema definition. A zero string clears the description.
+*/
+func (o *ObjectIdentifier) SetDescription(desc string) (err error) {
+	if o.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", o)
+		return
+	}
+
+	o.lock()
+	o.desc = desc
+	o.unlock()
+
+	return
+}
+
+/*
+Description returns the description of the receiver, or a zero string if unset.
+*/
+func (o *ObjectIdentifier) Description() string {
+	if o.IsZero() {
+		return ``
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	return o.desc
+}
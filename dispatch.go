@@ -0,0 +1,146 @@
+package oid
+
+/*
+dispatch.go deals with Dispatcher, which routes OIDs to handler functions registered for exact OIDs or entire subtrees.
+*/
+
+import "sync"
+
+/*
+HandlerFunc is a function invoked by a Dispatcher for a matching OID. The o argument is the OID being dispatched, rather than that for which the handler was registered, and arg is the value passed to Dispatch.
+*/
+type HandlerFunc[A any] func(o *ObjectIdentifier, arg A) error
+
+/*
+Dispatcher maps OIDs to handler functions, invoking the best match for a given OID. This is useful for the processing of LDAP controls, CMS attributes, SNMP traps and the like, e.g.:
+
+	d := oid.NewDispatcher[*ldap.Control]()
+	d.Handle(pagedResultsOID, handlePaging)
+	d.HandlePrefix(vendorArc, handleVendorControl)
+
+	err := d.Dispatch(ctrl.ControlType, ctrl)
+
+The best match for an OID is, in order of preference, the handler registered for that exact OID, the handler registered for the nearest ancestral subtree and lastly the default handler, if any.
+
+The zero value is not ready for use; see NewDispatcher.
+*/
+type Dispatcher[A any] struct {
+	mu     sync.RWMutex
+	exact  *TypedRegistry[HandlerFunc[A]]
+	prefix *TypedRegistry[HandlerFunc[A]]
+	def    HandlerFunc[A]
+}
+
+/*
+NewDispatcher returns a new, empty instance of *Dispatcher[A].
+*/
+func NewDispatcher[A any]() *Dispatcher[A] {
+	return &Dispatcher[A]{
+		exact:  NewTypedRegistry[HandlerFunc[A]](),
+		prefix: NewTypedRegistry[HandlerFunc[A]](),
+	}
+}
+
+/*
+Handle registers h for the OID o exactly, replacing any handler previously registered for it.
+*/
+func (d *Dispatcher[A]) Handle(o *ObjectIdentifier, h HandlerFunc[A]) (err error) {
+	if err = d.check(h); err == nil {
+		err = d.exact.Set(o, h)
+	}
+
+	return
+}
+
+/*
+HandlePrefix registers h for base and all of its descendants, replacing any handler previously registered for the same subtree. Handlers registered for nested subtrees take precedence within them.
+*/
+func (d *Dispatcher[A]) HandlePrefix(base *ObjectIdentifier, h HandlerFunc[A]) (err error) {
+	if err = d.check(h); err == nil {
+		err = d.prefix.Set(base, h)
+	}
+
+	return
+}
+
+/*
+HandleDefault registers h for all OIDs lacking a better match. A nil h removes the default handler.
+*/
+func (d *Dispatcher[A]) HandleDefault(h HandlerFunc[A]) {
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.def = h
+}
+
+/*
+Match returns the best matching handler for the OID described by term, alongside a Boolean value indicative of a successful match. Supported term types are those of TypedRegistry.LongestMatch.
+*/
+func (d *Dispatcher[A]) Match(term any) (h HandlerFunc[A], found bool) {
+	if d == nil {
+		return
+	}
+
+	h, found = d.match(typedTermOID(term))
+	return
+}
+
+/*
+Dispatch invokes the best matching handler for the OID described by term, passing arg, and returns its error. An error wrapping ErrNotFound is returned if no handler matches, and one wrapping ErrInvalidRoot if term does not describe a valid OID. Supported term types are those of TypedRegistry.LongestMatch.
+*/
+func (d *Dispatcher[A]) Dispatch(term any, arg A) (err error) {
+	if d == nil {
+		err = errorw(ErrNilInstance, "%T is nil", d)
+		return
+	}
+
+	o := typedTermOID(term)
+	if !o.valid() {
+		err = errorw(ErrInvalidRoot, "Cannot dispatch %T (%v)", term, term)
+		return
+	}
+
+	h, found := d.match(o)
+	if !found {
+		err = errorw(ErrNotFound, "No handler for %s", o.DotNotation())
+		return
+	}
+
+	return h(o, arg)
+}
+
+/*
+match returns the best matching handler for o.
+*/
+func (d *Dispatcher[A]) match(o *ObjectIdentifier) (h HandlerFunc[A], found bool) {
+	if o.valid() {
+		if h, found = d.exact.Get(o); found {
+			return
+		} else if _, h, found = d.prefix.LongestMatch(o); found {
+			return
+		}
+	}
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	h, found = d.def, d.def != nil
+	return
+}
+
+/*
+check returns an error if the receiver or h is nil.
+*/
+func (d *Dispatcher[A]) check(h HandlerFunc[A]) (err error) {
+	if d == nil || d.exact == nil {
+		err = errorw(ErrNilInstance, "%T is nil", d)
+	} else if h == nil {
+		err = errorw(ErrNilInstance, "%T is nil", h)
+	}
+
+	return
+}
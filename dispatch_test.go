@@ -0,0 +1,74 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDispatch(t *testing.T) {
+	d := NewDispatcher[*string]()
+	handler := func(label string) HandlerFunc[*string] {
+		return func(o *ObjectIdentifier, arg *string) error {
+			*arg = label + ` ` + o.DotNotation()
+			return nil
+		}
+	}
+
+	for _, err := range []error{
+		d.Handle(mustDot(t, `1.3.6.1.4.1.56521.1`), handler(`exact`)),
+		d.HandlePrefix(mustDot(t, `1.3.6.1.4.1`), handler(`enterprise`)),
+		d.HandlePrefix(mustDot(t, `1.3.6.1.4.1.56521`), handler(`vendor`)),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		term any
+		want string
+		err  error
+	}{
+		{`1.3.6.1.4.1.56521.1`, `exact 1.3.6.1.4.1.56521.1`, nil},
+		{[]int{1, 3, 6, 1, 4, 1, 56521, 1, 5}, `vendor 1.3.6.1.4.1.56521.1.5`, nil},
+		{mustDot(t, `1.3.6.1.4.1.1466`), `enterprise 1.3.6.1.4.1.1466`, nil},
+		{`1.3.6.1.4.1`, `enterprise 1.3.6.1.4.1`, nil},
+		{`1.3.6.1.4`, ``, ErrNotFound},
+		{`2.5.4.3`, ``, ErrNotFound},
+		{`bogus!`, ``, ErrInvalidRoot},
+		{42, ``, ErrInvalidRoot},
+	} {
+		var got string
+		if err := d.Dispatch(tc.term, &got); !errors.Is(err, tc.err) || got != tc.want {
+			t.Errorf("Dispatch(%v): got %q (%v), want %q (%v)", tc.term, got, err, tc.want, tc.err)
+		}
+	}
+
+	d.HandleDefault(handler(`default`))
+	var got string
+	if err := d.Dispatch(`2.5.4.3`, &got); err != nil || got != `default 2.5.4.3` {
+		t.Errorf("Dispatch with default: got %q (%v)", got, err)
+	} else if _, found := d.Match(`2.5.4.3`); !found {
+		t.Errorf("Match with default: not found")
+	}
+
+	d.HandleDefault(nil)
+	if _, found := d.Match(`2.5.4.3`); found {
+		t.Errorf("Match after removing default: found")
+	}
+
+	var nd *Dispatcher[*string]
+	for _, tc := range []struct {
+		name string
+		err  error
+	}{
+		{`nil handler`, d.Handle(mustDot(t, `2.5`), nil)},
+		{`nil prefix handler`, d.HandlePrefix(mustDot(t, `2.5`), nil)},
+		{`nil dispatcher`, nd.Handle(mustDot(t, `2.5`), handler(`x`))},
+		{`nil dispatch`, nd.Dispatch(`2.5`, &got)},
+	} {
+		if !errors.Is(tc.err, ErrNilInstance) {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.err, ErrNilInstance)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package oid
+
+/*
+dn.go deals with the attribute type and value pairs found within the string forms of distinguished names.
+*/
+
+/*
+ParseTypeAndValue returns an instance of *ObjectIdentifier and the associated value alongside an error following an attempt to parse x as a single attribute type and value pair of a distinguished name string, e.g.:
+
+	o, val, err := oid.ParseTypeAndValue(`2.5.4.3=Jesse`, nil)
+	o, val, err = oid.ParseTypeAndValue(`OID.2.5.4.10=Example`, nil)
+	o, val, err = oid.ParseTypeAndValue(`cn=Jesse`, nil)
+
+The attribute type may be a numericoid, optionally prefixed by "OID." in any case, as emitted by RFC 1779 and some certificate tooling, or a descr, which is resolved in the manner of ParseOIDOrDescr using m. Whitespace surrounding the attribute type, and preceding the value, is ignored.
+
+The value is returned as-is, i.e.: following the first equals sign and without unescaping, which is left to the distinguished name parser. Splitting a distinguished name into its individual pairs is likewise left to the caller.
+*/
+func ParseTypeAndValue(x string, m ObjectIdentifierMap) (o *ObjectIdentifier, value string, err error) {
+	idx := indexRune(x, '=')
+	if idx == -1 {
+		err = errorw(ErrSyntax, "No '=' in attribute type and value '%s'", x)
+		return
+	}
+
+	typ := trimS(x[:idx])
+	if len(typ) > 4 && eq(typ[:4], `OID.`) {
+		typ = typ[4:]
+	}
+
+	var t *ObjectIdentifier
+	if t, err = ParseOIDOrDescr(typ, m); err != nil {
+		return
+	}
+
+	value = trimL(x[idx+1:], " \t")
+	o = t
+
+	return
+}
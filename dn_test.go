@@ -0,0 +1,42 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseTypeAndValue(t *testing.T) {
+	own := ObjectIdentifierMap{`exampleAttr`: mustDot(t, `1.3.6.1.4.1.56521.1`)}
+
+	for _, tc := range []struct {
+		in    string
+		m     ObjectIdentifierMap
+		dot   string
+		value string
+		err   error
+	}{
+		{`2.5.4.3=Jesse`, nil, `2.5.4.3`, `Jesse`, nil},
+		{`OID.2.5.4.10=Example`, nil, `2.5.4.10`, `Example`, nil},
+		{`oid.2.5.4.10=Example`, nil, `2.5.4.10`, `Example`, nil},
+		{`cn=Jesse`, nil, `2.5.4.3`, `Jesse`, nil},
+		{`CN = Jesse Coretta`, nil, `2.5.4.3`, `Jesse Coretta`, nil},
+		{`cn=a\=b=c `, nil, `2.5.4.3`, `a\=b=c `, nil},
+		{`cn=`, nil, `2.5.4.3`, ``, nil},
+		{`exampleAttr=x`, own, `1.3.6.1.4.1.56521.1`, `x`, nil},
+		{`exampleAttr=x`, nil, ``, ``, ErrNotFound},
+		{`cn`, nil, ``, ``, ErrSyntax},
+		{`=Jesse`, nil, ``, ``, ErrEmptyInput},
+		{`OID.2=Jesse`, nil, ``, ``, ErrInvalidNumberForm},
+		{`2.5.04.3=Jesse`, nil, ``, ``, ErrInvalidNumberForm},
+		{`c_n=Jesse`, nil, ``, ``, ErrInvalidIdentifier},
+	} {
+		o, value, err := ParseTypeAndValue(tc.in, tc.m)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if err != nil {
+			continue
+		} else if d := o.DotNotation(); d != tc.dot || value != tc.value {
+			t.Errorf("%q: got %s, %q, want %s, %q", tc.in, d, value, tc.dot, tc.value)
+		}
+	}
+}
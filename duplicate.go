@@ -0,0 +1,146 @@
+package oid
+
+/*
+duplicate.go deals with the handling of duplicate OID registrations within a Registry.
+*/
+
+/*
+DuplicatePolicy describes the manner in which a Registry handles the assignment of an OID already present within it.
+*/
+type DuplicatePolicy uint8
+
+const (
+	DuplicateReplace DuplicatePolicy = iota // assign as requested, possibly under several keys (default)
+	DuplicateMerge                          // merge names and metadata into the existing entry
+	DuplicateReject                         // return an error
+)
+
+var duplicatePolicyNames []string = []string{`replace`, `merge`, `reject`}
+
+/*
+String returns the string name of the receiver, e.g. "merge".
+*/
+func (p DuplicatePolicy) String() string {
+	if int(p) < len(duplicatePolicyNames) {
+		return duplicatePolicyNames[p]
+	}
+	return sprintf("DuplicatePolicy(%d)", uint8(p))
+}
+
+/*
+SetDuplicatePolicy sets the manner in which the Set, Lease, Rollback and Allocate methods of the receiver, including within a Batch, handle an OID that is already present, whether under the same key or another:
+
+  - DuplicateReplace assigns the OID under the requested key, replacing any assignment of that key. The same OID may thus be present under several keys. This is the default.
+  - DuplicateMerge merges the names, arc identifiers and metadata of the OID into the existing entry, which retains its key. Names of the existing entry are preferred, and those of the new OID become alt names; a description or non-current status is adopted only where the existing entry lacks one.
+  - DuplicateReject returns an error, leaving the existing entry unchanged.
+
+In practice, Allocate is unaffected, as it never assigns an OID already present.
+*/
+func (r *Registry) SetDuplicatePolicy(p DuplicatePolicy) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dup = p
+}
+
+/*
+DuplicatePolicy returns the DuplicatePolicy in effect for the receiver.
+*/
+func (r *Registry) DuplicatePolicy() DuplicatePolicy {
+	if r == nil {
+		return DuplicateReplace
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.dup
+}
+
+/*
+place determines the key under which x should be assigned within oids, and the *ObjectIdentifier to be assigned, in accordance with the DuplicatePolicy, ParentConstraint and Limits of the receiver. The caller must hold the lock.
+*/
+func (r *Registry) place(oids ObjectIdentifierMap, key string, x *ObjectIdentifier) (k string, v *ObjectIdentifier, err error) {
+	if err = r.parents.Check(x); err != nil {
+		return
+	} else if err = r.limits.check(x); err != nil {
+		return
+	}
+
+	k, v = key, x
+	if r.dup == DuplicateReplace {
+		return
+	}
+
+	ek, existing := duplicateOf(oids, key, x)
+	if existing == nil {
+		return
+	} else if r.dup == DuplicateReject {
+		err = errorw(ErrConflict, "OID %s is already registered under key '%s'", x.DotNotation(), ek)
+		return
+	}
+
+	k, v = ek, existing.clone()
+	v.merge(x)
+
+	return
+}
+
+/*
+duplicateOf returns the key and value of the entry within oids bearing the same OID as x, if any. An entry under key is preferred.
+*/
+func duplicateOf(oids ObjectIdentifierMap, key string, x *ObjectIdentifier) (k string, v *ObjectIdentifier) {
+	if e, found := oids[key]; found && !e.IsZero() && compareArcs(e, x) == 0 {
+		return key, e
+	}
+
+	for ek, e := range oids {
+		if !e.IsZero() && compareArcs(e, x) == 0 && (v == nil || ek < k) {
+			k, v = ek, e
+		}
+	}
+
+	return
+}
+
+/*
+merge adds the names, arc identifiers and metadata of x, which must bear the same OID, to those of the receiver. The receiver's own names and metadata are preferred.
+*/
+func (o *ObjectIdentifier) merge(x *ObjectIdentifier) {
+	x = x.clone()
+
+	o.lock()
+	defer o.unlock()
+
+	arcs := o.copyArcs()
+	for i := 0; i < len(arcs) && i < len(x.nANF); i++ {
+		if len(arcs[i].identifier) == 0 {
+			arcs[i].identifier = x.nANF[i].identifier
+		}
+		if len(arcs[i].annotation) == 0 {
+			arcs[i].annotation = x.nANF[i].annotation
+		}
+	}
+	o.nANF = arcs
+
+	if len(o.name) == 0 {
+		o.name = x.name
+		o.removeAltName(x.name)
+	} else {
+		o.setAltNames(x.name)
+	}
+	o.setAltNames(x.aka...)
+
+	if len(o.desc) == 0 {
+		o.desc = x.desc
+	}
+	if o.status == StatusCurrent {
+		o.status = x.status
+	}
+
+	o.invalidate()
+}
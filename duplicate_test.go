@@ -0,0 +1,70 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDuplicatePolicy(t *testing.T) {
+	for _, tc := range []struct {
+		policy DuplicatePolicy
+		keys   string // the sorted keys following the second assignment
+		name   string // the principal name of the cn entry
+		alts   string
+		desc   string
+		str    string
+		err    error
+	}{
+		{DuplicateReplace, `cn commonName`, `cn`, ``, ``, `{ 2 5 4 cn(3) }`, nil},
+		{DuplicateMerge, `cn`, `cn`, `commonName,id-at-commonName`, `Common name`,
+			`{ joint-iso-itu-t(2) ds(5) attributeType(4) commonName(3) }`, nil},
+		{DuplicateReject, `cn`, `cn`, ``, ``, `{ 2 5 4 cn(3) }`, ErrConflict},
+	} {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			r := NewRegistry(WithDuplicatePolicy(tc.policy))
+			if p := r.DuplicatePolicy(); p != tc.policy {
+				t.Errorf("DuplicatePolicy: got %s, want %s", p, tc.policy)
+			}
+
+			cn := mustDot(t, `2.5.4.3`)
+			cn.SetName(`cn`)
+			if err := r.Set(`cn`, cn); err != nil {
+				t.Fatal(err)
+			}
+
+			dup, _ := NewFromNaNF(`{ joint-iso-itu-t(2) ds(5) attributeType(4) commonName(3) }`)
+			dup.SetName(`commonName`)
+			dup.SetAltNames(`id-at-commonName`)
+			dup.SetDescription(`Common name`)
+			if err := r.Set(`commonName`, dup); !errors.Is(err, tc.err) {
+				t.Fatalf("Set: got %v, want %v", err, tc.err)
+			}
+
+			if keys := strings.Join(r.Map().SortedKeys(), ` `); keys != tc.keys {
+				t.Errorf("keys: got %s, want %s", keys, tc.keys)
+			}
+
+			o, _ := r.Get(`cn`)
+			if n := o.Name(); n != tc.name {
+				t.Errorf("Name: got %q, want %q", n, tc.name)
+			}
+			if a := strings.Join(o.AltNames(), `,`); a != tc.alts {
+				t.Errorf("AltNames: got %q, want %q", a, tc.alts)
+			}
+			if d := o.Description(); d != tc.desc {
+				t.Errorf("Description: got %q, want %q", d, tc.desc)
+			}
+			if s := o.String(); s != tc.str {
+				t.Errorf("String: got %s, want %s", s, tc.str)
+			}
+			if cn.Description() != `` {
+				t.Errorf("the original instance was altered")
+			}
+		})
+	}
+
+	if s := DuplicatePolicy(9).String(); s != `DuplicatePolicy(9)` {
+		t.Errorf("String: got %q", s)
+	}
+}
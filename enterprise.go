@@ -0,0 +1,74 @@
+package oid
+
+/*
+enterprise.go deals with OIDs beneath the IANA Private Enterprise Number (PEN) arc, 1.3.6.1.4.1.
+*/
+
+/*
+enterpriseArcs contains the named arcs that precede any Private Enterprise Number.
+*/
+var enterpriseArcs []NameAndNumberForm = []NameAndNumberForm{
+	{identifier: `iso`, primaryIdentifier: 1},
+	{identifier: `identified-organization`, primaryIdentifier: 3},
+	{identifier: `dod`, primaryIdentifier: 6},
+	{identifier: `internet`, primaryIdentifier: 1},
+	{identifier: `private`, primaryIdentifier: 4},
+	{identifier: `enterprise`, primaryIdentifier: 1},
+}
+
+/*
+NewEnterpriseOID returns an instance of *ObjectIdentifier beneath the IANA Private Enterprise Number (PEN) arc. The provided pen is appended to the named enterprise arcs, followed by any sub arcs, e.g.:
+
+	NewEnterpriseOID(56521, 1, 5)
+
+... returns:
+
+	{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 1 5 }
+*/
+func NewEnterpriseOID(pen uint, sub ...uint) (o *ObjectIdentifier) {
+	o = newObjectIdentifier(len(enterpriseArcs) + len(sub) + 1)
+	o.nANF = append(o.nANF, enterpriseArcs...)
+	o.nANF = append(o.nANF, NameAndNumberForm{primaryIdentifier: pen})
+	for i := 0; i < len(sub); i++ {
+		o.nANF = append(o.nANF, NameAndNumberForm{primaryIdentifier: sub[i]})
+	}
+
+	return
+}
+
+/*
+IsUnderInternet returns a Boolean value indicative of whether the receiver is a descendant of the Internet arc, 1.3.6.1. The Internet arc itself is not considered to be beneath itself. Only number forms are considered.
+*/
+func (o *ObjectIdentifier) IsUnderInternet() bool {
+	return o.below(internetBase)
+}
+
+/*
+IsUnderEnterprise returns a Boolean value indicative of whether the receiver is a descendant of the IANA Private Enterprise Number arc, 1.3.6.1.4.1, i.e. whether it bears a Private Enterprise Number. See also PEN.
+*/
+func (o *ObjectIdentifier) IsUnderEnterprise() bool {
+	return o.below(enterpriseBase)
+}
+
+/*
+PEN returns the IANA Private Enterprise Number of the receiver alongside a Boolean value indicative of success, e.g. 56521 for 1.3.6.1.4.1.56521.1.5. False is returned if the receiver does not reside beneath the Private Enterprise Number arc, or if its enterprise number is too large to be represented by a uint.
+*/
+func (o *ObjectIdentifier) PEN() (pen uint, ok bool) {
+	if !o.below(enterpriseBase) {
+		return
+	}
+
+	if arc := o.arcs()[len(enterpriseArcs)]; arc.huge == nil {
+		pen, ok = arc.primaryIdentifier, true
+	}
+
+	return
+}
+
+/*
+below returns a Boolean value indicative of whether the receiver is a descendant of, but not equal to, base. Only number forms are considered.
+*/
+func (o *ObjectIdentifier) below(base *ObjectIdentifier) bool {
+	arcs, b := o.arcs(), base.arcs()
+	return len(arcs) > len(b) && arcsUnder(arcs, b)
+}
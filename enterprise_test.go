@@ -0,0 +1,63 @@
+package oid
+
+import "testing"
+
+func TestNewEnterpriseOID(t *testing.T) {
+	for _, tc := range []struct {
+		pen  uint
+		sub  []uint
+		dot  string
+		nanf string
+	}{
+		{56521, nil, `1.3.6.1.4.1.56521`, `{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 }`},
+		{56521, []uint{1, 5}, `1.3.6.1.4.1.56521.1.5`, `{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 1 5 }`},
+		{0, []uint{0}, `1.3.6.1.4.1.0.0`, `{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 0 0 }`},
+	} {
+		o := NewEnterpriseOID(tc.pen, tc.sub...)
+		if o.DotNotation() != tc.dot || o.StringAs(FormNaNF) != tc.nanf || !o.Valid() {
+			t.Errorf("NewEnterpriseOID(%d, %v): got %s", tc.pen, tc.sub, o.StringAs(FormNaNF))
+		}
+	}
+
+	a, b := NewEnterpriseOID(1), NewEnterpriseOID(1)
+	a.SetArcAnnotation(0, `changed`)
+	if b.Arc(0).Annotation() != `` {
+		t.Errorf("NewEnterpriseOID: instances share arcs")
+	}
+}
+
+func TestEnterpriseMembership(t *testing.T) {
+	for _, tc := range []struct {
+		dot                  string
+		internet, enterprise bool
+		pen                  uint
+		ok                   bool
+	}{
+		{`1.3.6.1.4.1.56521.1.5`, true, true, 56521, true},
+		{`1.3.6.1.4.1.56521`, true, true, 56521, true},
+		{`1.3.6.1.4.1`, true, false, 0, false},
+		{`1.3.6.1.2.1`, true, false, 0, false},
+		{`1.3.6.1`, false, false, 0, false},
+		{`1.3.6`, false, false, 0, false},
+		{`2.5.4.3`, false, false, 0, false},
+		{`1.3.6.1.4.1.18446744073709551616`, true, true, 0, false},
+	} {
+		o := mustDot(t, tc.dot)
+		if got := o.IsUnderInternet(); got != tc.internet {
+			t.Errorf("%s: IsUnderInternet got %t, want %t", tc.dot, got, tc.internet)
+		}
+		if got := o.IsUnderEnterprise(); got != tc.enterprise {
+			t.Errorf("%s: IsUnderEnterprise got %t, want %t", tc.dot, got, tc.enterprise)
+		}
+		if pen, ok := o.PEN(); pen != tc.pen || ok != tc.ok {
+			t.Errorf("%s: PEN got %d, %t, want %d, %t", tc.dot, pen, ok, tc.pen, tc.ok)
+		}
+	}
+
+	var nilOID *ObjectIdentifier
+	if nilOID.IsUnderInternet() || nilOID.IsUnderEnterprise() {
+		t.Errorf("nil receiver reported as a descendant")
+	} else if _, ok := nilOID.PEN(); ok {
+		t.Errorf("nil receiver bore a PEN")
+	}
+}
@@ -0,0 +1,68 @@
+package oid
+
+/*
+errors.go contains the sentinel errors of this package, allowing callers to branch on failure categories using errors.Is.
+*/
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidIdentifier indicates an identifier or descriptor that does not meet syntax requirements.
+	ErrInvalidIdentifier error = errors.New("invalid identifier")
+
+	// ErrInvalidNumberForm indicates a malformed, negative or otherwise unusable number form.
+	ErrInvalidNumberForm error = errors.New("invalid number form")
+
+	// ErrUnsupportedType indicates an input type that is not supported by the function or method in question.
+	ErrUnsupportedType error = errors.New("unsupported type")
+
+	// ErrInvalidRoot indicates an OID whose root arc is not 0, 1 or 2, or which has no arcs at all.
+	ErrInvalidRoot error = errors.New("invalid root arc")
+
+	// ErrEmptyInput indicates nil or zero-length input, such as an empty string or slice.
+	ErrEmptyInput error = errors.New("empty input")
+
+	// ErrNotFound indicates that a name or OID could not be resolved.
+	ErrNotFound error = errors.New("not found")
+
+	// ErrDisallowedParent indicates an OID that does not reside beneath any of the bases permitted by a ParentConstraint.
+	ErrDisallowedParent error = errors.New("disallowed parent")
+
+	// ErrLimitExceeded indicates input that exceeds a bound imposed by way of WithLimits, or an exhausted resource such as a Registry reservation.
+	ErrLimitExceeded error = errors.New("limit exceeded")
+
+	// ErrInvalidDuration indicates a zero or negative duration, such as the term of a Registry lease.
+	ErrInvalidDuration error = errors.New("invalid duration")
+
+	// ErrNilInstance indicates a nil receiver or argument, such as a nil *Registry, where an initialized instance is required.
+	ErrNilInstance error = errors.New("nil instance")
+
+	// ErrConflict indicates an OID or name that collides with one already present, such as a duplicate Registry entry.
+	ErrConflict error = errors.New("conflict")
+
+	// ErrInvalidValue indicates an argument that is well-formed but unacceptable, such as an unknown Status or an out-of-range index.
+	ErrInvalidValue error = errors.New("invalid value")
+
+	// ErrSyntax indicates textual input, such as an ASN.1 module, CSV record or distinguished name, that could not be parsed.
+	ErrSyntax error = errors.New("syntax error")
+
+	// ErrMalformedEncoding indicates binary input, such as DER, that is truncated or otherwise malformed.
+	ErrMalformedEncoding error = errors.New("malformed encoding")
+)
+
+/*
+errorw returns an error wrapping kind, with a message composed of msg and x as with errorf.
+*/
+func errorw(kind error, msg string, x ...any) error {
+	return fmt.Errorf("%w: %s", kind, sprintf(msg, x...))
+}
+
+/*
+errorc returns an error wrapping cause, prefixed with a message composed of msg and x as with errorf. Any sentinel wrapped by cause remains visible to errors.Is.
+*/
+func errorc(cause error, msg string, x ...any) error {
+	return fmt.Errorf("%s: %w", sprintf(msg, x...), cause)
+}
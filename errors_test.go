@@ -0,0 +1,81 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestErrorSentinels(t *testing.T) {
+	root, _ := NewFromDot(`2`)
+	enterprise, _ := NewFromDot(`1.3.6.1.4.1`)
+	r := NewRegistry()
+	_ = r.Set(`e`, enterprise)
+
+	for _, tc := range []struct {
+		name string
+		fn   func() error
+		want error
+	}{
+		{`ParseGSER`, func() (err error) { _, err = ParseGSER(``, nil); return }, ErrEmptyInput},
+		{`ToUUID`, func() (err error) { _, err = enterprise.ToUUID(); return }, ErrInvalidRoot},
+		{`MaxInputLen`, func() (err error) {
+			_, err = NewObjectIdentifier(`1.3.6.1`, WithLimits(Limits{MaxInputLen: 3}))
+			return
+		}, ErrLimitExceeded},
+		{`MaxArcs`, func() (err error) {
+			_, err = NewObjectIdentifier([]int{1, 3, 6, 1}, WithLimits(Limits{MaxArcs: 3}))
+			return
+		}, ErrLimitExceeded},
+		{`MaxArcs parsed`, func() (err error) {
+			_, err = NewObjectIdentifier(`{ 1 3 6 1 }`, WithLimits(Limits{MaxArcs: 3}))
+			return
+		}, ErrLimitExceeded},
+		{`AppendDERSequence`, func() (err error) {
+			_, err = AppendDERSequence(nil, []*ObjectIdentifier{root})
+			return
+		}, ErrInvalidRoot},
+		{`DeriveObjectIdentifier`, func() (err error) { _, err = DeriveObjectIdentifier(*enterprise, ``); return }, ErrEmptyInput},
+		{`Lease`, func() error { return r.Lease(`x`, enterprise, 0) }, ErrInvalidDuration},
+		{`Renew`, func() error { return r.Renew(`e`, -time.Second) }, ErrInvalidDuration},
+		{`nil Registry`, func() error { var nr *Registry; return nr.Set(`x`, enterprise) }, ErrNilInstance},
+		{`DuplicateReject`, func() error {
+			dr := NewRegistry(WithDuplicatePolicy(DuplicateReject))
+			_ = dr.Set(`a`, enterprise)
+			return dr.Set(`b`, enterprise)
+		}, ErrConflict},
+		{`MaxArcBits`, func() error {
+			lr := NewRegistry(WithLimits(Limits{MaxArcBits: 2}))
+			return lr.Set(`x`, enterprise)
+		}, ErrLimitExceeded},
+		{`ParseStatus`, func() (err error) { _, err = ParseStatus(`bogus`); return }, ErrInvalidValue},
+		{`Tokenize`, func() (err error) { _, err = Tokenize(`{ iso(1) $ }`); return }, ErrSyntax},
+		{`ParseTypeAndValue`, func() (err error) { _, _, err = ParseTypeAndValue(`cn`, nil); return }, ErrSyntax},
+		{`NewFromDER truncated`, func() (err error) { _, err = NewFromDER([]byte{0x06, 0x02, 0x2b}); return }, ErrMalformedEncoding},
+		{`NewFromDER trailing`, func() (err error) { _, err = NewFromDER([]byte{0x06, 0x01, 0x2b, 0x00}); return }, ErrMalformedEncoding},
+		{`ParseAny`, func() (err error) { _, err = ParseAny(` `); return }, ErrEmptyInput},
+	} {
+		if err := tc.fn(); !errors.Is(err, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+/*
+TestErrorText ensures that error messages describe the offending input rather than the unexported functions which rejected it.
+*/
+func TestErrorText(t *testing.T) {
+	for _, x := range []string{`1.3.6.1`, `(1)`, `{ iso(1) abc }`, `{ 1 3 -6 }`, ``} {
+		_, err := NewObjectIdentifier(x)
+		if err == nil {
+			t.Errorf("%q: expected error", x)
+			continue
+		}
+
+		for _, internal := range []string{`parseNaNF`, `parseDotNotation`, `primaryIdentifier`, `nANF`} {
+			if contains(err.Error(), internal) {
+				t.Errorf("%q: error %q mentions %s", x, err, internal)
+			}
+		}
+	}
+}
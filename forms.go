@@ -0,0 +1,274 @@
+package oid
+
+/*
+forms.go deals with the URN (RFC 3061) and OID-IRI (ITU-T Rec. X.660) textual forms of OIDs, as well as ParseAny, which accepts any textual form supported by this package.
+*/
+
+import "encoding/hex"
+
+/*
+URN returns the RFC 3061 URN form of the receiver, e.g.:
+
+	urn:oid:1.3.6.1
+
+A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) URN() string {
+	if o.IsZero() {
+		return ``
+	}
+	return `urn:oid:` + o.DotNotation()
+}
+
+/*
+IRI returns the OID-IRI form of the receiver using integer labels, e.g.:
+
+	/1/3/6/1
+
+A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) IRI() string {
+	if o.IsZero() {
+		return ``
+	}
+
+	arcs := o.arcs()
+	dst := make([]byte, 0, 32)
+	for i := 0; i < len(arcs); i++ {
+		dst = append(dst, '/')
+		dst = arcs[i].appendNumber(dst)
+	}
+
+	return string(dst)
+}
+
+/*
+ParseURN returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an RFC 3061 URN, e.g. urn:oid:1.3.6.1.
+*/
+func ParseURN(x string) (o *ObjectIdentifier, err error) {
+	const prefix = `urn:oid:`
+	if len(x) <= len(prefix) || !eq(x[:len(prefix)], prefix) {
+		err = errorw(ErrInvalidNumberForm, "Bad URN '%s' [hint: must begin with '%s']", x, prefix)
+		return
+	}
+
+	t := newObjectIdentifier(countByte(x, '.') + 1)
+	if t.nANF, err = parseDotNotation(t.nANF, x[len(prefix):], true); err != nil {
+		return
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+ParseIRI returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an OID-IRI bearing integer labels, e.g. /1/3/6/1.
+*/
+func ParseIRI(x string) (o *ObjectIdentifier, err error) {
+	if len(x) < 2 || x[0] != '/' {
+		err = errorw(ErrInvalidNumberForm, "Bad OID-IRI '%s' [hint: must begin with '/']", x)
+		return
+	}
+
+	t := newObjectIdentifier(countByte(x, '/'))
+	for start, i := 1, 1; i <= len(x); i++ {
+		if i < len(x) && x[i] != '/' {
+			continue
+		}
+
+		label := x[start:i]
+		var arc NameAndNumberForm
+		if len(label) == 0 || !isDigit(label) || (len(label) > 1 && label[0] == '0') {
+			err = errorw(ErrInvalidNumberForm, "Bad OID-IRI label '%s' in '%s' [hint: only integer labels are supported]", label, x)
+			return
+		} else if err = arc.setNumberForm(label); err != nil {
+			return
+		}
+
+		t.nANF = append(t.nANF, arc)
+		start = i + 1
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+ParseAny returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x in any textual form supported by this package:
+
+  - ASN.1 NameAndNumberForm sequence, e.g. { iso(1) identified-organization(3) dod(6) }
+  - dotNotation, e.g. 1.3.6
+  - SNMP leading-dot notation, e.g. .1.3.6
+  - RFC 3061 URN, e.g. urn:oid:1.3.6
+  - OID-IRI bearing integer labels, e.g. /1/3/6
+  - Hexadecimal DER encoding, including the tag and length octets, e.g. 06022b06
+  - A descriptor known to the WellKnown database, e.g. signingTime, in which case a copy of the well-known instance is returned
+  - Any of the above followed by an ASN.1 comment bearing its name, e.g. 1.3.6.1.5.5.7.3.1 -- id-kp-serverAuth (see ParseCommented)
+
+Whichever form is given, the number forms of the resulting ObjectIdentifier are identical, thus the Normalize method of the return value yields the same canonical instance for each form of a given OID. See also EqualForms.
+
+ParseAny never panics, regardless of input, making it suitable for use as a fuzzing target.
+*/
+func ParseAny(x string) (o *ObjectIdentifier, err error) {
+	start, end := 0, len(x)
+	for start < end && isSpace(x[start]) {
+		start++
+	}
+	for end > start && isSpace(x[end-1]) {
+		end--
+	}
+	x = x[start:end]
+
+	switch {
+	case len(x) == 0:
+		err = errorw(ErrEmptyInput, "No content for ParseAny to read")
+	case commentIndex(x) != -1:
+		o, err = ParseCommented(x)
+	case len(x) > 4 && eq(x[:4], `urn:`):
+		o, err = ParseURN(x)
+	case x[0] == '/':
+		o, err = ParseIRI(x)
+	case isDotNotation(x) && contains(x, `.`):
+		o, err = NewFromDot(x)
+	case x[0] == '.' && isDotNotation(x[1:]):
+		o, err = ParseSNMP(x)
+	case isHexDER(x):
+		o, err = ParseHexDER(x)
+	case isDescr(x) && indexRune(x, '(') == -1:
+		if wk, found := WellKnown().Get(x); found {
+			o = wk.clone()
+		} else {
+			err = errorw(ErrNotFound, "Descriptor '%s' not found", x)
+		}
+	default:
+		o, err = NewFromNaNF(x)
+	}
+
+	return
+}
+
+/*
+ParseCommented returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an OID followed by an ASN.1 comment bearing its name, as found throughout RFC appendices, e.g.:
+
+	1.3.6.1.5.5.7.3.1 -- id-kp-serverAuth
+
+The OID may be in any form accepted by ParseAny, and the comment may optionally be closed by a second "--". Should the comment qualify as a descriptor, it is assigned as the principal name of the return value; otherwise, e.g. when it bears free text, it is assigned as its description.
+*/
+func ParseCommented(x string) (o *ObjectIdentifier, err error) {
+	idx := commentIndex(x)
+	if idx == -1 {
+		err = errorw(ErrSyntax, "No comment for ParseCommented to read in '%s'", x)
+		return
+	}
+
+	comment := trimS(x[idx+2:])
+	if hasSuffix(comment, `--`) {
+		comment = trimS(comment[:len(comment)-2])
+	}
+
+	if len(comment) == 0 {
+		err = errorw(ErrEmptyInput, "Empty comment following OID in '%s'", x)
+		return
+	}
+
+	var t *ObjectIdentifier
+	if t, err = ParseAny(x[:idx]); err != nil {
+		return
+	}
+
+	if isDescr(comment) {
+		err = t.SetName(comment)
+	} else {
+		err = t.SetDescription(comment)
+	}
+
+	if err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+commentIndex returns the index of the "--" which commences an ASN.1 comment following the OID value within x, or -1 if none. Within a NameAndNumberForm sequence, only a comment following the closing brace is considered.
+*/
+func commentIndex(x string) (idx int) {
+	var from int
+	if len(x) > 0 && x[0] == '{' {
+		if from = indexRune(x, '}'); from == -1 {
+			return -1
+		}
+	}
+
+	if idx = index(x[from:], `--`); idx != -1 {
+		idx += from
+	}
+
+	return
+}
+
+/*
+ParseHexDER returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as the hexadecimal form of the complete DER encoding of an OBJECT IDENTIFIER, e.g. 06032b0601. Hexadecimal digits may be of either case.
+*/
+func ParseHexDER(x string) (o *ObjectIdentifier, err error) {
+	var b []byte
+	if b, err = hex.DecodeString(x); err != nil {
+		err = errorw(ErrInvalidNumberForm, "Bad hexadecimal DER '%s': %v", x, err)
+		return
+	}
+
+	return NewFromDER(b)
+}
+
+/*
+isHexDER returns a Boolean value indicative of whether x resembles the hexadecimal form of a DER encoded OBJECT IDENTIFIER: an even number of hexadecimal digits, commencing with the OBJECT IDENTIFIER tag (06) and bearing at least one content octet.
+*/
+func isHexDER(x string) bool {
+	if len(x) < 6 || len(x)%2 != 0 || x[0] != '0' || x[1] != '6' {
+		return false
+	}
+
+	for i := 0; i < len(x); i++ {
+		c := x[i]
+		if !('0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F') {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+FuzzSeeds returns a seed corpus suitable for use with the native fuzzing facilities of the testing package, e.g.:
+
+	for _, seed := range oid.FuzzSeeds() {
+		f.Add(seed)
+	}
+
+The corpus contains each WellKnown OID in each supported textual form, followed by a selection of malformed input.
+*/
+func FuzzSeeds() (seeds []string) {
+	for _, v := range WellKnown() {
+		seeds = append(seeds, v.StringAs(FormNaNF), v.DotNotation(), v.URN(), v.IRI(), hex.EncodeToString(v.AppendDER(nil, true)))
+		if name := v.Name(); len(name) > 0 {
+			seeds = append(seeds, name)
+		}
+	}
+
+	seeds = append(seeds,
+		``, ` `, `{`, `}`, `{ }`, `()`, `(1)`, `a(`, `a()`, `a(1`, `-(1)`, `a-(1)`,
+		`1.`, `.1`, `1..3`, `01.3`, `3.1`, `urn:oid:`, `urn:oid:1..3`, `/`, `//`, `/1//3`,
+		`060100`, `06022b`, `06032b0601ff`, `0601ab`,
+		`1.3.6.1 -- internet`, `1.3 --`, `--`, `{ 1 3 } -- x --`,
+		`{ iso(1) 3 6 1 4 1 340282366920938463463374607431768211456 }`,
+		`2.25.340282366920938463463374607431768211456`,
+	)
+
+	return
+}
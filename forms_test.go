@@ -0,0 +1,94 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"testing"
+)
+
+/*
+TestDescriptorParsersReturnCopies verifies that parsers which resolve descriptors using the WellKnown database return instances which may be modified without affecting it.
+*/
+func TestDescriptorParsersReturnCopies(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		dot   string
+		parse func() (*ObjectIdentifier, error)
+	}{
+		{`ParseAny`, `1.2.840.113549.1.9.5`, func() (*ObjectIdentifier, error) { return ParseAny(`signingTime`) }},
+		{`ParseGSER`, `1.2.840.113549.1.9.5`, func() (*ObjectIdentifier, error) { return ParseGSER(`signingTime`, nil) }},
+		{`ParseOIDOrDescr`, `2.5.4.3`, func() (*ObjectIdentifier, error) { return ParseOIDOrDescr(`cn`, nil) }},
+		{`GSSMechanism`, `1.2.840.113554.1.2.2`, func() (o *ObjectIdentifier, err error) {
+			if o, _ = GSSMechanism(asn1.ObjectIdentifier{1, 2, 840, 113554, 1, 2, 2}); o == nil {
+				err = ErrNotFound
+			}
+			return
+		}},
+		{`ParseAll`, `2.5.4.3`, func() (o *ObjectIdentifier, err error) {
+			var oids []ObjectIdentifier
+			if oids, err = ParseAll([]string{`cn`}); err == nil {
+				o = &oids[0]
+			}
+			return
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			wk := WellKnown()[tc.dot]
+			name := wk.Name()
+
+			o, err := tc.parse()
+			if err != nil {
+				t.Fatal(err)
+			} else if o.DotNotation() != tc.dot {
+				t.Fatalf("got %s, want %s", o.DotNotation(), tc.dot)
+			} else if err = o.SetName(`modified`); err != nil {
+				t.Fatal(err)
+			}
+
+			if wk.Name() != name {
+				t.Errorf("well-known %s renamed to %q", tc.dot, wk.Name())
+			}
+		})
+	}
+}
+
+func TestParseCommented(t *testing.T) {
+	for _, tc := range []struct {
+		in, dot, name, desc string
+		fail                bool
+	}{
+		{`1.3.6.1.5.5.7.3.1 -- id-kp-serverAuth`, `1.3.6.1.5.5.7.3.1`, `id-kp-serverAuth`, ``, false},
+		{`1.3.6.1.5.5.7.3.1 -- id-kp-serverAuth --`, `1.3.6.1.5.5.7.3.1`, `id-kp-serverAuth`, ``, false},
+		{`urn:oid:1.3.6.1--internet`, `1.3.6.1`, `internet`, ``, false},
+		{`{ iso(1) 3 } -- ISO identified organization`, `1.3`, ``, `ISO identified organization`, false},
+		{`1.3 --`, ``, ``, ``, true},
+		{`--`, ``, ``, ``, true},
+		{`1.3.6`, ``, ``, ``, true},
+		{`1..3 -- bad`, ``, ``, ``, true},
+	} {
+		o, err := ParseCommented(tc.in)
+		if (err != nil) != tc.fail {
+			t.Errorf("%q: got error %v, want failure %t", tc.in, err, tc.fail)
+			continue
+		} else if err != nil {
+			continue
+		}
+
+		if d := o.DotNotation(); d != tc.dot {
+			t.Errorf("%q: got %s, want %s", tc.in, d, tc.dot)
+		}
+		if n := o.Name(); n != tc.name {
+			t.Errorf("%q: got name %q, want %q", tc.in, n, tc.name)
+		}
+		if d := o.Description(); d != tc.desc {
+			t.Errorf("%q: got description %q, want %q", tc.in, d, tc.desc)
+		}
+	}
+
+	// the value must not be shared with WellKnown
+	o, err := ParseCommented(`commonName -- example-name`)
+	if err != nil {
+		t.Fatal(err)
+	} else if w, _ := WellKnown().Get(`commonName`); w.Name() == `example-name` || o.Name() != `example-name` {
+		t.Errorf("got name %q, WellKnown name %q", o.Name(), w.Name())
+	}
+}
@@ -0,0 +1,44 @@
+//go:build ignore
+
+/*
+gencorpus.go compresses each human-readable table named upon the command line, such as corpus.tsv and wellknown.tsv, into a file of the same name bearing a .gz suffix, the form embedded within package oid. It is invoked by way of go generate.
+*/
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"log"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal("usage: go run gencorpus.go <file.tsv> ...")
+	}
+
+	for _, name := range os.Args[1:] {
+		compress(name)
+	}
+}
+
+func compress(name string) {
+	tsv, err := os.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	var zw *gzip.Writer
+	if zw, err = gzip.NewWriterLevel(&buf, gzip.BestCompression); err != nil {
+		log.Fatal(err)
+	} else if _, err = zw.Write(tsv); err != nil {
+		log.Fatal(err)
+	} else if err = zw.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err = os.WriteFile(name+`.gz`, buf.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
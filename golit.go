@@ -0,0 +1,105 @@
+package oid
+
+/*
+golit.go deals with the export of ObjectIdentifierMap contents as a Go composite literal, allowing small fixtures to be pinned from live registries.
+*/
+
+import (
+	"go/format"
+	"io"
+	"strconv"
+)
+
+/*
+ExportGo writes to w a Go variable declaration named name, whose value is an ObjectIdentifierMap literal bearing the key, arcs, principal name and alt names of each ObjectIdentifier within the receiver that is equal to, or a descendant of, base. If base is nil, all ObjectIdentifier instances are exported. Entries are ordered by OID, in the manner of SortedKeys, and the output is formatted in the manner of gofmt, e.g.:
+
+	var fixture = oid.ObjectIdentifierMap{
+		"example":   oid.Literal(`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) example(56521) }`, "example", "exampleCorp"),
+		"exampleAt": oid.Literal(`{ 1 3 6 1 4 1 56521 1 }`, ""),
+	}
+
+The result is intended for inclusion in a test or other package importing this one as "oid". This complements the constants generated for package wk, which describe OIDs but not the maps that hold them. The contents of a Registry may be exported by way of its Map method.
+*/
+func (o ObjectIdentifierMap) ExportGo(w io.Writer, name string, base *ObjectIdentifier) (err error) {
+	if !isGoIdentifier(name) {
+		err = errorw(ErrInvalidIdentifier, "Bad Go variable name '%s'", name)
+		return
+	}
+
+	src := sprintf("var %s = oid.ObjectIdentifierMap{\n", name)
+	o.Range(func(k string, v *ObjectIdentifier) bool {
+		if len(v.arcs()) == 0 || (base != nil && !v.under(base)) {
+			return true
+		}
+
+		args := []string{goRawString(v.nanfString()), strconv.Quote(v.Name())}
+		aka := v.AltNames()
+		for i := 0; i < len(aka); i++ {
+			args = append(args, strconv.Quote(aka[i]))
+		}
+
+		src += sprintf("%s: oid.Literal(%s),\n", strconv.Quote(k), join(args, `, `))
+		return true
+	})
+	src += "}\n"
+
+	var b []byte
+	if b, err = format.Source([]byte(src)); err != nil {
+		return
+	}
+	_, err = w.Write(b)
+
+	return
+}
+
+/*
+Literal returns an instance of *ObjectIdentifier parsed from x, which may bear any string form accepted by NewObjectIdentifier, bearing the principal name and alt names provided. It panics, in the manner of regexp.MustCompile, should x or any of the names be invalid, and is intended for package-level variables and test fixtures such as those written by ExportGo, e.g.:
+
+	serverAuth := oid.Literal(`{ 1 3 6 1 5 5 7 3 1 }`, "id-kp-serverAuth", "serverAuth")
+*/
+func Literal(x, name string, altNames ...string) *ObjectIdentifier {
+	o, err := NewFromNaNF(x)
+	if err == nil {
+		if err = o.SetName(name); err == nil {
+			err = o.SetAltNames(altNames...)
+		}
+	}
+
+	if err != nil {
+		panic(sprintf("oid.Literal(%q): %v", x, err))
+	}
+
+	return o
+}
+
+/*
+goRawString returns val as a Go raw string literal, falling back to an interpreted string literal should val contain a backquote.
+*/
+func goRawString(val string) string {
+	if contains(val, "`") {
+		return strconv.Quote(val)
+	}
+
+	return "`" + val + "`"
+}
+
+/*
+is 'val' a valid Go identifier?
+*/
+func isGoIdentifier(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		ch := val[i]
+		switch {
+		case ch == '_', 'a' <= ch && ch <= 'z', 'A' <= ch && ch <= 'Z':
+		case '0' <= ch && ch <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,74 @@
+package oid
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestExportGo(t *testing.T) {
+	m := ObjectIdentifierMap{
+		`example`:   Literal(`{ iso(1) 3 6 1 4 1 example(56521) }`, `example`, `exampleCorp`),
+		`exampleAt`: Literal(`{ 1 3 6 1 4 1 56521 1 }`, ``),
+		`cn`:        Literal(`{ 2 5 4 3 }`, `cn`),
+	}
+
+	for _, tc := range []struct {
+		name string
+		base *ObjectIdentifier
+		want string
+		err  error
+	}{
+		{`fixture`, NewEnterpriseOID(56521), "var fixture = oid.ObjectIdentifierMap{\n" +
+			"\t\"example\":   oid.Literal(`{ iso(1) 3 6 1 4 1 example(56521) }`, \"example\", \"exampleCorp\"),\n" +
+			"\t\"exampleAt\": oid.Literal(`{ 1 3 6 1 4 1 56521 1 }`, \"\"),\n" +
+			"}\n", nil},
+		{`all`, nil, "var all = oid.ObjectIdentifierMap{\n" +
+			"\t\"example\":   oid.Literal(`{ iso(1) 3 6 1 4 1 example(56521) }`, \"example\", \"exampleCorp\"),\n" +
+			"\t\"exampleAt\": oid.Literal(`{ 1 3 6 1 4 1 56521 1 }`, \"\"),\n" +
+			"\t\"cn\":        oid.Literal(`{ 2 5 4 cn(3) }`, \"cn\"),\n" +
+			"}\n", nil},
+		{`none`, LDAP(), "var none = oid.ObjectIdentifierMap{}\n", nil},
+		{`1bad`, nil, ``, ErrInvalidIdentifier},
+		{`bad-name`, nil, ``, ErrInvalidIdentifier},
+		{``, nil, ``, ErrInvalidIdentifier},
+	} {
+		var buf bytes.Buffer
+		if err := m.ExportGo(&buf, tc.name, tc.base); !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.name, err, tc.err)
+		} else if got := buf.String(); got != tc.want {
+			t.Errorf("%q: got\n%s\nwant\n%s", tc.name, got, tc.want)
+		}
+	}
+
+	if err := m.ExportGo(failingWriter{}, `fixture`, nil); err == nil {
+		t.Errorf("no error from a failing writer")
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	for _, tc := range []struct {
+		x, name string
+		aka     []string
+		panics  bool
+	}{
+		{`{ 1 3 6 1 5 5 7 3 1 }`, `id-kp-serverAuth`, []string{`serverAuth`}, false},
+		{`{ iso(1) 3 }`, ``, nil, false},
+		{`{ 1 3 .. }`, `x`, nil, true},
+		{``, `x`, nil, true},
+		{`{ 1 3 }`, `x`, []string{`bad alt`}, false},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); (r != nil) != tc.panics {
+					t.Errorf("%s: got panic %v, want panic %t", tc.x, r, tc.panics)
+				}
+			}()
+
+			o := Literal(tc.x, tc.name, tc.aka...)
+			if o.Name() != tc.name || o.AltNamesLen() != len(tc.aka) {
+				t.Errorf("%s: got %q %v", tc.x, o.Name(), o.AltNames())
+			}
+		}()
+	}
+}
@@ -0,0 +1,124 @@
+package oid
+
+/*
+graphviz.go deals with the export of ObjectIdentifierMap contents as Graphviz DOT graphs.
+*/
+
+import "io"
+
+/*
+DOTOptions contains the settings which govern the output of ExportDOT.
+*/
+type DOTOptions struct {
+	// Base limits the graph to the subtree rooted at Base. If nil,
+	// all ObjectIdentifier instances are graphed.
+	Base *ObjectIdentifier
+
+	// GraphName is the ID of the resulting digraph. If zero, "oid"
+	// is used.
+	GraphName string
+
+	// LeftToRight lays the graph out horizontally (rankdir=LR)
+	// rather than from top to bottom.
+	LeftToRight bool
+}
+
+/*
+ExportDOT writes a Graphviz digraph of the ObjectIdentifier instances within the receiver to w, e.g.:
+
+	digraph "oid" {
+		node [shape=box];
+		"1.3.6.1" [label="internet(1)\n1.3.6.1"];
+		"1.3.6.1.4" [label="private(4)\n1.3.6.1.4", style=dashed];
+		"1.3.6.1" -> "1.3.6.1.4";
+	}
+
+Each node is labeled with the nameAndNumberForm of its arc and its dotNotation, as well as the principal name of the registered OID should it differ from the identifier of the arc. Intermediate arcs bearing no registered OID are drawn with dashed outlines.
+*/
+func (o ObjectIdentifierMap) ExportDOT(w io.Writer, opts DOTOptions) (err error) {
+	t := NewOIDTree()
+	for _, v := range o {
+		if v.IsZero() || (opts.Base != nil && !v.under(opts.Base)) {
+			continue
+		}
+		t.Insert(v)
+	}
+
+	name := opts.GraphName
+	if len(name) == 0 {
+		name = `oid`
+	}
+
+	head := sprintf("digraph %s {\n", dotString(name))
+	if opts.LeftToRight {
+		head += "\trankdir=LR;\n"
+	}
+	if _, err = io.WriteString(w, head+"\tnode [shape=box];\n"); err != nil {
+		return
+	}
+
+	roots := t.Roots()
+	if opts.Base != nil {
+		roots = []*OIDTreeNode{t.Find(opts.Base)}
+		if roots[0] == nil {
+			roots = nil
+		}
+	}
+
+	for i := 0; i < len(roots) && err == nil; i++ {
+		roots[i].Walk(PreOrder, func(n *OIDTreeNode) bool {
+			err = writeDOTNode(w, n, n != roots[i])
+			return err == nil
+		})
+	}
+
+	if err == nil {
+		_, err = io.WriteString(w, "}\n")
+	}
+
+	return
+}
+
+/*
+writeDOTNode writes the node statement for n and, if edge is true, the edge leading from its parent.
+*/
+func writeDOTNode(w io.Writer, n *OIDTreeNode, edge bool) (err error) {
+	dot := n.dotNotation()
+
+	label := n.nanf.String() + "\n" + dot
+	if name := n.oid.Name(); len(name) > 0 && !eq(name, n.nanf.identifier) {
+		label += "\n" + name
+	}
+
+	stmt := sprintf("\t%s [label=%s", dotString(dot), dotString(label))
+	if n.oid == nil {
+		stmt += `, style=dashed`
+	}
+	stmt += "];\n"
+
+	if edge {
+		stmt += sprintf("\t%s -> %s;\n", dotString(n.parent.dotNotation()), dotString(dot))
+	}
+
+	_, err = io.WriteString(w, stmt)
+	return
+}
+
+/*
+dotString returns val as a quoted Graphviz ID, escaping quotes and backslashes, and representing newlines as \n sequences.
+*/
+func dotString(val string) string {
+	q := `"`
+	for i := 0; i < len(val); i++ {
+		switch ch := val[i]; ch {
+		case '"', '\\':
+			q += `\` + string(ch)
+		case '\n':
+			q += `\n`
+		default:
+			q += string(ch)
+		}
+	}
+
+	return q + `"`
+}
@@ -0,0 +1,47 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportDOT(t *testing.T) {
+	internet, _ := NewFromNaNF(`{ iso(1) identified-organization(3) dod(6) internet(1) }`)
+	pen := mustDot(t, `1.3.6.1.4.1`)
+	pen.SetName(`enterprise`)
+	m := ObjectIdentifierMap{`internet`: internet, `enterprise`: pen, `2.5`: mustDot(t, `2.5`)}
+
+	for _, tc := range []struct {
+		name string
+		opts DOTOptions
+		want string
+	}{
+		{`subtree`, DOTOptions{Base: internet}, "digraph \"oid\" {\n" +
+			"\tnode [shape=box];\n" +
+			"\t\"1.3.6.1\" [label=\"internet(1)\\n1.3.6.1\"];\n" +
+			"\t\"1.3.6.1.4\" [label=\"4\\n1.3.6.1.4\", style=dashed];\n" +
+			"\t\"1.3.6.1\" -> \"1.3.6.1.4\";\n" +
+			"\t\"1.3.6.1.4.1\" [label=\"1\\n1.3.6.1.4.1\\nenterprise\"];\n" +
+			"\t\"1.3.6.1.4\" -> \"1.3.6.1.4.1\";\n" +
+			"}\n"},
+		{`options`, DOTOptions{Base: mustDot(t, `2`), GraphName: `my "graph"`, LeftToRight: true}, "digraph \"my \\\"graph\\\"\" {\n" +
+			"\trankdir=LR;\n" +
+			"\tnode [shape=box];\n" +
+			"\t\"2\" [label=\"2\\n2\", style=dashed];\n" +
+			"\t\"2.5\" [label=\"5\\n2.5\"];\n" +
+			"\t\"2\" -> \"2.5\";\n" +
+			"}\n"},
+		{`absent`, DOTOptions{Base: mustDot(t, `0`)}, "digraph \"oid\" {\n\tnode [shape=box];\n}\n"},
+	} {
+		var b strings.Builder
+		if err := m.ExportDOT(&b, tc.opts); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		} else if b.String() != tc.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tc.name, b.String(), tc.want)
+		}
+	}
+
+	if err := m.ExportDOT(failingWriter{}, DOTOptions{}); err == nil {
+		t.Errorf("ExportDOT: expected write error")
+	}
+}
@@ -0,0 +1,74 @@
+package oid
+
+/*
+gser.go deals with the Generic String Encoding Rules (GSER) representation of OIDs, as defined in RFC 3641.
+*/
+
+/*
+GSER returns the Generic String Encoding Rules (RFC 3641) ObjectIdentifierValue representation of the receiver.
+
+If descr is true, the principal name of the receiver, or else the first of its alt names, that qualifies as a descriptor is returned. Otherwise, or if no such alt name exists, the numeric-oid (dotNotation) form is returned, e.g.:
+
+	1.3.6.1.5.5.7.3.1
+
+A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) GSER(descr bool) string {
+	if o.IsZero() {
+		return ``
+	} else if descr {
+		if name := o.Name(); isDescr(name) {
+			return name
+		}
+
+		aka := o.AltNames()
+		for i := 0; i < len(aka); i++ {
+			if isDescr(aka[i]) {
+				return aka[i]
+			}
+		}
+	}
+
+	return o.DotNotation()
+}
+
+/*
+ParseGSER returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as a Generic String Encoding Rules (RFC 3641) ObjectIdentifierValue.
+
+A numeric-oid value is parsed directly. A descr value is resolved using the provided ObjectIdentifierMap, or the WellKnown database if m is nil, and a copy of the instance found is returned.
+*/
+func ParseGSER(x string, m ObjectIdentifierMap) (o *ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No content for ParseGSER to read")
+		return
+	}
+
+	if !('0' <= x[0] && x[0] <= '9') {
+		if !isDescr(x) {
+			err = errorw(ErrInvalidIdentifier, "Bad GSER descr '%s'", x)
+			return
+		}
+
+		if m == nil {
+			m = WellKnown()
+		}
+
+		if d, found := m.Get(x); found {
+			o = d.clone()
+		} else {
+			err = errorw(ErrNotFound, "GSER descr '%s' not found", x)
+		}
+		return
+	}
+
+	t := newObjectIdentifier(countByte(x, '.') + 1)
+	if t.nANF, err = parseDotNotation(t.nANF, x, true); err != nil {
+		return
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
@@ -0,0 +1,53 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGSER(t *testing.T) {
+	named := mustDot(t, `1.3.6.1.4.1.56521`)
+	named.SetName(`example`)
+	aliased := mustDot(t, `1.3.6.1.4.1.56521`)
+	aliased.SetName(`not a descr`)
+	aliased.SetAltNames(`example-alt`)
+
+	for _, tc := range []struct {
+		o     *ObjectIdentifier
+		descr bool
+		want  string
+	}{
+		{named, false, `1.3.6.1.4.1.56521`},
+		{named, true, `example`},
+		{aliased, true, `example-alt`},
+		{mustDot(t, `1.3.6.1`), true, `1.3.6.1`},
+		{nil, true, ``},
+	} {
+		if got := tc.o.GSER(tc.descr); got != tc.want {
+			t.Errorf("GSER(%t) of %v: got %q, want %q", tc.descr, tc.o, got, tc.want)
+		}
+	}
+
+	m := ObjectIdentifierMap{`example`: named}
+	for _, tc := range []struct {
+		x   string
+		m   ObjectIdentifierMap
+		dot string
+		err error
+	}{
+		{`1.3.6.1.4.1.56521`, nil, `1.3.6.1.4.1.56521`, nil},
+		{`commonName`, nil, `2.5.4.3`, nil},
+		{`example`, m, `1.3.6.1.4.1.56521`, nil},
+		{`example`, nil, ``, ErrNotFound},
+		{`1..3`, nil, ``, ErrInvalidNumberForm},
+		{`-bad`, nil, ``, ErrInvalidIdentifier},
+		{``, nil, ``, ErrEmptyInput},
+	} {
+		o, err := ParseGSER(tc.x, tc.m)
+		if !errors.Is(err, tc.err) || err == nil && o.DotNotation() != tc.dot {
+			t.Errorf("ParseGSER(%q): got %v (%v), want %s (%v)", tc.x, o, err, tc.dot, tc.err)
+		} else if err == nil && o == m[`example`] {
+			t.Errorf("ParseGSER(%q): returned the instance within m", tc.x)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package oid
+
+/*
+gssapi.go contains helpers for recognizing GSS-API mechanism OIDs, which reside within the "gssapi" table of wellknown.tsv, within the mechTypes of a parsed SPNEGO token.
+*/
+
+import "encoding/asn1"
+
+/*
+GSSMechanism returns a copy of the well-known GSS-API mechanism ObjectIdentifier matching x alongside a Boolean value indicative of a successful match. The copy may be modified freely by the caller. This is useful when examining the mechTypes list of a parsed SPNEGO NegTokenInit.
+*/
+func GSSMechanism(x asn1.ObjectIdentifier) (o *ObjectIdentifier, ok bool) {
+	dot := x.String()
+	mechs := wellKnownTable(`gssapi`)
+	for i := 0; i < len(mechs); i++ {
+		if mechs[i].dot == dot {
+			if wk, found := WellKnown()[dot]; found {
+				o, ok = wk.clone(), true
+			}
+			break
+		}
+	}
+
+	return
+}
+
+/*
+IsKerberosMechanism returns a Boolean value indicative of whether x is one of the Kerberos V5 GSS-API mechanisms, including the legacy OID used by Microsoft.
+*/
+func IsKerberosMechanism(x asn1.ObjectIdentifier) bool {
+	return gssMechIs(x, `1.2.840.113554.1.2.2`, `1.2.840.48018.1.2.2`)
+}
+
+/*
+IsSPNEGOMechanism returns a Boolean value indicative of whether x is the SPNEGO pseudo-mechanism.
+*/
+func IsSPNEGOMechanism(x asn1.ObjectIdentifier) bool {
+	return gssMechIs(x, `1.3.6.1.5.5.2`)
+}
+
+/*
+IsNTLMSSPMechanism returns a Boolean value indicative of whether x is the NTLM Security Support Provider mechanism.
+*/
+func IsNTLMSSPMechanism(x asn1.ObjectIdentifier) bool {
+	return gssMechIs(x, `1.3.6.1.4.1.311.2.2.10`)
+}
+
+/*
+IsIAKERBMechanism returns a Boolean value indicative of whether x is the Initial and Pass Through Authentication Using Kerberos (IAKERB) mechanism.
+*/
+func IsIAKERBMechanism(x asn1.ObjectIdentifier) bool {
+	return gssMechIs(x, `1.3.6.1.5.2.5`)
+}
+
+/*
+PreferredGSSMechanism returns the first member of the mechTypes list, as found within a parsed SPNEGO NegTokenInit, that is a known GSS-API mechanism. The index of the match is returned, or -1 if no known mechanism was found.
+*/
+func PreferredGSSMechanism(mechTypes []asn1.ObjectIdentifier) (o *ObjectIdentifier, idx int) {
+	idx = -1
+	for i := 0; i < len(mechTypes); i++ {
+		var ok bool
+		if o, ok = GSSMechanism(mechTypes[i]); ok {
+			idx = i
+			break
+		}
+	}
+
+	return
+}
+
+func gssMechIs(x asn1.ObjectIdentifier, dot ...string) bool {
+	return strInSlice(x.String(), dot)
+}
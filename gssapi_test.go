@@ -0,0 +1,60 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"testing"
+)
+
+func TestGSSMechanism(t *testing.T) {
+	for _, tc := range []struct {
+		mech                           asn1.ObjectIdentifier
+		known                          bool
+		kerberos, spnego, ntlm, iakerb bool
+	}{
+		{asn1.ObjectIdentifier{1, 2, 840, 113554, 1, 2, 2}, true, true, false, false, false},
+		{asn1.ObjectIdentifier{1, 2, 840, 48018, 1, 2, 2}, true, true, false, false, false},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 2}, true, false, true, false, false},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 2, 10}, true, false, false, true, false},
+		{asn1.ObjectIdentifier{1, 3, 6, 1, 5, 2, 5}, true, false, false, false, true},
+		{asn1.ObjectIdentifier{2, 5, 4, 3}, false, false, false, false, false},
+		{nil, false, false, false, false, false},
+	} {
+		o, ok := GSSMechanism(tc.mech)
+		if ok != tc.known || ok && !o.Equal(tc.mech) {
+			t.Errorf("GSSMechanism(%s): got %v (%t), want %t", tc.mech, o, ok, tc.known)
+		}
+		for _, is := range []struct {
+			name string
+			got  bool
+			want bool
+		}{
+			{`IsKerberosMechanism`, IsKerberosMechanism(tc.mech), tc.kerberos},
+			{`IsSPNEGOMechanism`, IsSPNEGOMechanism(tc.mech), tc.spnego},
+			{`IsNTLMSSPMechanism`, IsNTLMSSPMechanism(tc.mech), tc.ntlm},
+			{`IsIAKERBMechanism`, IsIAKERBMechanism(tc.mech), tc.iakerb},
+		} {
+			if is.got != is.want {
+				t.Errorf("%s(%s): got %t, want %t", is.name, tc.mech, is.got, is.want)
+			}
+		}
+	}
+
+	krb, _ := GSSMechanism(asn1.ObjectIdentifier{1, 2, 840, 113554, 1, 2, 2})
+	krb.SetName(`changed`)
+	if WellKnown()[`1.2.840.113554.1.2.2`].Name() == `changed` {
+		t.Errorf("GSSMechanism: returned the shared well-known instance")
+	}
+
+	for _, tc := range []struct {
+		mechs []asn1.ObjectIdentifier
+		idx   int
+	}{
+		{[]asn1.ObjectIdentifier{{2, 5, 4, 3}, {1, 3, 6, 1, 5, 5, 2}, {1, 2, 840, 113554, 1, 2, 2}}, 1},
+		{[]asn1.ObjectIdentifier{{2, 5, 4, 3}}, -1},
+		{nil, -1},
+	} {
+		if o, idx := PreferredGSSMechanism(tc.mechs); idx != tc.idx || (idx == -1) != (o == nil) {
+			t.Errorf("PreferredGSSMechanism(%v): got %v at %d, want %d", tc.mechs, o, idx, tc.idx)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package oid
+
+/*
+history.go deals with the optional per-entry version history of a Registry.
+*/
+
+import "time"
+
+/*
+Version is a snapshot of a Registry entry, as recorded upon a mutation of that entry.
+*/
+type Version struct {
+	// Number is the sequence number of the version, starting at one (1).
+	Number int
+
+	// Time is the moment at which the version was recorded.
+	Time time.Time
+
+	// Action is the mutation which produced the version.
+	Action AuditAction
+
+	// OID is a copy of the entry as it stood following the mutation,
+	// including its names and metadata. OID is nil for a version
+	// produced by Delete.
+	OID *ObjectIdentifier
+}
+
+/*
+EnableHistory enables or disables the recording of per-entry version history within the receiver. Disabling history discards any history already recorded.
+*/
+func (r *Registry) EnableHistory(enabled bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !enabled {
+		r.hist = nil
+	} else if r.hist == nil {
+		r.hist = make(map[string][]Version)
+	}
+}
+
+/*
+History returns the versions recorded for the entry bearing key, oldest first. The final version reflects the entry as it stood following its most recent mutation through the receiver. Nil is returned if history is disabled or no versions exist.
+
+Note that changes made directly to a registered *ObjectIdentifier, e.g. using its SetName method, are only captured upon its next assignment through Set.
+*/
+func (r *Registry) History(key string) (versions []Version) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	h := r.hist[key]
+	if len(h) == 0 {
+		return
+	}
+
+	versions = make([]Version, len(h))
+	for i := 0; i < len(h); i++ {
+		versions[i] = h[i]
+		if h[i].OID != nil {
+			versions[i].OID = h[i].OID.clone()
+		}
+	}
+
+	return
+}
+
+/*
+Rollback restores the entry bearing key to the state captured by the specified version number, which may precede a deletion of the entry. The restoration is itself recorded as a new version, and is subject to the DuplicatePolicy of the receiver as is Set.
+*/
+func (r *Registry) Rollback(key string, number int) (err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h := r.hist[key]
+	if number < 1 || number > len(h) {
+		err = errorw(ErrNotFound, "No version %d for '%s'", number, key)
+		return
+	} else if h[number-1].OID == nil {
+		err = errorw(ErrInvalidValue, "Version %d of '%s' is a deletion", number, key)
+		return
+	}
+
+	x := h[number-1].OID.clone()
+	if key, x, err = r.place(r.oids, key, x); err == nil {
+		r.oids[key] = x
+		r.record(AuditRollback, key, x)
+	}
+
+	return
+}
+
+/*
+record notes a mutation of the entry bearing key within the audit trail and history of the receiver, if enabled. Any lease upon the entry is ended by all but an allocation. The caller must hold the lock.
+*/
+func (r *Registry) record(action AuditAction, key string, x *ObjectIdentifier) {
+	r.audit.append(action, key, x)
+	if action != AuditAllocate && action != AuditExpire {
+		delete(r.lease, key)
+	}
+
+	if r.hist == nil {
+		return
+	}
+
+	v := Version{
+		Number: len(r.hist[key]) + 1,
+		Time:   time.Now(),
+		Action: action,
+	}
+	if action != AuditDelete {
+		v.OID = x.clone()
+	}
+
+	r.hist[key] = append(r.hist[key], v)
+}
@@ -0,0 +1,64 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHistoryRollback(t *testing.T) {
+	r := NewRegistry()
+	r.EnableHistory(true)
+
+	named := mustDot(t, `1.3.6.1.4.1.56521.1`)
+	named.SetName(`example`)
+	steps := []func() error{
+		func() error { return r.Set(`x`, mustDot(t, `1.3.6.1.4.1.56521.1`)) },
+		func() error { return r.Set(`x`, named) },
+		func() error { r.Delete(`x`); return nil },
+	}
+	for i := range steps {
+		if err := steps[i](); err != nil {
+			t.Fatalf("step #%d: %v", i, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		number int
+		err    error
+		name   string
+	}{
+		{0, ErrNotFound, ``},
+		{4, ErrNotFound, ``},
+		{3, ErrInvalidValue, ``},
+		{1, nil, ``},
+		{2, nil, `example`},
+	} {
+		err := r.Rollback(`x`, tc.number)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Rollback(%d): got %v, want %v", tc.number, err, tc.err)
+		} else if o, _ := r.Get(`x`); err == nil && (o.IsZero() || o.Name() != tc.name) {
+			t.Errorf("Rollback(%d): got %v, want name %q", tc.number, o, tc.name)
+		}
+	}
+
+	h := r.History(`x`)
+	want := []AuditAction{AuditSet, AuditSet, AuditDelete, AuditRollback, AuditRollback}
+	if len(h) != len(want) {
+		t.Fatalf("History: got %d versions, want %d", len(h), len(want))
+	}
+	for i := range want {
+		if h[i].Number != i+1 || h[i].Action != want[i] || (h[i].OID == nil) != (want[i] == AuditDelete) {
+			t.Errorf("version #%d: got %d %s %v", i, h[i].Number, h[i].Action, h[i].OID)
+		}
+	}
+
+	h[0].OID.SetName(`changed`)
+	if r.History(`x`)[0].OID.Name() == `changed` {
+		t.Errorf("History: returned versions are not copies")
+	}
+
+	r.EnableHistory(false)
+	if r.History(`x`) != nil {
+		t.Errorf("EnableHistory(false): history retained")
+	}
+}
@@ -0,0 +1,196 @@
+package oid
+
+/*
+iana.go deals with the import of OIDs from IANA registry files, such as those of the "ldap-parameters" and "smi-numbers" registries, in either their XML or CSV forms.
+*/
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+)
+
+/*
+ImportIANACSV reads an IANA registry in CSV form from r and imports each of its records into the receiver, returning the number of records imported alongside an error.
+
+The first row must be a header. The OID of each record is taken from its "OID" column if present, e.g. within the "Object Identifier Descriptors" table of the ldap-parameters registry; otherwise its "Decimal" or "Value" column is appended to base, e.g. within the tables of the smi-numbers registry. The name of each record is taken from its "Name" or "Descriptor" column.
+
+Records lacking a name, or whose value is not a single OID (e.g. a range of unassigned values), are skipped. Should an OID already be present within the receiver, the name is added to its alt names; otherwise a new ObjectIdentifier, keyed by its dotNotation, is added. This allows the WellKnown database to be refreshed from authoritative sources at runtime, e.g.:
+
+	f, _ := os.Open(`smi-numbers-5.csv`)
+	mib2, _ := oid.NewFromDot(`1.3.6.1.2.1`)
+	n, err := oid.WellKnown().ImportIANACSV(f, mib2)
+*/
+func (o ObjectIdentifierMap) ImportIANACSV(r io.Reader, base *ObjectIdentifier) (n int, err error) {
+	if o == nil {
+		err = errorw(ErrNilInstance, "%T is nil", o)
+		return
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	var header []string
+	if header, err = cr.Read(); err != nil {
+		return
+	}
+
+	col := func(names ...string) int {
+		for i := 0; i < len(header); i++ {
+			if strInSlice(trimS(header[i]), names) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	oidCol, valCol, nameCol := col(`OID`), col(`Decimal`, `Value`), col(`Name`, `Descriptor`)
+	if nameCol == -1 || (oidCol == -1 && valCol == -1) {
+		err = errorw(ErrSyntax, "Unrecognized IANA CSV header %v", header)
+		return
+	}
+
+	var rec []string
+	for {
+		if rec, err = cr.Read(); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			return
+		}
+
+		field := func(i int) string {
+			if i == -1 || i >= len(rec) {
+				return ``
+			}
+			return trimS(rec[i])
+		}
+
+		if o.importIANA(field(oidCol), field(valCol), field(nameCol), base) {
+			n++
+		}
+	}
+
+	return
+}
+
+/*
+ImportIANAXML reads an IANA registry in XML form from r and imports each of its records into the receiver, returning the number of records imported alongside an error.
+
+The OID of each record is taken from its <oid> element if present; otherwise its <value> element is appended to the prefix of the enclosing registry. The prefix is the dotNotation found in parentheses within the <title> or <note> of the enclosing registry, e.g. "Prefix: iso.org.dod.internet.mgmt.mib (1.3.6.1.2.1)", or base if none is found. The name of each record is taken from its <name> element or, failing that, from its <description> element if it is a single word.
+
+Records are skipped and merged in the manner described by ImportIANACSV.
+*/
+func (o ObjectIdentifierMap) ImportIANAXML(r io.Reader, base *ObjectIdentifier) (n int, err error) {
+	if o == nil {
+		err = errorw(ErrNilInstance, "%T is nil", o)
+		return
+	}
+
+	prefixes := []*ObjectIdentifier{base}
+	rec := make(map[string]string)
+	var elem string
+	var inRecord bool
+
+	dec := xml.NewDecoder(r)
+	for {
+		var tok xml.Token
+		if tok, err = dec.Token(); err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			return
+		}
+
+		switch tv := tok.(type) {
+		case xml.StartElement:
+			elem = tv.Name.Local
+			switch elem {
+			case `registry`:
+				prefixes = append(prefixes, prefixes[len(prefixes)-1])
+			case `record`:
+				inRecord = true
+				rec = make(map[string]string)
+			}
+		case xml.CharData:
+			text := trimS(string(tv))
+			if inRecord {
+				rec[elem] += text
+			} else if (elem == `title` || elem == `note`) && len(prefixes) > 1 {
+				if p := ianaPrefix(text); p != nil {
+					prefixes[len(prefixes)-1] = p
+				}
+			}
+		case xml.EndElement:
+			switch tv.Name.Local {
+			case `registry`:
+				if len(prefixes) > 1 {
+					prefixes = prefixes[:len(prefixes)-1]
+				}
+			case `record`:
+				inRecord = false
+				name := rec[`name`]
+				if desc := rec[`description`]; len(name) == 0 && len(fields(desc)) == 1 {
+					name = desc
+				}
+				if o.importIANA(rec[`oid`], rec[`value`], name, prefixes[len(prefixes)-1]) {
+					n++
+				}
+			}
+			elem = ``
+		}
+	}
+
+	return
+}
+
+/*
+importIANA imports a single IANA record into the receiver, returning a Boolean value indicative of success. The OID is dot if non-zero, else val appended to base.
+*/
+func (o ObjectIdentifierMap) importIANA(dot, val, name string, base *ObjectIdentifier) bool {
+	if len(name) == 0 || eq(name, `Reserved`) || eq(name, `Unassigned`) {
+		return false
+	}
+
+	if len(dot) == 0 {
+		if base.IsZero() || !isDigit(val) {
+			return false
+		}
+		dot = base.DotNotation() + `.` + val
+	}
+
+	x, err := NewFromDot(dot)
+	if err != nil {
+		return false
+	}
+
+	if found, ok := o.Get(x.DotNotation()); ok {
+		if found.Name() != name {
+			found.SetAltNames(name)
+		}
+		return true
+	}
+
+	x.SetName(name)
+	o.Set(x.DotNotation(), x)
+
+	return true
+}
+
+/*
+ianaPrefix returns the OID described by the final parenthesized dotNotation within text, or nil if none is found.
+*/
+func ianaPrefix(text string) (o *ObjectIdentifier) {
+	end := lastIndex(text, `)`)
+	if end == -1 {
+		return
+	}
+
+	start := lastIndex(text[:end], `(`)
+	if start == -1 {
+		return
+	}
+
+	o, _ = NewFromDot(text[start+1 : end])
+	return
+}
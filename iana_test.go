@@ -0,0 +1,108 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestImportIANACSV(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		csv  string
+		base string
+		n    int
+		want map[string]string // dotNotation to name
+		err  error
+	}{
+		{`ldap-parameters`, "Name,Type,OID,Reference\n" +
+			"cn,A,2.5.4.3,[RFC4519]\n" +
+			"commonName,A,2.5.4.3,[RFC4519]\n" +
+			",A,2.5.4.4,\n", ``, 2,
+			map[string]string{`2.5.4.3`: `cn`}, nil},
+		{`smi-numbers`, "Decimal,Name,Description,References\n" +
+			"1,system,,\n" +
+			"2,interfaces,,\n" +
+			"3-9,Unassigned,,\n" +
+			"10,Reserved,,\n", `1.3.6.1.2.1`, 2,
+			map[string]string{`1.3.6.1.2.1.1`: `system`, `1.3.6.1.2.1.2`: `interfaces`}, nil},
+		{`no base`, "Value,Descriptor\n1,system\n", ``, 0, nil, nil},
+		{`bad header`, "Foo,Bar\n1,system\n", ``, 0, nil, ErrSyntax},
+	} {
+		var base *ObjectIdentifier
+		if len(tc.base) > 0 {
+			base = mustDot(t, tc.base)
+		}
+
+		m := make(ObjectIdentifierMap)
+		n, err := m.ImportIANACSV(strings.NewReader(tc.csv), base)
+		if !errors.Is(err, tc.err) || n != tc.n {
+			t.Errorf("%s: got %d (%v), want %d (%v)", tc.name, n, err, tc.n, tc.err)
+			continue
+		}
+		for dot, name := range tc.want {
+			if o, found := m.Get(dot); !found || o.Name() != name {
+				t.Errorf("%s: %s: got %v, want %s", tc.name, dot, o, name)
+			}
+		}
+	}
+
+	// subsequent names are merged as alt names
+	m := make(ObjectIdentifierMap)
+	m.ImportIANACSV(strings.NewReader("Name,OID\ncn,2.5.4.3\ncommonName,2.5.4.3\n"), nil)
+	if o, _ := m.Get(`2.5.4.3`); !o.HasAltName(`commonName`) {
+		t.Errorf("ImportIANACSV: commonName not merged as an alt name")
+	}
+}
+
+func TestImportIANAXML(t *testing.T) {
+	const doc = `<?xml version="1.0"?>
+<registry id="smi-numbers">
+  <title>Network Management Parameters</title>
+  <registry id="smi-numbers-5">
+    <title>Prefix: iso.org.dod.internet.mgmt.mib (1.3.6.1.2.1)</title>
+    <record><value>1</value><description>system</description></record>
+    <record><value>2</value><name>interfaces</name></record>
+    <record><value>3</value><description>Address Translation</description></record>
+  </registry>
+  <registry id="ldap">
+    <record><oid>2.5.4.3</oid><name>cn</name></record>
+    <record><value>9</value><name>orphan</name></record>
+  </registry>
+</registry>`
+
+	for _, tc := range []struct {
+		name string
+		doc  string
+		base string
+		n    int
+		want map[string]string
+	}{
+		{`nested`, doc, ``, 3, map[string]string{
+			`1.3.6.1.2.1.1`: `system`,
+			`1.3.6.1.2.1.2`: `interfaces`,
+			`2.5.4.3`:       `cn`,
+		}},
+		{`base`, doc, `2.999`, 4, map[string]string{`2.999.9`: `orphan`}},
+		{`malformed`, `<registry><record>`, ``, 0, nil},
+	} {
+		var base *ObjectIdentifier
+		if len(tc.base) > 0 {
+			base = mustDot(t, tc.base)
+		}
+
+		m := make(ObjectIdentifierMap)
+		n, err := m.ImportIANAXML(strings.NewReader(tc.doc), base)
+		if n != tc.n {
+			t.Errorf("%s: got %d (%v), want %d", tc.name, n, err, tc.n)
+		}
+		if tc.want == nil && err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+		for dot, name := range tc.want {
+			if o, found := m.Get(dot); !found || o.Name() != name {
+				t.Errorf("%s: %s: got %v, want %s", tc.name, dot, o, name)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package oid
+
+/*
+intern.go deals with the optional interning of arc identifiers, allowing large numbers of ObjectIdentifier instances to share identifier strings such as "iso", "dod" and "internet".
+*/
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+/*
+InternPool is a concurrency-safe pool of identifier strings. Lookups of identifiers already pooled take no lock, as the pool is read by every parse once enabled using UseInternPool. A zero InternPool is empty and ready for use.
+*/
+type InternPool struct {
+	m sync.Map // string -> string
+	n atomic.Int64
+}
+
+/*
+internPool is the pool enabled using UseInternPool, or nil.
+*/
+var internPool atomic.Pointer[InternPool]
+
+/*
+NewInternPool returns a new, empty instance of *InternPool.
+*/
+func NewInternPool() *InternPool {
+	return new(InternPool)
+}
+
+/*
+UseInternPool instructs the parsers of this package to intern all arc identifiers using p. Passing nil disables interning, which is the default.
+*/
+func UseInternPool(p *InternPool) {
+	internPool.Store(p)
+}
+
+/*
+Intern returns the pooled instance of val, adding val to the pool if not already present. The pooled instance is a copy of val, thus the pool never retains a larger string of which val may be a substring.
+*/
+func (p *InternPool) Intern(val string) string {
+	if p == nil || len(val) == 0 {
+		return val
+	}
+
+	if s, found := p.m.Load(val); found {
+		return s.(string)
+	}
+
+	s := string(append([]byte{}, val...))
+	if v, loaded := p.m.LoadOrStore(s, s); loaded {
+		return v.(string)
+	}
+	p.n.Add(1)
+
+	return s
+}
+
+/*
+Len returns the number of strings held by the receiver.
+*/
+func (p *InternPool) Len() int {
+	if p == nil {
+		return 0
+	}
+
+	return int(p.n.Load())
+}
+
+/*
+Intern replaces each ObjectIdentifier within the receiver with a copy whose arc identifiers are their pooled instances from p. This is useful for ObjectIdentifierMap instances populated before UseInternPool was called.
+
+Each copy is allocated anew, such that its arcs remain stored inline (see newObjectIdentifier), rather than modified in place as a mutator would. Instances obtained from the receiver beforehand are therefore left as they were.
+*/
+func (o ObjectIdentifierMap) Intern(p *InternPool) {
+	for k, v := range o {
+		if v.IsZero() {
+			continue
+		}
+
+		c := v.clone()
+		c.noFold, c.descrNames = v.noFold, v.descrNames
+		for i := 0; i < len(c.nANF); i++ {
+			c.nANF[i].identifier = p.Intern(c.nANF[i].identifier)
+		}
+		o[k] = c
+	}
+}
+
+/*
+intern returns the pooled instance of val if interning was enabled through UseInternPool, else val is returned as-is.
+*/
+func intern(val string) string {
+	return internPool.Load().Intern(val)
+}
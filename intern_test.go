@@ -0,0 +1,108 @@
+package oid
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+/*
+TestObjectIdentifierMapIntern verifies that interned instances retain their contents and inline arcs, and that instances obtained beforehand are left as they were.
+*/
+func TestObjectIdentifierMapIntern(t *testing.T) {
+	for _, tc := range []struct {
+		nanf string
+		pool int
+	}{
+		{`{ joint-iso-itu-t(2) ds(5) attributeType(4) cn(3) }`, 4},
+		{`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 1 5 }`, 6},
+		{`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) microsoft(311) 21 8 1 2 3 }`, 7},
+	} {
+		o, err := NewFromNaNF(tc.nanf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = o.SetName(`example`); err != nil {
+			t.Fatal(err)
+		}
+
+		m := ObjectIdentifierMap{`example`: o}
+		p := NewInternPool()
+		m.Intern(p)
+
+		x := m[`example`]
+		switch {
+		case x == o:
+			t.Errorf("%s: Intern modified the instance in place", tc.nanf)
+		case x.String() != o.String() || x.Name() != `example`:
+			t.Errorf("%s: got %s (%s), want %s (example)", tc.nanf, x, x.Name(), o)
+		case cap(x.arcs()) > inlineArcs:
+			t.Errorf("%s: interned arcs spilled over (capacity %d)", tc.nanf, cap(x.arcs()))
+		case p.Len() != tc.pool:
+			t.Errorf("%s: got %d pooled identifiers, want %d", tc.nanf, p.Len(), tc.pool)
+		}
+	}
+}
+
+/*
+TestInternPoolConcurrent verifies that concurrent callers of Intern receive the same pooled instance of each identifier, and that each is counted once.
+*/
+func TestInternPoolConcurrent(t *testing.T) {
+	ids := []string{`iso`, `identified-organization`, `dod`, `internet`, `private`, `enterprise`}
+	p := NewInternPool()
+
+	var wg sync.WaitGroup
+	got := make([][]string, 8)
+	for g := 0; g < len(got); g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < len(ids); i++ {
+				got[g] = append(got[g], p.Intern(string(append([]byte{}, ids[i]...))))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	if p.Len() != len(ids) {
+		t.Errorf("got %d pooled identifiers, want %d", p.Len(), len(ids))
+	}
+	for g := 1; g < len(got); g++ {
+		for i := 0; i < len(ids); i++ {
+			if unsafe.StringData(got[g][i]) != unsafe.StringData(got[0][i]) {
+				t.Errorf("%s: goroutine %d received a distinct instance", ids[i], g)
+			}
+		}
+	}
+}
+
+/*
+TestInternAllocs guards the lookup of identifiers already pooled, which is performed by every parse once interning is enabled.
+*/
+func TestInternAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable under the race detector")
+	}
+
+	p := NewInternPool()
+	p.Intern(`internet`)
+	val := string(append([]byte{}, `internet`...))
+	if n := testing.AllocsPerRun(100, func() { p.Intern(val) }); n > 0 {
+		t.Errorf("got %.0f allocations per pooled lookup, want none", n)
+	}
+}
+
+func BenchmarkNewFromNaNFInterned(b *testing.B) {
+	UseInternPool(NewInternPool())
+	defer UseInternPool(nil)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := NewFromNaNF(benchNaNF); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
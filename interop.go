@@ -0,0 +1,59 @@
+package oid
+
+/*
+interop.go deals with the string OID conventions of popular third-party packages, such as the leading-dot form used by gosnmp and the descriptor form used by go-ldap.
+*/
+
+/*
+SNMP returns the leading-dot form of the receiver, as used by gosnmp and the Net-SNMP tools, e.g.:
+
+	.1.3.6.1.2.1.1.1
+
+A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) SNMP() string {
+	if o.IsZero() {
+		return ``
+	}
+	return `.` + o.DotNotation()
+}
+
+/*
+ParseSNMP returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an SNMP OID string, such as those produced by gosnmp. A single leading dot is tolerated but not required.
+*/
+func ParseSNMP(x string) (o *ObjectIdentifier, err error) {
+	if len(x) > 1 && x[0] == '.' {
+		x = x[1:]
+	}
+
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No content for ParseSNMP to read")
+		return
+	}
+
+	return NewFromDot(x)
+}
+
+/*
+LDAP returns the form of the receiver most suitable for use with go-ldap and LDAP protocol elements generally: a descriptor if one is known, else the numeric-oid. See GSER for details.
+*/
+func (o *ObjectIdentifier) LDAP() string {
+	return o.GSER(true)
+}
+
+/*
+ParseLDAP returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an LDAP OID string, such as an attribute description or control type held by a go-ldap structure.
+
+Attribute options, e.g. the ";binary" of "userCertificate;binary", are discarded, as is a single leading dot. The remaining value is parsed in the manner of ParseGSER, thus a descriptor is resolved using m, or the WellKnown database if m is nil.
+*/
+func ParseLDAP(x string, m ObjectIdentifierMap) (o *ObjectIdentifier, err error) {
+	if idx := indexRune(x, ';'); idx != -1 {
+		x = x[:idx]
+	}
+
+	if len(x) > 1 && x[0] == '.' {
+		x = x[1:]
+	}
+
+	return ParseGSER(x, m)
+}
@@ -0,0 +1,54 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSNMP(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		snmp string
+		err  error
+	}{
+		{`.1.3.6.1.2.1.1.1`, `.1.3.6.1.2.1.1.1`, nil},
+		{`1.3.6.1.2.1.1.1`, `.1.3.6.1.2.1.1.1`, nil},
+		{`.`, ``, ErrInvalidNumberForm},
+		{``, ``, ErrEmptyInput},
+		{`..1.3`, ``, ErrInvalidNumberForm},
+	} {
+		o, err := ParseSNMP(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if s := o.SNMP(); s != tc.snmp {
+			t.Errorf("%q: got %q, want %q", tc.in, s, tc.snmp)
+		}
+	}
+}
+
+func TestLDAP(t *testing.T) {
+	m := ObjectIdentifierMap{`exampleAttr`: mustDot(t, `1.3.6.1.4.1.56521.1`)}
+	m[`exampleAttr`].SetName(`exampleAttr`)
+
+	for _, tc := range []struct {
+		in   string
+		m    ObjectIdentifierMap
+		dot  string
+		ldap string
+		err  error
+	}{
+		{`cn`, nil, `2.5.4.3`, `cn`, nil},
+		{`cn;lang-en`, nil, `2.5.4.3`, `cn`, nil},
+		{`.2.5.4.3`, nil, `2.5.4.3`, `2.5.4.3`, nil},
+		{`exampleAttr;lang-en`, m, `1.3.6.1.4.1.56521.1`, `exampleAttr`, nil},
+		{`1.3.6.1.4.1.56521.2`, m, `1.3.6.1.4.1.56521.2`, `1.3.6.1.4.1.56521.2`, nil},
+		{`unknownAttr`, m, ``, ``, ErrNotFound},
+	} {
+		o, err := ParseLDAP(tc.in, tc.m)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if err == nil && (o.DotNotation() != tc.dot || o.LDAP() != tc.ldap) {
+			t.Errorf("%q: got %s (%s), want %s (%s)", tc.in, o.DotNotation(), o.LDAP(), tc.dot, tc.ldap)
+		}
+	}
+}
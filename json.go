@@ -0,0 +1,110 @@
+package oid
+
+/*
+json.go deals with the JSON representation of ObjectIdentifier instances.
+*/
+
+import "encoding/json"
+
+/*
+objectIdentifierJSON is the JSON form of an ObjectIdentifier.
+*/
+type objectIdentifierJSON struct {
+	Name     string   `json:"name,omitempty"`
+	Dot      string   `json:"dotNotation,omitempty"`
+	NaNF     string   `json:"nameAndNumberForm,omitempty"`
+	AltNames []string `json:"altNames,omitempty"`
+	Desc     string   `json:"description,omitempty"`
+	Status   string   `json:"status,omitempty"`
+
+	Annotations []arcAnnotationJSON `json:"annotations,omitempty"`
+}
+
+/*
+arcAnnotationJSON is the JSON form of the annotation of a single arc, identified by its index.
+*/
+type arcAnnotationJSON struct {
+	Arc  int    `json:"arc"`
+	Text string `json:"text"`
+}
+
+/*
+MarshalJSON returns the JSON representation of the receiver alongside an error, e.g.:
+
+	{"name":"id-kp-serverAuth","dotNotation":"1.3.6.1.5.5.7.3.1","nameAndNumberForm":"{ iso(1) ... id-kp-serverAuth(1) }","altNames":["serverAuth"]}
+
+A nil receiver is represented as null.
+*/
+func (o *ObjectIdentifier) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte(`null`), nil
+	}
+
+	j := objectIdentifierJSON{
+		Name:     o.Name(),
+		Dot:      o.DotNotation(),
+		NaNF:     o.StringAs(FormNaNF),
+		AltNames: o.AltNames(),
+		Desc:     o.Description(),
+	}
+
+	if st := o.Status(); st != StatusCurrent {
+		j.Status = st.String()
+	}
+
+	for i := 0; i < o.len(); i++ {
+		if text := o.Arc(i).Annotation(); len(text) > 0 {
+			j.Annotations = append(j.Annotations, arcAnnotationJSON{Arc: i, Text: text})
+		}
+	}
+
+	return json.Marshal(j)
+}
+
+/*
+UnmarshalJSON populates the receiver using the JSON representation data, as produced by MarshalJSON, alongside an error. The nameAndNumberForm value is preferred over the dotNotation value when both are present.
+*/
+func (o *ObjectIdentifier) UnmarshalJSON(data []byte) (err error) {
+	var j objectIdentifierJSON
+	if err = json.Unmarshal(data, &j); err != nil {
+		return
+	}
+
+	var t *ObjectIdentifier
+	if len(j.NaNF) > 0 {
+		t, err = NewFromNaNF(j.NaNF)
+	} else {
+		t, err = NewFromDot(j.Dot)
+	}
+
+	if err != nil {
+		return
+	}
+
+	// the principal name may have been rendered
+	// as the identifier of the final arc
+	if last := &t.nANF[len(t.nANF)-1]; last.identifier == j.Name {
+		last.identifier = ``
+	}
+
+	if len(j.Status) > 0 {
+		if t.status, err = ParseStatus(j.Status); err != nil {
+			return
+		}
+	}
+
+	for i := 0; i < len(j.Annotations); i++ {
+		if err = t.SetArcAnnotation(j.Annotations[i].Arc, j.Annotations[i].Text); err != nil {
+			return
+		}
+	}
+
+	t.name = j.Name
+	t.desc = j.Desc
+	t.SetAltNames(j.AltNames...)
+
+	*o = *t
+	o.invalidate()
+
+	return
+}
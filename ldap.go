@@ -0,0 +1,56 @@
+package oid
+
+/*
+ldap.go deals with OID values found within LDAP schema definitions, as described by RFC 4512.
+*/
+
+/*
+ParseOIDOrDescr returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an RFC 4512 "oid" production, i.e. either a numericoid or a descr. This is the form taken by the SUP, SYNTAX, EQUALITY, ORDERING and SUBSTR fields, among others, of LDAP schema definitions, e.g.:
+
+	o, err := oid.ParseOIDOrDescr(`caseIgnoreMatch`, nil)
+	o, err = oid.ParseOIDOrDescr(`2.5.13.2`, nil)
+
+Leading and trailing whitespace is ignored. A numericoid must bear at least two arcs and no leading zeros. A descr is resolved case-insensitively using the provided ObjectIdentifierMap, or the WellKnown database if m is nil, and a copy of the instance found is returned; an error wrapping ErrNotFound is returned should it be unknown.
+
+Note that SYNTAX values bearing a length bound (a "noidlen", e.g. "1.3.6.1.4.1.1466.115.121.1.15{256}") must be stripped of the bound prior to use.
+*/
+func ParseOIDOrDescr(x string, m ObjectIdentifierMap) (o *ObjectIdentifier, err error) {
+	if x = trimS(x); len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No content for ParseOIDOrDescr to read")
+		return
+	}
+
+	if '0' <= x[0] && x[0] <= '9' {
+		if indexRune(x, '.') == -1 {
+			err = errorw(ErrInvalidNumberForm, "Bad numericoid '%s' [hint: at least two arcs are required]", x)
+			return
+		}
+
+		t := newObjectIdentifier(countByte(x, '.') + 1)
+		if t.nANF, err = parseDotNotation(t.nANF, x, true); err != nil {
+			return
+		}
+
+		if err = t.checkValid(); err == nil {
+			o = t
+		}
+		return
+	}
+
+	if !isDescr(x) {
+		err = errorw(ErrInvalidIdentifier, "Bad descr '%s'", x)
+		return
+	}
+
+	if m == nil {
+		m = WellKnown()
+	}
+
+	if t, found := m.Get(x); found {
+		o = t.clone()
+	} else {
+		err = errorw(ErrNotFound, "descr '%s' not found", x)
+	}
+
+	return
+}
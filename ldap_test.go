@@ -0,0 +1,42 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseOIDOrDescr(t *testing.T) {
+	ex := mustDot(t, `1.3.6.1.4.1.56521.1.1`)
+	ex.SetName(`exampleMatch`)
+	m := ObjectIdentifierMap{`exampleMatch`: ex}
+
+	for _, tc := range []struct {
+		in   string
+		m    ObjectIdentifierMap
+		want string
+		err  error
+	}{
+		{`2.5.13.2`, nil, `2.5.13.2`, nil},
+		{` 2.5.13.2 `, nil, `2.5.13.2`, nil},
+		{`caseIgnoreMatch`, nil, `2.5.13.2`, nil},
+		{`CASEIGNOREMATCH`, nil, `2.5.13.2`, nil},
+		{`examplematch`, m, `1.3.6.1.4.1.56521.1.1`, nil},
+		{`caseIgnoreMatch`, m, ``, ErrNotFound},
+		{`2`, nil, ``, ErrInvalidNumberForm},
+		{`2.05.13`, nil, ``, ErrInvalidNumberForm},
+		{`case_ignore`, nil, ``, ErrInvalidIdentifier},
+		{`  `, nil, ``, ErrEmptyInput},
+	} {
+		o, err := ParseOIDOrDescr(tc.in, tc.m)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if err == nil && o.DotNotation() != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, o.DotNotation(), tc.want)
+		}
+	}
+
+	// a copy of the registered instance is returned
+	if o, _ := ParseOIDOrDescr(`exampleMatch`, m); o == ex {
+		t.Errorf("ParseOIDOrDescr: got the registered instance, want a copy")
+	}
+}
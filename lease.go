@@ -0,0 +1,125 @@
+package oid
+
+/*
+lease.go deals with provisional Registry assignments which expire after a period of time.
+*/
+
+import (
+	"sort"
+	"time"
+)
+
+/*
+Lease assigns x to the receiver under key in the manner of Set, but provisionally: the assignment expires once ttl has elapsed, after which it is subject to Sweep. A subsequent Set, Delete or Rollback of key ends the lease, making the assignment permanent or removing it.
+
+The DuplicatePolicy of the receiver applies as it does to Set. Should x be merged into an existing entry, under key or another key, the lifetime of that entry is left as it was: a permanent entry is not leased, and a leased entry keeps its expiry.
+*/
+func (r *Registry) Lease(key string, x *ObjectIdentifier, ttl time.Duration) (err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if x.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", x)
+		return
+	} else if ttl <= 0 {
+		err = errorw(ErrInvalidDuration, "Lease duration must be positive")
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k, v, err := r.place(r.oids, key, x)
+	if err != nil {
+		return
+	}
+
+	prior, leased := r.lease[k]
+	r.oids[k] = v
+	r.record(AuditSet, k, v)
+	if v == x {
+		r.lease[k] = time.Now().Add(ttl)
+	} else if leased {
+		r.lease[k] = prior
+	}
+
+	return
+}
+
+/*
+Renew extends the lease upon the entry bearing key such that it expires once ttl has elapsed from now. An error is returned if the entry is not leased.
+*/
+func (r *Registry) Renew(key string, ttl time.Duration) (err error) {
+	if r == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if ttl <= 0 {
+		err = errorw(ErrInvalidDuration, "Lease duration must be positive")
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, leased := r.lease[key]; !leased {
+		err = errorw(ErrNotFound, "No lease upon '%s'", key)
+		return
+	}
+	r.lease[key] = time.Now().Add(ttl)
+
+	return
+}
+
+/*
+Expiry returns the moment at which the lease upon the entry bearing key expires, alongside a Boolean value indicative of whether the entry is leased at all.
+*/
+func (r *Registry) Expiry(key string) (expires time.Time, leased bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expires, leased = r.lease[key]
+	return
+}
+
+/*
+Sweep returns the keys of all entries whose leases have expired, in lexical order.
+
+If remove is true, each such entry is removed from the receiver in the manner of Delete. Otherwise, each is flagged by replacing it with a copy bearing StatusObsolete as its lifecycle status, which is recorded within the audit trail and history as AuditExpire. A flagged entry remains leased, thus it will be returned again by subsequent sweeps until renewed, assigned permanently or removed, though it is recorded only once.
+*/
+func (r *Registry) Sweep(remove bool) (expired []string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for k, t := range r.lease {
+		if now.After(t) {
+			expired = append(expired, k)
+		}
+	}
+	sort.Strings(expired)
+
+	for i := 0; i < len(expired); i++ {
+		x, found := r.oids[expired[i]]
+		if !found {
+			delete(r.lease, expired[i])
+		} else if remove {
+			delete(r.oids, expired[i])
+			r.record(AuditDelete, expired[i], x)
+		} else if x.Status() != StatusObsolete {
+			x = x.clone()
+			x.SetStatus(StatusObsolete)
+			r.oids[expired[i]] = x
+			r.record(AuditExpire, expired[i], x)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,172 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLeaseDuplicatePolicy(t *testing.T) {
+	r := NewRegistry()
+	r.SetDuplicatePolicy(DuplicateReject)
+
+	orig, _ := NewFromDot(`1.3.6.1.4.1.56521`)
+	if err := r.Set(`a`, orig); err != nil {
+		t.Fatal(err)
+	}
+
+	dup, _ := NewFromDot(`1.3.6.1.4.1.56521`)
+	if err := r.Lease(`c`, dup, time.Hour); err == nil {
+		t.Errorf("Lease of duplicate OID: expected error under %s policy", DuplicateReject)
+	} else if _, found := r.oids[`c`]; found {
+		t.Errorf("Lease of duplicate OID: entry assigned despite error")
+	} else if _, leased := r.Expiry(`c`); leased {
+		t.Errorf("Lease of duplicate OID: lease recorded despite error")
+	}
+
+	r.SetDuplicatePolicy(DuplicateMerge)
+	dup.SetName(`example`)
+	if err := r.Lease(`c`, dup, time.Hour); err != nil {
+		t.Fatalf("Lease of duplicate OID under %s policy: %v", DuplicateMerge, err)
+	} else if _, found := r.oids[`c`]; found {
+		t.Errorf("Lease of duplicate OID: assigned under new key despite %s policy", DuplicateMerge)
+	} else if _, leased := r.Expiry(`a`); leased {
+		t.Errorf("Lease of duplicate OID: existing entry became leased")
+	} else if got := r.oids[`a`].Name(); got != `example` {
+		t.Errorf("Lease of duplicate OID: got name %q, want %q", got, `example`)
+	}
+}
+
+func TestRollbackDuplicatePolicy(t *testing.T) {
+	r := NewRegistry()
+	r.EnableHistory(true)
+
+	a, _ := NewFromDot(`1.3.6.1.4.1.56521`)
+	b, _ := NewFromDot(`1.3.6.1.4.1.56522`)
+	_ = r.Set(`a`, a)
+	_ = r.Set(`a`, b)
+	_ = r.Set(`c`, a)
+
+	r.SetDuplicatePolicy(DuplicateReject)
+	if err := r.Rollback(`a`, 1); err == nil {
+		t.Errorf("Rollback to duplicate OID: expected error under %s policy", DuplicateReject)
+	} else if got := r.oids[`a`].DotNotation(); got != `1.3.6.1.4.1.56522` {
+		t.Errorf("Rollback to duplicate OID: got %s, want entry unchanged", got)
+	}
+}
+
+func TestLeaseMergeLifetime(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		prior  time.Duration // zero for a permanent entry
+		key    string
+		leased bool
+	}{
+		{`fresh key`, 0, `b`, true},
+		{`permanent entry`, 0, `a`, false},
+		{`leased entry`, time.Minute, `a`, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRegistry()
+			r.SetDuplicatePolicy(DuplicateMerge)
+
+			a, _ := NewFromDot(`1.3.6.1.4.1.56521`)
+			if tc.prior > 0 {
+				_ = r.Lease(`a`, a, tc.prior)
+			} else {
+				_ = r.Set(`a`, a)
+			}
+			before, _ := r.Expiry(`a`)
+
+			x, _ := NewFromDot(`1.3.6.1.4.1.56521`)
+			if tc.key == `b` {
+				x, _ = NewFromDot(`1.3.6.1.4.1.56522`)
+			}
+			if err := r.Lease(tc.key, x, time.Hour); err != nil {
+				t.Fatal(err)
+			}
+
+			after, leased := r.Expiry(tc.key)
+			if leased != tc.leased {
+				t.Errorf("got leased %t, want %t", leased, tc.leased)
+			} else if tc.prior > 0 && !after.Equal(before) {
+				t.Errorf("expiry changed from %s to %s", before, after)
+			}
+		})
+	}
+}
+
+func TestSweepFlagsExpired(t *testing.T) {
+	r := NewRegistry()
+	r.EnableHistory(true)
+	log := NewAuditLog(nil)
+	r.SetAuditLog(log)
+
+	x, _ := NewFromDot(`1.3.6.1.4.1.56521`)
+	if err := r.Lease(`a`, x, time.Nanosecond); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		if expired := r.Sweep(false); len(expired) != 1 || expired[0] != `a` {
+			t.Fatalf("Sweep #%d: got %v, want [a]", i+1, expired)
+		}
+	}
+
+	if st := x.Status(); st != StatusCurrent {
+		t.Errorf("original instance modified in place: status %s", st)
+	} else if got, _ := r.Get(`a`); got.Status() != StatusObsolete {
+		t.Errorf("got status %s, want %s", got.Status(), StatusObsolete)
+	} else if _, leased := r.Expiry(`a`); !leased {
+		t.Errorf("flagged entry is no longer leased")
+	}
+
+	hist := r.History(`a`)
+	if len(hist) != 2 || hist[1].Action != AuditExpire {
+		t.Errorf("got %d versions, want 2 ending with %s", len(hist), AuditExpire)
+	} else if n := log.Len(); n != 2 {
+		t.Errorf("got %d audit records, want 2", n)
+	}
+}
+
+func TestLeaseLifecycle(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		then    func(*Registry) error
+		err     error
+		leased  bool
+		removed []string
+	}{
+		{`expired`, func(*Registry) error { return nil }, nil, true, []string{`a`}},
+		{`renewed`, func(r *Registry) error { return r.Renew(`a`, time.Hour) }, nil, true, nil},
+		{`made permanent`, func(r *Registry) error { return r.Set(`a`, NewEnterpriseOID(56521)) }, nil, false, nil},
+		{`deleted`, func(r *Registry) error { r.Delete(`a`); return nil }, nil, false, nil},
+		{`renew unleased`, func(r *Registry) error { return r.Renew(`b`, time.Hour) }, ErrNotFound, true, []string{`a`}},
+		{`renew negative`, func(r *Registry) error { return r.Renew(`a`, -time.Hour) }, ErrInvalidDuration, true, []string{`a`}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRegistry()
+			if err := r.Lease(`a`, NewEnterpriseOID(56521), time.Nanosecond); err != nil {
+				t.Fatal(err)
+			}
+			_ = r.Set(`b`, NewEnterpriseOID(56522))
+			time.Sleep(time.Millisecond)
+
+			if err := tc.then(r); !errors.Is(err, tc.err) {
+				t.Fatalf("got %v, want %v", err, tc.err)
+			} else if _, leased := r.Expiry(`a`); leased != tc.leased {
+				t.Errorf("got leased %t, want %t", leased, tc.leased)
+			}
+
+			removed := r.Sweep(true)
+			if len(removed) != len(tc.removed) || len(removed) > 0 && removed[0] != tc.removed[0] {
+				t.Errorf("Sweep: got %v, want %v", removed, tc.removed)
+			} else if _, found := r.Get(`b`); !found {
+				t.Errorf("Sweep removed a permanent entry")
+			} else if _, leased := r.Expiry(`a`); leased && len(removed) > 0 {
+				t.Errorf("Sweep left a lease upon a removed entry")
+			}
+		})
+	}
+}
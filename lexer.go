@@ -0,0 +1,238 @@
+package oid
+
+/*
+lexer.go contains an exported tokenizer for ASN.1 NameAndNumberForm sequences, allowing OID values found within larger documents, such as ASN.1 modules, to be lexed using the grammar of this package.
+*/
+
+/*
+TokenType describes the lexical class of a Token.
+*/
+type TokenType uint8
+
+const (
+	TokenIllegal TokenType = iota // an unexpected character
+	TokenEOF                      // end of input
+	TokenLBrace                   // {
+	TokenRBrace                   // }
+	TokenLParen                   // (
+	TokenRParen                   // )
+	TokenIdent                    // an identifier, e.g. iso
+	TokenNumber                   // a number form, e.g. 1
+)
+
+var tokenTypeNames = [...]string{
+	TokenIllegal: `ILLEGAL`,
+	TokenEOF:     `EOF`,
+	TokenLBrace:  `LBRACE`,
+	TokenRBrace:  `RBRACE`,
+	TokenLParen:  `LPAREN`,
+	TokenRParen:  `RPAREN`,
+	TokenIdent:   `IDENT`,
+	TokenNumber:  `NUMBER`,
+}
+
+/*
+String returns the string name of the receiver, e.g. "LBRACE".
+*/
+func (t TokenType) String() string {
+	if int(t) < len(tokenTypeNames) {
+		return tokenTypeNames[t]
+	}
+	return `TokenType(` + itoa(int(t)) + `)`
+}
+
+/*
+Token is a single lexical element produced by a Lexer. Pos is the byte offset of Value within the input.
+*/
+type Token struct {
+	Type  TokenType
+	Value string
+	Pos   int
+}
+
+/*
+String returns the string representation of the receiver, e.g. IDENT("iso").
+*/
+func (t Token) String() string {
+	return sprintf("%s(%q)", t.Type, t.Value)
+}
+
+/*
+Lexer tokenizes ASN.1 NameAndNumberForm sequences, e.g.:
+
+	{ iso(1) identified-organization(3) dod(6) }
+
+Whitespace, as well as ASN.1 comments (from "--" until the next "--" or the end of the line), is skipped. Identifiers begin with a letter and may contain letters, digits and single hyphens, though may not end with a hyphen; number forms consist solely of digits. Lexing does not require whitespace between tokens, thus "{iso(1)}" is tokenized in the same manner as "{ iso(1) }".
+
+Identifiers are not otherwise validated, e.g. for a leading lowercase letter; this is left to the consumer, such as NewFromTokens.
+*/
+type Lexer struct {
+	src string
+	pos int
+}
+
+/*
+NewLexer returns a new *Lexer instance reading from x.
+*/
+func NewLexer(x string) *Lexer {
+	return &Lexer{src: x}
+}
+
+/*
+Offset returns the byte offset at which the receiver will resume lexing. This allows a consumer to stop at the closing brace of an OID value and resume processing the remainder of a larger document itself.
+*/
+func (l *Lexer) Offset() int {
+	return l.pos
+}
+
+/*
+Next returns the next Token from the input. A Token of type TokenEOF is returned upon exhaustion of the input, and one of type TokenIllegal, bearing the offending character, upon encountering a character outside of the grammar. The receiver advances past an illegal character, allowing lexing to continue.
+*/
+func (l *Lexer) Next() (tok Token) {
+	l.skip()
+
+	tok.Pos = l.pos
+	if l.pos >= len(l.src) {
+		tok.Type = TokenEOF
+		return
+	}
+
+	start := l.pos
+	switch ch := l.src[l.pos]; {
+	case ch == '{':
+		tok.Type = TokenLBrace
+		l.pos++
+	case ch == '}':
+		tok.Type = TokenRBrace
+		l.pos++
+	case ch == '(':
+		tok.Type = TokenLParen
+		l.pos++
+	case ch == ')':
+		tok.Type = TokenRParen
+		l.pos++
+	case '0' <= ch && ch <= '9':
+		tok.Type = TokenNumber
+		for l.pos < len(l.src) && '0' <= l.src[l.pos] && l.src[l.pos] <= '9' {
+			l.pos++
+		}
+	case isAlpha(ch):
+		tok.Type = TokenIdent
+		l.pos++
+		for l.pos < len(l.src) {
+			if c := l.src[l.pos]; isAlpha(c) || ('0' <= c && c <= '9') {
+				l.pos++
+			} else if c == '-' && l.pos+1 < len(l.src) && l.src[l.pos+1] != '-' &&
+				(isAlpha(l.src[l.pos+1]) || ('0' <= l.src[l.pos+1] && l.src[l.pos+1] <= '9')) {
+				l.pos++
+			} else {
+				break
+			}
+		}
+	default:
+		tok.Type = TokenIllegal
+		l.pos++
+	}
+
+	tok.Value = l.src[start:l.pos]
+	return
+}
+
+/*
+skip advances the receiver past any whitespace and comments.
+*/
+func (l *Lexer) skip() {
+	for l.pos < len(l.src) {
+		if isSpace(l.src[l.pos]) {
+			l.pos++
+			continue
+		} else if !hasPrefix(l.src[l.pos:], `--`) {
+			return
+		}
+
+		// ASN.1 comment: ends at the next "--" or line break
+		l.pos += 2
+		for l.pos < len(l.src) {
+			if hasPrefix(l.src[l.pos:], `--`) {
+				l.pos += 2
+				break
+			} else if l.src[l.pos] == '\n' || l.src[l.pos] == '\r' {
+				break
+			}
+			l.pos++
+		}
+	}
+}
+
+/*
+Tokenize returns all tokens within x, excluding the final TokenEOF, alongside an error. An error is returned upon encountering a TokenIllegal.
+*/
+func Tokenize(x string) (toks []Token, err error) {
+	l := NewLexer(x)
+	for {
+		tok := l.Next()
+		switch tok.Type {
+		case TokenEOF:
+			return
+		case TokenIllegal:
+			err = errorw(ErrSyntax, "Illegal character '%s' at offset %d", tok.Value, tok.Pos)
+			return
+		}
+		toks = append(toks, tok)
+	}
+}
+
+/*
+NewFromTokens returns an instance of *ObjectIdentifier alongside an error following an attempt to assemble toks, as produced by a Lexer, into an ObjectIdentifier. The enclosing TokenLBrace and TokenRBrace are optional, and each arc must be either a TokenNumber or the sequence TokenIdent, TokenLParen, TokenNumber, TokenRParen, e.g.:
+
+	toks, _ := oid.Tokenize(`{iso(1) 3 dod(6)}`)
+	o, err := oid.NewFromTokens(toks)
+*/
+func NewFromTokens(toks []Token) (o *ObjectIdentifier, err error) {
+	return newFromTokens(toks, false)
+}
+
+/*
+newFromTokens implements NewFromTokens. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func newFromTokens(toks []Token, upper bool) (o *ObjectIdentifier, err error) {
+	if n := len(toks); n > 0 && toks[0].Type == TokenLBrace {
+		if toks[n-1].Type != TokenRBrace {
+			err = errorw(ErrSyntax, "No closing brace for NewFromTokens to read")
+			return
+		}
+		toks = toks[1 : n-1]
+	}
+
+	t := newObjectIdentifier(len(toks))
+	for i := 0; i < len(toks); i++ {
+		var arc NameAndNumberForm
+		switch tok := toks[i]; tok.Type {
+		case TokenNumber:
+			err = arc.setNumberForm(tok.Value)
+		case TokenIdent:
+			if i+3 >= len(toks) ||
+				toks[i+1].Type != TokenLParen || toks[i+2].Type != TokenNumber || toks[i+3].Type != TokenRParen {
+				err = errorw(ErrInvalidNumberForm, "Bad nameAndNumberForm at offset %d [hint: expected %s(NUMBER)]", tok.Pos, tok.Value)
+			} else if _, err = identifierIsValid(tok.Value, upper); err == nil {
+				if err = arc.setNumberForm(toks[i+2].Value); err == nil {
+					arc.identifier = intern(tok.Value)
+				}
+				i += 3
+			}
+		default:
+			err = errorw(ErrSyntax, "Unexpected %s at offset %d", tok, tok.Pos)
+		}
+
+		if err != nil {
+			return
+		}
+		t.nANF = append(t.nANF, arc)
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
@@ -0,0 +1,87 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{`{ iso(1) 3 }`, `LBRACE("{") IDENT("iso") LPAREN("(") NUMBER("1") RPAREN(")") NUMBER("3") RBRACE("}")`, nil},
+		{`{iso(1)}`, `LBRACE("{") IDENT("iso") LPAREN("(") NUMBER("1") RPAREN(")") RBRACE("}")`, nil},
+		{`identified-organization(3)`, `IDENT("identified-organization") LPAREN("(") NUMBER("3") RPAREN(")")`, nil},
+		{"iso -- the root -- (1)", `IDENT("iso") LPAREN("(") NUMBER("1") RPAREN(")")`, nil},
+		{"iso -- to end of line\n(1)", `IDENT("iso") LPAREN("(") NUMBER("1") RPAREN(")")`, nil},
+		{`bad--name`, `IDENT("bad")`, nil},
+		{`trailing-`, ``, ErrSyntax},
+		{`{ iso(1) 3. }`, ``, ErrSyntax},
+		{``, ``, nil},
+	} {
+		toks, err := Tokenize(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+			continue
+		} else if err != nil {
+			continue
+		}
+
+		var got []string
+		for _, tok := range toks {
+			got = append(got, tok.String())
+		}
+		if s := strings.Join(got, ` `); s != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, s, tc.want)
+		}
+	}
+}
+
+func TestLexerOffset(t *testing.T) {
+	const doc = `id-example OBJECT IDENTIFIER ::= { 2 999 } -- rest`
+	value := doc[indexRune(doc, '{'):]
+
+	l := NewLexer(value)
+	for tok := l.Next(); tok.Type != TokenRBrace; tok = l.Next() {
+		if tok.Type == TokenEOF {
+			t.Fatal("no closing brace")
+		}
+	}
+	if rest := value[l.Offset():]; rest != ` -- rest` {
+		t.Errorf("Offset: got remainder %q", rest)
+	}
+	if tok := l.Next(); tok.Type != TokenEOF || tok.Pos != len(value) {
+		t.Errorf("Next: got %s at %d following comment", tok, tok.Pos)
+	}
+}
+
+func TestNewFromTokens(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{`{ iso(1) 3 dod(6) }`, `{ iso(1) 3 dod(6) }`, nil},
+		{`iso(1) 3`, `{ iso(1) 3 }`, nil},
+		{`{ iso(1) 3`, ``, ErrSyntax},
+		{`{ iso 3 }`, ``, ErrInvalidNumberForm},
+		{`{ iso(1) (3) }`, ``, ErrSyntax},
+		{`{ Iso(1) 3 }`, ``, ErrInvalidIdentifier},
+		{`{ 3 1 }`, ``, ErrInvalidRoot},
+	} {
+		toks, err := Tokenize(tc.in)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.in, err)
+		}
+
+		o, err := NewFromTokens(toks)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if err == nil && o.String() != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, o, tc.want)
+		}
+	}
+}
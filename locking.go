@@ -0,0 +1,74 @@
+package oid
+
+/*
+locking.go deals with the synchronization of ObjectIdentifier mutators and readers.
+*/
+
+/*
+rlock acquires the read lock of the receiver. Instances lacking a lock, such as those owned by a *Parser, are not shared and are left unsynchronized.
+*/
+func (o *ObjectIdentifier) rlock() {
+	if o.mu != nil {
+		o.mu.RLock()
+	}
+}
+
+/*
+runlock releases the read lock acquired by rlock.
+*/
+func (o *ObjectIdentifier) runlock() {
+	if o.mu != nil {
+		o.mu.RUnlock()
+	}
+}
+
+/*
+lock acquires the write lock of the receiver. The lock is not reentrant, thus methods holding it must only call unexported helpers which do not themselves lock.
+*/
+func (o *ObjectIdentifier) lock() {
+	if o.mu != nil {
+		o.mu.Lock()
+	}
+}
+
+/*
+unlock releases the write lock acquired by lock.
+*/
+func (o *ObjectIdentifier) unlock() {
+	if o.mu != nil {
+		o.mu.Unlock()
+	}
+}
+
+/*
+arcs returns the arcs of the receiver as of the time of the call. Mutators of a shared instance replace its arcs wholesale, by way of copyArcs, rather than modifying them in place, thus the result may be read without holding the lock.
+*/
+func (o *ObjectIdentifier) arcs() (a []NameAndNumberForm) {
+	if o.IsZero() {
+		return
+	}
+
+	o.rlock()
+	a = o.nANF
+	o.runlock()
+
+	return
+}
+
+/*
+copyArcs returns a copy of the arcs of the receiver, which a mutator holding the write lock may modify before assigning it to the receiver. See arcs.
+*/
+func (o *ObjectIdentifier) copyArcs() []NameAndNumberForm {
+	return append(make([]NameAndNumberForm, 0, len(o.nANF)), o.nANF...)
+}
+
+/*
+renderCache returns the current render cache of the receiver, which may be replaced by a concurrent mutator.
+*/
+func (o *ObjectIdentifier) renderCache() (c *renderCache) {
+	o.rlock()
+	c = o.cache
+	o.runlock()
+
+	return
+}
@@ -0,0 +1,150 @@
+package oid
+
+import (
+	"sync"
+	"testing"
+)
+
+/*
+TestInferNamesConcurrentReaders is meaningful under the race detector (go test -race), which reports any unsynchronized access to the arcs of o.
+*/
+func TestInferNamesConcurrentReaders(t *testing.T) {
+	for n := 0; n < 50; n++ {
+		o, err := NewFromDot(`1.3.6.1.4.1.56521.1`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if der := o.AppendDER(nil, true); len(der) == 0 {
+					t.Errorf("AppendDER yielded no octets")
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if iri := o.IRI(); iri != `/1/3/6/1/4/1/56521/1` {
+					t.Errorf("IRI: got %s", iri)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if nanf := o.NameAndNumberForm(); nanf.Decimal() != 1 {
+					t.Errorf("NameAndNumberForm: got %s", nanf)
+				}
+			}
+		}()
+
+		if err = o.InferNames(); err != nil {
+			t.Error(err)
+		}
+		wg.Wait()
+
+		if id := o.Arc(0).Identifier(); id != `iso` {
+			t.Errorf("InferNames: root arc identifier %q, want iso", id)
+		}
+	}
+}
+
+/*
+TestSetArcAnnotationConcurrentReaders is meaningful under the race detector, as with TestInferNamesConcurrentReaders.
+*/
+func TestSetArcAnnotationConcurrentReaders(t *testing.T) {
+	o, err := NewFromDot(`1.3.6.1.4.1.56521.1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := o.AppendDER(nil, true)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			if der := o.AppendDER(nil, true); string(der) != string(want) {
+				t.Errorf("AppendDER: got %x, want %x", der, want)
+			}
+			_ = o.IRI()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 200; i++ {
+			_ = o.Arc(6).Annotation()
+			_ = o.NameAndNumberForm()
+		}
+	}()
+
+	for i := 0; i < 200; i++ {
+		if err = o.SetArcAnnotation(6, `assigned to Platform team`); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+
+	if text := o.Arc(6).Annotation(); text != `assigned to Platform team` {
+		t.Errorf("Annotation: got %q", text)
+	}
+}
+
+/*
+TestMutatorsConcurrentComparisons exercises the readers which compare the arcs of two instances, such as those used for sorting and clustering, while each mutator of a shared instance runs. As with TestInferNamesConcurrentReaders, it is meaningful under the race detector.
+*/
+func TestMutatorsConcurrentComparisons(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		mutate func(*ObjectIdentifier) error
+	}{
+		{`SetArcAnnotation`, func(o *ObjectIdentifier) error { return o.SetArcAnnotation(6, `assigned to Platform team`) }},
+		{`InferNames`, func(o *ObjectIdentifier) error { return o.InferNames() }},
+		{`SetName`, func(o *ObjectIdentifier) error { return o.SetName(`example`) }},
+		{`SetAltNames`, func(o *ObjectIdentifier) error { return o.SetAltNames(`exampleAlt`) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := NewFromDot(`1.3.6.1.4.1.56521.1`)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sib, _ := NewFromDot(`1.3.6.1.4.1.56521.2`)
+			m := ObjectIdentifierMap{`a`: o, `b`: sib}
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					if !o.Equal(`1.3.6.1.4.1.56521.1`) {
+						t.Errorf("Equal: mismatch")
+					}
+					if keys := m.SortedKeys(); len(keys) != 2 || keys[0] != `a` {
+						t.Errorf("SortedKeys: got %v", keys)
+					}
+					if !o.IsUnderEnterprise() {
+						t.Errorf("IsUnderEnterprise: got false")
+					}
+					if n := CommonPrefixLen(o, sib); n != 7 {
+						t.Errorf("CommonPrefixLen: got %d, want 7", n)
+					}
+					if c := m.Cluster([]*ObjectIdentifier{sib, o}); len(c) != 1 {
+						t.Errorf("Cluster: got %d clusters, want 1", len(c))
+					}
+				}
+			}()
+
+			for i := 0; i < 100; i++ {
+				if err = tc.mutate(o); err != nil {
+					t.Error(err)
+					break
+				}
+			}
+			wg.Wait()
+		})
+	}
+}
@@ -7,24 +7,37 @@ import (
 	"strings"
 )
 
+/*
+maxInt is the largest value of a uint that can be converted to an int without loss.
+*/
+const maxInt = uint(^uint(0) >> 1)
+
 var (
 	sprintf func(string, ...any) string = fmt.Sprintf
 
 	atoi func(string) (int, error) = strconv.Atoi
 	itoa func(int) string          = strconv.Itoa
 
-	contains   func(string, string) bool          = strings.Contains
-	eq         func(string, string) bool          = strings.EqualFold
-	fields     func(string) []string              = strings.Fields
-	hasPrefix  func(string, string) bool          = strings.HasPrefix
-	hasSuffix  func(string, string) bool          = strings.HasSuffix
-	indexRune  func(string, rune) int             = strings.IndexRune
-	join       func([]string, string) string      = strings.Join
-	split      func(string, string) []string      = strings.Split
-	splitAfter func(string, string) []string      = strings.SplitAfter
-	splitN     func(string, string, int) []string = strings.SplitN
-	trimL      func(string, string) string        = strings.TrimLeft
-	trimR      func(string, string) string        = strings.TrimRight
+	appendUint func([]byte, uint64, int) []byte       = strconv.AppendUint
+	fmtUint    func(uint64, int) string               = strconv.FormatUint
+	parseUint  func(string, int, int) (uint64, error) = strconv.ParseUint
+
+	contains   func(string, string) bool           = strings.Contains
+	eq         func(string, string) bool           = strings.EqualFold
+	fields     func(string) []string               = strings.Fields
+	hasPrefix  func(string, string) bool           = strings.HasPrefix
+	hasSuffix  func(string, string) bool           = strings.HasSuffix
+	index      func(string, string) int            = strings.Index
+	indexRune  func(string, rune) int              = strings.IndexRune
+	lastIndex  func(string, string) int            = strings.LastIndex
+	replaceAll func(string, string, string) string = strings.ReplaceAll
+	join       func([]string, string) string       = strings.Join
+	split      func(string, string) []string       = strings.Split
+	splitAfter func(string, string) []string       = strings.SplitAfter
+	splitN     func(string, string, int) []string  = strings.SplitN
+	trimL      func(string, string) string         = strings.TrimLeft
+	trimR      func(string, string) string         = strings.TrimRight
+	trimS      func(string) string                 = strings.TrimSpace
 )
 
 func errorf(msg any, x ...any) error {
@@ -100,3 +113,55 @@ func strSliceEqual(s1, s2 []string) (equal bool) {
 	equal = true
 	return
 }
+
+/*
+count the occurrences of byte 'b' within 'val'.
+*/
+func countByte(val string, b byte) (c int) {
+	for i := 0; i < len(val); i++ {
+		if val[i] == b {
+			c++
+		}
+	}
+
+	return
+}
+
+/*
+is 'ch' an ASCII whitespace character?
+*/
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' || ch == '\v' || ch == '\f'
+}
+
+/*
+is 'ch' an ASCII letter?
+*/
+func isAlpha(ch byte) bool {
+	return ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z')
+}
+
+/*
+is 'val' a descriptor, as defined by the "keystring" production of RFC 4512 section 1.4?
+*/
+func isDescr(val string) bool {
+	if len(val) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(val); i++ {
+		ch := val[i]
+		switch {
+		case ('a' <= ch && ch <= 'z') || ('A' <= ch && ch <= 'Z'):
+			// leadkeychar or keychar
+		case ('0' <= ch && ch <= '9') || ch == '-':
+			if i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,69 @@
+package oid
+
+/*
+name.go deals with the principal name of an ObjectIdentifier, i.e.: the "official" descriptor by which it is known, as distinct from any alt names.
+*/
+
+/*
+SetName assigns name as the principal name of the receiver. Should name also be present among the alt names of the receiver, it is removed from them. A zero string clears the principal name.
+
+The principal name of `id-kp-serverAuth(1)` (1.3.6.1.5.5.7.3.1), for instance, is 'id-kp-serverAuth', while 'serverAuth' is an alt name.
+*/
+func (o *ObjectIdentifier) SetName(name string) (err error) {
+	if o.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", o)
+		return
+	} else if o.descrNames && len(name) > 0 && !isDescr(name) {
+		err = errorw(ErrInvalidIdentifier, "Bad name '%s' [hint: must be a descriptor]", name)
+		return
+	}
+
+	o.lock()
+	defer o.unlock()
+
+	o.removeAltName(name)
+	o.name = name
+	o.invalidate()
+
+	return
+}
+
+/*
+Name returns the principal name of the receiver, or a zero string if unset.
+*/
+func (o *ObjectIdentifier) Name() string {
+	if o.IsZero() {
+		return ``
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	return o.name
+}
+
+/*
+Key returns the principal name of the receiver or, if unset, its dotNotation. This is the key used when the receiver is added to an ObjectIdentifierMap using its Add method.
+*/
+func (o *ObjectIdentifier) Key() string {
+	if name := o.Name(); len(name) > 0 {
+		return name
+	}
+	return o.DotNotation()
+}
+
+/*
+Add assigns x to the receiver, keyed by the principal name of x or, if unset, its dotNotation.
+*/
+func (o ObjectIdentifierMap) Add(x *ObjectIdentifier) (err error) {
+	if x.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", x)
+		return
+	} else if o == nil {
+		err = errorw(ErrNilInstance, "%T is nil", o)
+		return
+	}
+
+	o.Set(x.Key(), x)
+	return
+}
@@ -0,0 +1,64 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSetName(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		set  string
+		key  string
+		str  string
+		alts int
+		err  error
+	}{
+		{`named`, nil, `id-kp-serverAuth`, `id-kp-serverAuth`,
+			`{ 1 3 6 1 5 5 7 3 id-kp-serverAuth(1) }`, 1, nil},
+		{`alt promoted`, nil, `serverAuth`, `serverAuth`,
+			`{ 1 3 6 1 5 5 7 3 serverAuth(1) }`, 0, nil},
+		{`cleared`, nil, ``, `1.3.6.1.5.5.7.3.1`,
+			`{ 1 3 6 1 5 5 7 3 1 }`, 1, nil},
+		{`descriptor`, []Option{WithDescriptorAltNames()}, `server auth`, `1.3.6.1.5.5.7.3.1`,
+			`{ 1 3 6 1 5 5 7 3 1 }`, 1, ErrInvalidIdentifier},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := NewObjectIdentifier(`{ 1 3 6 1 5 5 7 3 1 }`, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			o.SetAltNames(`serverAuth`)
+
+			if err = o.SetName(tc.set); !errors.Is(err, tc.err) {
+				t.Fatalf("SetName: got error %v, want %v", err, tc.err)
+			} else if err == nil && o.Name() != tc.set {
+				t.Errorf("Name: got %q, want %q", o.Name(), tc.set)
+			}
+
+			if k := o.Key(); k != tc.key {
+				t.Errorf("Key: got %q, want %q", k, tc.key)
+			}
+			if s := o.String(); s != tc.str {
+				t.Errorf("String: got %q, want %q", s, tc.str)
+			}
+			if n := o.AltNamesLen(); n != tc.alts {
+				t.Errorf("AltNamesLen: got %d, want %d", n, tc.alts)
+			}
+
+			m := make(ObjectIdentifierMap)
+			if err = m.Add(o); err != nil {
+				t.Fatal(err)
+			} else if _, found := m[tc.key]; !found {
+				t.Errorf("Add: no entry for key %q", tc.key)
+			}
+
+			b, _ := o.MarshalJSON()
+			if want := `"name":"` + o.Name() + `"`; len(o.Name()) > 0 && !strings.Contains(string(b), want) {
+				t.Errorf("MarshalJSON: %s lacks %s", b, want)
+			}
+		})
+	}
+}
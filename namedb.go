@@ -0,0 +1,501 @@
+package oid
+
+/*
+namedb.go deals with NameDB, a compact read-only binary database of OID names suited to memory-mapping.
+*/
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"os"
+	"sort"
+)
+
+/*
+nameDBMagic identifies a NameDB file, the final octet of which is its format version.
+*/
+var nameDBMagic = [8]byte{'O', 'I', 'D', 'N', 'M', 'D', 'B', 1}
+
+const nameDBHeaderLen = 16
+
+/*
+NameDB is a read-only database of OID names, held in a compact binary format that is searched in place rather than deserialized, such that very large databases, e.g. full MIB and Private Enterprise Number dumps, may be consulted by lookup-heavy daemons with little resident memory. See WriteNameDB and OpenNameDB.
+
+The format, all integers of which are big-endian, is as follows:
+
+	offset        size  field
+	0             8     magic "OIDNMDB" followed by the version (1)
+	8             4     number of records (n)
+	12            4     number of names (m)
+	16            4n    record offsets, ordered by key
+	16+4n         8m    name offset and record offset pairs, ordered by case-folded name
+	16+4n+8m      -     records
+
+Each record consists of a uvarint-prefixed key, being the base-128 encoding of each arc of the OID in turn, followed by a uvarint name count and each uvarint-prefixed name, the first of which is the principal name. Offsets are relative to the start of the file, which limits a NameDB to 4GiB.
+
+A NameDB satisfies both the Getter and Resolver interfaces. Each *ObjectIdentifier it returns is assembled upon request, and is owned by the caller. Lookups are safe for concurrent use, but must not overlap with Close.
+*/
+type NameDB struct {
+	data  []byte
+	n, m  int
+	unmap func() error
+}
+
+/*
+WriteNameDB writes the OIDs of the receiver to w in the binary format read by NameDB, alongside an error. The names of each OID are its principal name, its alt names and the identifier of its final arc, in that order; OIDs bearing no names are omitted, and the names of an OID present under several keys are combined. Names are compared in a case-folded manner. The contents of a Registry may be written by way of its Map method.
+*/
+func (o ObjectIdentifierMap) WriteNameDB(w io.Writer) (err error) {
+	type record struct {
+		key   []byte
+		names []string
+	}
+
+	var recs []*record
+	byKey := make(map[string]*record)
+	keys := o.SortedKeys()
+	for i := 0; i < len(keys); i++ {
+		v := o[keys[i]]
+		if len(v.arcs()) == 0 {
+			continue
+		}
+
+		key := v.nameDBKey(nil)
+		rec, found := byKey[string(key)]
+		if !found {
+			rec = &record{key: key}
+		}
+
+		names := append([]string{v.Name()}, v.AltNames()...)
+		names = append(names, v.NameAndNumberForm().Identifier())
+		for k := 0; k < len(names); k++ {
+			if len(names[k]) > 0 && !strInSlice(names[k], rec.names) {
+				rec.names = append(rec.names, names[k])
+			}
+		}
+
+		if !found && len(rec.names) > 0 {
+			byKey[string(key)] = rec
+			recs = append(recs, rec)
+		}
+	}
+
+	sort.Slice(recs, func(i, j int) bool {
+		return bytes.Compare(recs[i].key, recs[j].key) < 0
+	})
+
+	type nameRef struct {
+		name         string
+		nameOff, off uint32
+	}
+
+	var refs []nameRef
+	for i := 0; i < len(recs); i++ {
+		refs = append(refs, make([]nameRef, len(recs[i].names))...)
+	}
+
+	base := nameDBHeaderLen + 4*len(recs) + 8*len(refs)
+	data := make([]byte, 0, base)
+	data = append(data, nameDBMagic[:]...)
+	data = binary.BigEndian.AppendUint32(data, uint32(len(recs)))
+	data = binary.BigEndian.AppendUint32(data, uint32(len(refs)))
+	data = data[:base]
+
+	var r int
+	for i := 0; i < len(recs); i++ {
+		off := len(data)
+		binary.BigEndian.PutUint32(data[nameDBHeaderLen+4*i:], uint32(off))
+		data = binary.AppendUvarint(data, uint64(len(recs[i].key)))
+		data = append(data, recs[i].key...)
+		data = binary.AppendUvarint(data, uint64(len(recs[i].names)))
+		for k := 0; k < len(recs[i].names); k++ {
+			refs[r] = nameRef{recs[i].names[k], uint32(len(data)), uint32(off)}
+			data = binary.AppendUvarint(data, uint64(len(recs[i].names[k])))
+			data = append(data, recs[i].names[k]...)
+			r++
+		}
+
+		if uint64(len(data)) > uint64(^uint32(0)) {
+			err = errorw(ErrLimitExceeded, "%T exceeds 4GiB", NameDB{})
+			return
+		}
+	}
+
+	sort.SliceStable(refs, func(i, j int) bool {
+		return foldCompare([]byte(refs[i].name), refs[j].name) < 0
+	})
+
+	for i := 0; i < len(refs); i++ {
+		at := nameDBHeaderLen + 4*len(recs) + 8*i
+		binary.BigEndian.PutUint32(data[at:], refs[i].nameOff)
+		binary.BigEndian.PutUint32(data[at+4:], refs[i].off)
+	}
+
+	_, err = w.Write(data)
+
+	return
+}
+
+/*
+nameDBKey appends the key of the receiver within a NameDB to dst, being the base-128 encoding of each of its arcs in turn.
+*/
+func (o *ObjectIdentifier) nameDBKey(dst []byte) []byte {
+	o.rlock()
+	defer o.runlock()
+
+	for i := 0; i < len(o.nANF); i++ {
+		if o.nANF[i].huge != nil {
+			dst = appendBase128Big(dst, o.nANF[i].huge)
+		} else {
+			dst = appendBase128(dst, uint64(o.nANF[i].primaryIdentifier))
+		}
+	}
+
+	return dst
+}
+
+/*
+OpenNameDB opens the NameDB file at path, alongside an error. The file is memory-mapped on platforms which support it, and read into memory otherwise. The returned instance must be closed using its Close method once no longer needed.
+*/
+func OpenNameDB(path string) (d *NameDB, err error) {
+	var f *os.File
+	if f, err = os.Open(path); err != nil {
+		return
+	}
+	defer f.Close()
+
+	var fi os.FileInfo
+	if fi, err = f.Stat(); err != nil {
+		return
+	}
+
+	var data []byte
+	var unmap func() error
+	if data, unmap, err = mapFile(f, fi.Size()); err != nil {
+		return
+	}
+
+	if d, err = NewNameDB(data); err != nil {
+		if unmap != nil {
+			unmap()
+		}
+		return
+	}
+	d.unmap = unmap
+
+	return
+}
+
+/*
+NewNameDB returns an instance of *NameDB reading b, which must bear the binary format written by WriteNameDB, alongside an error. The header and offset tables of b are verified, while records are verified as they are read. b is used in place, and must not be modified while the return value is in use.
+*/
+func NewNameDB(b []byte) (d *NameDB, err error) {
+	if len(b) < nameDBHeaderLen || !bytes.Equal(b[:8], nameDBMagic[:]) {
+		err = errorw(ErrMalformedEncoding, "Data is not a %T of version %d", d, nameDBMagic[7])
+		return
+	}
+
+	n := uint64(binary.BigEndian.Uint32(b[8:]))
+	m := uint64(binary.BigEndian.Uint32(b[12:]))
+	if nameDBHeaderLen+4*n+8*m > uint64(len(b)) {
+		err = errorw(ErrMalformedEncoding, "Truncated %T: %d records and %d names exceed %d octets", d, n, m, len(b))
+		return
+	}
+
+	d = &NameDB{data: b, n: int(n), m: int(m)}
+	return
+}
+
+/*
+Close releases the memory mapping of the receiver, if any. The receiver must not be used afterwards.
+*/
+func (d *NameDB) Close() (err error) {
+	if d == nil {
+		return
+	}
+
+	if d.unmap != nil {
+		err = d.unmap()
+		d.unmap = nil
+	}
+	d.data, d.n, d.m = nil, 0, 0
+
+	return
+}
+
+/*
+Len returns the number of OIDs within the receiver.
+*/
+func (d *NameDB) Len() int {
+	if d == nil {
+		return 0
+	}
+	return d.n
+}
+
+/*
+Names returns the names of o within the receiver, the first of which is its principal name, alongside a Boolean value indicative of success.
+*/
+func (d *NameDB) Names(o *ObjectIdentifier) (names []string, found bool) {
+	if d == nil || o.IsZero() {
+		return
+	}
+
+	if off, ok := d.findOID(o); ok {
+		_, rest, _ := d.record(off)
+		names, found = d.names(rest)
+	}
+
+	return
+}
+
+/*
+Get returns the *ObjectIdentifier within the receiver matching term alongside a Boolean value indicative of success. term may be an *ObjectIdentifier, a dotNotation string or a name, the latter of which is matched in a case-folded manner. This method satisfies the Getter interface.
+*/
+func (d *NameDB) Get(term any) (o *ObjectIdentifier, found bool) {
+	if d == nil {
+		return
+	}
+
+	var off uint32
+	switch tv := term.(type) {
+	case *ObjectIdentifier:
+		off, found = d.findOID(tv)
+	case string:
+		if isDotNotation(tv) {
+			x, err := NewFromDot(tv)
+			if err != nil {
+				return
+			}
+			off, found = d.findOID(x)
+		} else {
+			off, found = d.findName(tv)
+		}
+	}
+
+	if found {
+		o, found = d.assemble(off)
+	}
+
+	return
+}
+
+/*
+Resolve returns the *ObjectIdentifier within the receiver matching the dotNotation value dot, alongside an error wrapping ErrNotFound should there be none. This method satisfies the Resolver interface.
+*/
+func (d *NameDB) Resolve(ctx context.Context, dot string) (o *ObjectIdentifier, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	var x *ObjectIdentifier
+	if x, err = NewFromDot(dot); err != nil {
+		return
+	}
+
+	var found bool
+	if o, found = d.Get(x); !found {
+		err = errorw(ErrNotFound, "No %T registered for '%s'", o, dot)
+	}
+
+	return
+}
+
+/*
+findOID returns the offset of the record of o alongside a Boolean value indicative of success.
+*/
+func (d *NameDB) findOID(o *ObjectIdentifier) (off uint32, found bool) {
+	if o.IsZero() {
+		return
+	}
+
+	key := o.nameDBKey(nil)
+	i := sort.Search(d.n, func(i int) bool {
+		k, _, _ := d.record(d.recordOffset(i))
+		return bytes.Compare(k, key) >= 0
+	})
+
+	if i < d.n {
+		off = d.recordOffset(i)
+		k, _, ok := d.record(off)
+		found = ok && bytes.Equal(k, key)
+	}
+
+	return
+}
+
+/*
+findName returns the offset of the record bearing name, compared in a case-folded manner, alongside a Boolean value indicative of success. Should several records bear name, that with the lowest key is returned.
+*/
+func (d *NameDB) findName(name string) (off uint32, found bool) {
+	i := sort.Search(d.m, func(i int) bool {
+		n, _ := d.nameAt(i)
+		return foldCompare(n, name) >= 0
+	})
+
+	if i < d.m {
+		var n []byte
+		n, off = d.nameAt(i)
+		found = n != nil && foldCompare(n, name) == 0
+	}
+
+	return
+}
+
+/*
+recordOffset returns the offset of the record at index i of the record table.
+*/
+func (d *NameDB) recordOffset(i int) uint32 {
+	return binary.BigEndian.Uint32(d.data[nameDBHeaderLen+4*i:])
+}
+
+/*
+nameAt returns the name at index i of the name table and the offset of the record bearing it. A nil name is returned should the receiver be corrupt.
+*/
+func (d *NameDB) nameAt(i int) (name []byte, off uint32) {
+	at := nameDBHeaderLen + 4*d.n + 8*i
+	name, _, _ = d.field(uint64(binary.BigEndian.Uint32(d.data[at:])))
+	off = binary.BigEndian.Uint32(d.data[at+4:])
+
+	return
+}
+
+/*
+field returns the uvarint-prefixed field at offset off, alongside the offset following it and a Boolean value indicative of success.
+*/
+func (d *NameDB) field(off uint64) (f []byte, next uint64, ok bool) {
+	if off >= uint64(len(d.data)) {
+		return
+	}
+
+	l, w := binary.Uvarint(d.data[off:])
+	if w <= 0 || l > uint64(len(d.data))-off-uint64(w) {
+		return
+	}
+
+	next = off + uint64(w) + l
+	f, ok = d.data[off+uint64(w):next], true
+
+	return
+}
+
+/*
+record returns the key of the record at offset off, alongside the offset of its name count and a Boolean value indicative of success.
+*/
+func (d *NameDB) record(off uint32) (key []byte, rest uint64, ok bool) {
+	return d.field(uint64(off))
+}
+
+/*
+names returns the names of the record whose name count resides at offset off, alongside a Boolean value indicative of success.
+*/
+func (d *NameDB) names(off uint64) (names []string, ok bool) {
+	if off >= uint64(len(d.data)) {
+		return
+	}
+
+	c, w := binary.Uvarint(d.data[off:])
+	if w <= 0 || c > uint64(len(d.data)) {
+		return
+	}
+
+	names = make([]string, 0, c)
+	off += uint64(w)
+	for i := uint64(0); i < c; i++ {
+		var name []byte
+		if name, off, ok = d.field(off); !ok {
+			return nil, false
+		}
+		names = append(names, string(name))
+	}
+
+	return names, true
+}
+
+/*
+assemble returns a new *ObjectIdentifier bearing the OID and names of the record at offset off, alongside a Boolean value indicative of success.
+*/
+func (d *NameDB) assemble(off uint32) (o *ObjectIdentifier, ok bool) {
+	key, rest, found := d.record(off)
+	if !found || len(key) == 0 || key[len(key)-1]&0x80 != 0 {
+		return
+	}
+
+	var names []string
+	if names, ok = d.names(rest); !ok {
+		return
+	}
+
+	t := newObjectIdentifier(len(key))
+	for i := 0; i < len(key); {
+		j := i
+		for key[j]&0x80 != 0 {
+			j++
+		}
+
+		var arc NameAndNumberForm
+		if j-i < 9 {
+			var v uint64
+			for k := i; k <= j; k++ {
+				v = v<<7 | uint64(key[k]&0x7F)
+			}
+			arc.setBig(new(big.Int).SetUint64(v))
+		} else {
+			v := new(big.Int)
+			for k := i; k <= j; k++ {
+				v.Lsh(v, 7)
+				v.Or(v, big.NewInt(int64(key[k]&0x7F)))
+			}
+			arc.setBig(v)
+		}
+		t.nANF = append(t.nANF, arc)
+		i = j + 1
+	}
+
+	if !t.valid() {
+		return nil, false
+	}
+
+	if len(names) > 0 {
+		t.name = names[0]
+		t.setAltNames(names[1:]...)
+	}
+
+	return t, true
+}
+
+/*
+foldCompare compares a and b in an ASCII case-folded manner, returning -1, 0 or +1.
+*/
+func foldCompare(a []byte, b string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		x, y := foldByte(a[i]), foldByte(b[i])
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+
+	return 0
+}
+
+/*
+foldByte returns the lowercase form of the ASCII letter c, or c itself if it is not an uppercase letter.
+*/
+func foldByte(c byte) byte {
+	if 'A' <= c && c <= 'Z' {
+		return c + 'a' - 'A'
+	}
+	return c
+}
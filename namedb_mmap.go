@@ -0,0 +1,32 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package oid
+
+/*
+namedb_mmap.go deals with the memory-mapping of NameDB files on platforms which support it.
+*/
+
+import (
+	"os"
+	"syscall"
+)
+
+/*
+mapFile maps the first size octets of f into memory read-only, returning the mapping alongside the function which releases it and an error.
+*/
+func mapFile(f *os.File, size int64) (data []byte, unmap func() error, err error) {
+	if size <= 0 || int64(int(size)) != size {
+		err = errorw(ErrLimitExceeded, "Cannot map %s of %d octets", f.Name(), size)
+		return
+	}
+
+	if data, err = syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED); err != nil {
+		return
+	}
+
+	unmap = func() error {
+		return syscall.Munmap(data)
+	}
+
+	return
+}
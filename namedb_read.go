@@ -0,0 +1,22 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package oid
+
+/*
+namedb_read.go deals with the loading of NameDB files on platforms which do not support memory-mapping, where the file is read into memory instead.
+*/
+
+import (
+	"io"
+	"os"
+)
+
+/*
+mapFile reads the first size octets of f into memory, returning them alongside a nil release function and an error.
+*/
+func mapFile(f *os.File, size int64) (data []byte, unmap func() error, err error) {
+	data = make([]byte, size)
+	_, err = io.ReadFull(f, data)
+
+	return
+}
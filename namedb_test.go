@@ -0,0 +1,136 @@
+package oid
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+/*
+newTestNameDB returns the bytes of a NameDB bearing a handful of named OIDs, one of which is present under two keys, and one of which is unnamed.
+*/
+func newTestNameDB(t *testing.T) []byte {
+	t.Helper()
+
+	cn, err := NewObjectIdentifier(`{ joint-iso-itu-t(2) ds(5) attributeType(4) cn(3) }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cn.SetName(`commonName`)
+	cn.SetAltNames(`label`)
+
+	ex := mustDot(t, `1.3.6.1.4.1.56521`)
+	ex.SetName(`example`)
+
+	m := ObjectIdentifierMap{
+		`commonName`: cn,
+		`2.5.4.3`:    cn,
+		`example`:    ex,
+		`1.3.6`:      mustDot(t, `1.3.6`),
+	}
+
+	var buf bytes.Buffer
+	if err = m.WriteNameDB(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestNameDBGet(t *testing.T) {
+	d, err := NewNameDB(newTestNameDB(t))
+	if err != nil {
+		t.Fatal(err)
+	} else if n := d.Len(); n != 2 {
+		t.Errorf("Len: got %d, want 2", n)
+	}
+
+	for _, tc := range []struct {
+		term  any
+		dot   string
+		names []string
+	}{
+		{`commonName`, `2.5.4.3`, []string{`commonName`, `label`, `cn`}},
+		{`COMMONNAME`, `2.5.4.3`, []string{`commonName`, `label`, `cn`}},
+		{`cn`, `2.5.4.3`, []string{`commonName`, `label`, `cn`}},
+		{`2.5.4.3`, `2.5.4.3`, []string{`commonName`, `label`, `cn`}},
+		{mustDot(t, `1.3.6.1.4.1.56521`), `1.3.6.1.4.1.56521`, []string{`example`}},
+		{`1.3.6`, ``, nil},
+		{`2.5.4`, ``, nil},
+		{`unknown`, ``, nil},
+		{3, ``, nil},
+	} {
+		o, found := d.Get(tc.term)
+		if found != (len(tc.dot) > 0) {
+			t.Errorf("%v: got found %t", tc.term, found)
+			continue
+		} else if !found {
+			continue
+		}
+
+		if dot := o.DotNotation(); dot != tc.dot {
+			t.Errorf("%v: got %s, want %s", tc.term, dot, tc.dot)
+		}
+		names, _ := d.Names(o)
+		if len(names) != len(tc.names) {
+			t.Errorf("%v: got names %v, want %v", tc.term, names, tc.names)
+			continue
+		}
+		for i := 0; i < len(names); i++ {
+			if names[i] != tc.names[i] {
+				t.Errorf("%v: got names %v, want %v", tc.term, names, tc.names)
+			}
+		}
+	}
+
+	if _, err = d.Resolve(context.Background(), `2.5.4.3`); err != nil {
+		t.Errorf("Resolve: %v", err)
+	} else if _, err = d.Resolve(context.Background(), `2.5.4.4`); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Resolve: got error %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestNewNameDBMalformed(t *testing.T) {
+	good := newTestNameDB(t)
+	for _, tc := range []struct {
+		name string
+		b    []byte
+	}{
+		{`empty`, nil},
+		{`short header`, good[:nameDBHeaderLen-1]},
+		{`bad magic`, append([]byte(`XXXXXXXX`), good[8:]...)},
+		{`bad version`, append(append(append([]byte(nil), good[:7]...), 9), good[8:]...)},
+		{`truncated tables`, good[:nameDBHeaderLen+4]},
+	} {
+		if _, err := NewNameDB(tc.b); !errors.Is(err, ErrMalformedEncoding) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, ErrMalformedEncoding)
+		}
+	}
+}
+
+func TestOpenNameDB(t *testing.T) {
+	path := filepath.Join(t.TempDir(), `names.db`)
+	if err := os.WriteFile(path, newTestNameDB(t), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := OpenNameDB(path)
+	if err != nil {
+		t.Fatal(err)
+	} else if o, found := d.Get(`example`); !found || o.DotNotation() != `1.3.6.1.4.1.56521` {
+		t.Errorf("Get: got %v, %t", o, found)
+	}
+
+	if err = d.Close(); err != nil {
+		t.Error(err)
+	} else if d.Len() != 0 {
+		t.Errorf("Len: got %d following Close", d.Len())
+	}
+
+	if _, err = OpenNameDB(filepath.Join(t.TempDir(), `missing.db`)); err == nil {
+		t.Errorf("OpenNameDB: no error for a missing file")
+	}
+}
@@ -0,0 +1,249 @@
+package oid
+
+/*
+nameddot.go deals with the named-dotted notation of OIDs, i.e. the MIB name paths used by SNMP tooling, e.g. iso.org.dod.internet.private.enterprises.
+*/
+
+/*
+mibPathNames contains the names by which SNMP tooling, such as net-snmp, knows certain arcs, where these differ from their ASN.1 identifiers.
+*/
+var mibPathNames = map[string]string{
+	`1.3`:         `org`,
+	`1.3.6.1.4.1`: `enterprises`,
+}
+
+/*
+NamedDotNotation returns the named-dotted form of the receiver, as used within the MIB name paths of SNMP tooling, e.g.:
+
+	iso.org.dod.internet.private.enterprises.56521
+
+Each arc is represented by the name by which SNMP tooling knows it, where this differs from its ASN.1 identifier (org and enterprises), or else its identifier. The root and second arcs otherwise bear their standard identifiers. Any other arc lacking an identifier is looked up using each of g in turn, e.g. a *Registry, and is represented by its number form should none know it. If g is not provided, the golden corpus (see GoldenCorpus) and the WellKnown database are used.
+
+A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) NamedDotNotation(g ...Getter) string {
+	if o.IsZero() {
+		return ``
+	} else if len(g) == 0 {
+		g = []Getter{corpusMap(), WellKnown()}
+	}
+
+	arcs := o.arcs()
+	standard := arcs[0].huge == nil && arcs[0].primaryIdentifier < 3
+
+	dst := make([]byte, 0, 64)
+	dot := make([]byte, 0, 64)
+	for i := 0; i < len(arcs); i++ {
+		if i > 0 {
+			dst = append(dst, '.')
+			dot = append(dot, '.')
+		}
+		dot = arcs[i].appendNumber(dot)
+
+		var names []string
+		if standard {
+			names = standardArcNames(arcs, i)
+		}
+
+		if name, found := mibPathNames[string(dot)]; found {
+			dst = append(dst, name...)
+		} else if id := arcs[i].identifier; len(id) > 0 {
+			dst = append(dst, id...)
+		} else if len(names) > 0 {
+			dst = append(dst, names[0]...)
+		} else if id = namedArc(g, string(dot)); len(id) > 0 {
+			dst = append(dst, id...)
+		} else {
+			dst = arcs[i].appendNumber(dst)
+		}
+	}
+
+	return string(dst)
+}
+
+/*
+namedArc returns the name of the OID known to any of g by the dotNotation value dot, or a zero string if none.
+*/
+func namedArc(g []Getter, dot string) string {
+	for i := 0; i < len(g); i++ {
+		if g[i] == nil {
+			continue
+		} else if x, found := g[i].Get(dot); found {
+			if id := arcName(*x); len(id) > 0 {
+				return id
+			}
+		}
+	}
+
+	return ``
+}
+
+/*
+ParseNamedDot returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as a named-dotted MIB name path, as emitted by SNMP tooling, e.g.:
+
+	iso.org.dod.internet.private.enterprises.56521.1
+	.iso.3.6.1.4.1.56521.1
+	SNMPv2-SMI::enterprises.56521.1
+
+Each label may be a number form or a name. Names are resolved beneath the arcs preceding them: the root and second arcs are resolved using their standard identifiers, the names used by SNMP tooling are recognized, and all other names are looked up using each of g in turn. If g is not provided, the golden corpus (see GoldenCorpus) and the WellKnown database are used. A single leading dot is ignored.
+
+Should x bear a module prefix, as in the final example above, the module name is ignored and the first label is resolved without regard to its position, thus it must be unambiguous.
+
+Names are retained as the identifiers of their arcs. An error wrapping ErrNotFound is returned should any name be unknown.
+*/
+func ParseNamedDot(x string, g ...Getter) (o *ObjectIdentifier, err error) {
+	if len(g) == 0 {
+		g = []Getter{corpusMap(), WellKnown()}
+	}
+
+	x = trimS(x)
+	var anywhere bool
+	if idx := index(x, `::`); idx != -1 {
+		x, anywhere = x[idx+2:], true
+	} else if len(x) > 1 && x[0] == '.' {
+		x = x[1:]
+	}
+
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No content for ParseNamedDot to read")
+		return
+	}
+
+	labels := split(x, `.`)
+	t := newObjectIdentifier(len(labels))
+	for i := 0; i < len(labels); i++ {
+		label := labels[i]
+		var arc NameAndNumberForm
+		switch {
+		case len(label) == 0:
+			err = errorw(ErrInvalidNumberForm, "Empty label #%d in '%s'", i, x)
+		case isDigit(label):
+			err = arc.setNumberForm(label)
+		case i == 0 && anywhere:
+			var base *ObjectIdentifier
+			if base, err = namedBase(g, label); err == nil {
+				b := base.arcs()
+				t.nANF = append(t.nANF, b[:len(b)-1]...)
+				arc = b[len(b)-1]
+				arc.identifier = label
+			}
+		default:
+			var found bool
+			if arc, found = namedChild(g, t.nANF, label); !found {
+				err = errorw(ErrNotFound, "Name '%s' not found beneath '%s'", label, join(labels[:i], `.`))
+			}
+		}
+
+		if err != nil {
+			return
+		}
+		t.nANF = append(t.nANF, arc)
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+namedBase returns the OID known by name to SNMP tooling or to any of g, regardless of its position.
+*/
+func namedBase(g []Getter, name string) (o *ObjectIdentifier, err error) {
+	for dot, n := range mibPathNames {
+		if eq(n, name) {
+			return NewFromDot(dot)
+		}
+	}
+
+	for i := 0; i < len(g); i++ {
+		if g[i] == nil {
+			continue
+		} else if x, found := g[i].Get(name); found && x.valid() {
+			return x.clone(), nil
+		}
+	}
+
+	err = errorw(ErrNotFound, "Name '%s' not found", name)
+	return
+}
+
+/*
+namedChild returns the arc named name beneath parent, alongside a Boolean value indicative of success. The identifier of the arc is set to name.
+*/
+func namedChild(g []Getter, parent []NameAndNumberForm, name string) (arc NameAndNumberForm, found bool) {
+	arc.identifier = name
+
+	// standard identifiers of the root and second arcs
+	switch len(parent) {
+	case 0:
+		for n := 0; n < len(rootArcNames); n++ {
+			if strInSlice(name, rootArcNames[n]) {
+				arc.primaryIdentifier = uint(n)
+				return arc, true
+			}
+		}
+	case 1:
+		if root := parent[0]; root.huge == nil && root.primaryIdentifier < 3 {
+			for n, names := range secondArcNames[root.primaryIdentifier] {
+				if strInSlice(name, names) {
+					arc.primaryIdentifier = n
+					return arc, true
+				}
+			}
+		}
+	}
+
+	for dot, n := range mibPathNames {
+		if x, _ := NewFromDot(dot); eq(n, name) && x.isChildOf(parent) {
+			return x.arcs()[len(parent)], true
+		}
+	}
+
+	for i := 0; i < len(g); i++ {
+		if x := childOf(g[i], parent, name); x != nil {
+			arc = x.Arc(len(parent))
+			arc.identifier, arc.annotation = name, ``
+			return arc, true
+		}
+	}
+
+	return
+}
+
+/*
+childOf returns the immediate child of parent known to g by name, or nil if none. ObjectIdentifierMap and *Registry instances are searched in their entirety, whereas any other Getter is asked for name, and its answer used only if it resides beneath parent.
+*/
+func childOf(g Getter, parent []NameAndNumberForm, name string) *ObjectIdentifier {
+	var m ObjectIdentifierMap
+	switch tv := g.(type) {
+	case nil:
+		return nil
+	case ObjectIdentifierMap:
+		m = tv
+	case *Registry:
+		m = tv.Map()
+	default:
+		if x, found := g.Get(name); found && x.isChildOf(parent) {
+			return x
+		}
+		return nil
+	}
+
+	for _, v := range m {
+		if !v.IsZero() && v.isChildOf(parent) && v.isSynonym(name) {
+			return v
+		}
+	}
+
+	return nil
+}
+
+/*
+isChildOf returns a Boolean value indicative of whether the receiver is an immediate child of parent, which may bear no arcs.
+*/
+func (o *ObjectIdentifier) isChildOf(parent []NameAndNumberForm) bool {
+	arcs := o.arcs()
+	return len(arcs) == len(parent)+1 && (len(parent) == 0 || arcsUnder(arcs, parent))
+}
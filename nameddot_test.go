@@ -0,0 +1,91 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNamedDotNotation(t *testing.T) {
+	own := namedDotGetter(t)
+
+	for _, tc := range []struct {
+		dot  string
+		g    []Getter
+		want string
+	}{
+		{`1.3.6.1`, nil, `iso.org.dod.internet`},
+		{`1.3.6.1.4.1.56521.1`, nil, `iso.org.dod.internet.private.enterprises.56521.1`},
+		{`1.3.6.1.4.1.56521.1`, []Getter{own}, `iso.org.dod.internet.private.enterprises.example.1`},
+		{`2.5.4.3`, nil, `joint-iso-itu-t.ds.attributeType.commonName`},
+		{`1.3.6.1.4.1.99999.7`, nil, `iso.org.dod.internet.private.enterprises.99999.7`},
+	} {
+		if got := mustDot(t, tc.dot).NamedDotNotation(tc.g...); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.dot, got, tc.want)
+		}
+	}
+
+	var nilOID *ObjectIdentifier
+	if s := nilOID.NamedDotNotation(); s != `` {
+		t.Errorf("nil receiver: got %q", s)
+	}
+}
+
+func TestParseNamedDot(t *testing.T) {
+	own := namedDotGetter(t)
+
+	for _, tc := range []struct {
+		in   string
+		g    []Getter
+		want string
+		err  error
+	}{
+		{`iso.org.dod.internet`, nil, `1.3.6.1`, nil},
+		{`iso.org.dod.internet.private.enterprises.56521.1`, nil, `1.3.6.1.4.1.56521.1`, nil},
+		{`.iso.3.6.1.4.1.56521.1`, nil, `1.3.6.1.4.1.56521.1`, nil},
+		{` iso.org.dod `, nil, `1.3.6`, nil},
+		{`SNMPv2-SMI::enterprises.56521.1`, nil, `1.3.6.1.4.1.56521.1`, nil},
+		{`iso.org.dod.internet.private.enterprises.example.1`, []Getter{own}, `1.3.6.1.4.1.56521.1`, nil},
+		{`iso.org.dod.internet.private.enterprises.example.1`, nil, ``, ErrNotFound},
+		{`iso.bogus`, nil, ``, ErrNotFound},
+		{`iso..dod`, nil, ``, ErrInvalidNumberForm},
+		{``, nil, ``, ErrEmptyInput},
+		{`MIB::`, nil, ``, ErrEmptyInput},
+	} {
+		o, err := ParseNamedDot(tc.in, tc.g...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if d := o.DotNotation(); err == nil && d != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, d, tc.want)
+		}
+	}
+
+	// names are retained, thus the result renders as it was read
+	x := `iso.org.dod.internet.private.enterprises`
+	if o, err := ParseNamedDot(x); err != nil {
+		t.Fatal(err)
+	} else if s := o.NamedDotNotation(); s != x {
+		t.Errorf("got %s, want %s", s, x)
+	}
+}
+
+/*
+namedDotGetter returns a Getter knowing the arcs leading to, and including, the example enterprise arc, in lieu of the golden corpus.
+*/
+func namedDotGetter(t *testing.T) ObjectIdentifierMap {
+	t.Helper()
+	m := make(ObjectIdentifierMap)
+	for name, dot := range map[string]string{
+		`dod`:      `1.3.6`,
+		`internet`: `1.3.6.1`,
+		`private`:  `1.3.6.1.4`,
+		`example`:  `1.3.6.1.4.1.56521`,
+	} {
+		o := mustDot(t, dot)
+		if err := o.SetName(name); err != nil {
+			t.Fatal(err)
+		}
+		m[name] = o
+	}
+
+	return m
+}
@@ -4,103 +4,303 @@ package oid
 nanf.go deals with NameAndNumberForm syntax and viability
 */
 
+import (
+	"math/big"
+	"unicode/utf8"
+)
+
+/*
+NameAndNumberForm contains an optional identifier and a primaryIdentifier. Number forms which cannot be represented by a uint, such as those found beneath the {joint-iso-itu-t uuid(25)} arc, are stored as a *big.Int.
+
+An optional free-text annotation may also be present; see the Annotation method.
+*/
 type NameAndNumberForm struct {
 	identifier        string
 	primaryIdentifier uint
+	huge              *big.Int
+	annotation        string
 }
 
+/*
+IsZero returns a Boolean value indicative of whether the receiver is unset, i.e.: it bears neither an identifier nor a non-zero number form. Note that a bare number form of zero (0) is indistinguishable from an unset instance.
+*/
 func (nanf NameAndNumberForm) IsZero() bool {
-	return len(nanf.identifier)&int(nanf.primaryIdentifier) == 0
+	return len(nanf.identifier) == 0 && nanf.primaryIdentifier == 0 && nanf.huge == nil
 }
 
 func (nanf NameAndNumberForm) Identifier() string {
 	return nanf.identifier
 }
 
+/*
+Decimal returns the primaryIdentifier of the receiver as an int. If the primaryIdentifier is too large to be represented by an int on the current platform, -1 is returned; see the DecimalE, Decimal64 and Big methods for such cases.
+*/
 func (nanf NameAndNumberForm) Decimal() int {
+	if nanf.huge != nil || nanf.primaryIdentifier > maxInt {
+		return -1
+	}
 	return int(nanf.primaryIdentifier)
 }
 
+/*
+DecimalE returns the primaryIdentifier of the receiver as an int alongside an error, which wraps ErrInvalidNumberForm should the primaryIdentifier be too large to be represented by an int on the current platform, e.g. beyond 2147483647 on 32-bit platforms. The int is zero in such cases.
+*/
+func (nanf NameAndNumberForm) DecimalE() (n int, err error) {
+	if nanf.huge != nil || nanf.primaryIdentifier > maxInt {
+		err = errorw(ErrInvalidNumberForm, "Number form %s cannot be represented by an int", nanf.number())
+		return
+	}
+
+	n = int(nanf.primaryIdentifier)
+	return
+}
+
+/*
+Decimal64 returns the primaryIdentifier of the receiver as an int64, alongside a Boolean value indicative of whether it could be represented as such without loss. Unlike Decimal, the result does not vary by platform. Zero and false are returned for larger values; see the Big method for such cases.
+*/
+func (nanf NameAndNumberForm) Decimal64() (n int64, ok bool) {
+	if nanf.huge != nil {
+		// on 32-bit platforms, huge values may yet fit an int64.
+		if nanf.huge.IsInt64() {
+			n, ok = nanf.huge.Int64(), true
+		}
+		return
+	} else if uint64(nanf.primaryIdentifier) > 1<<63-1 {
+		return
+	}
+
+	n, ok = int64(nanf.primaryIdentifier), true
+	return
+}
+
+/*
+Big returns the primaryIdentifier of the receiver as a *big.Int, regardless of its magnitude.
+*/
+func (nanf NameAndNumberForm) Big() *big.Int {
+	if nanf.huge != nil {
+		return new(big.Int).Set(nanf.huge)
+	}
+	return new(big.Int).SetUint64(uint64(nanf.primaryIdentifier))
+}
+
+/*
+number returns the decimal string form of the primaryIdentifier of the receiver.
+*/
+func (nanf NameAndNumberForm) number() string {
+	if nanf.huge != nil {
+		return nanf.huge.String()
+	}
+	return fmtUint(uint64(nanf.primaryIdentifier), 10)
+}
+
 func (nanf NameAndNumberForm) String() (val string) {
-	n := itoa(int(nanf.primaryIdentifier))
+	n := nanf.number()
 	if len(nanf.identifier) == 0 {
 		return n
 	}
 	return sprintf("%s(%s)", nanf.identifier, n)
 }
 
+/*
+AppendString appends the string form of the receiver to dst and returns the extended buffer.
+*/
+func (nanf NameAndNumberForm) AppendString(dst []byte) []byte {
+	if len(nanf.identifier) == 0 {
+		return nanf.appendNumber(dst)
+	}
+
+	dst = append(dst, nanf.identifier...)
+	dst = append(dst, '(')
+	dst = nanf.appendNumber(dst)
+	return append(dst, ')')
+}
+
+/*
+appendNumber appends the decimal form of the primaryIdentifier of the receiver to dst.
+*/
+func (nanf NameAndNumberForm) appendNumber(dst []byte) []byte {
+	if nanf.huge != nil {
+		return nanf.huge.Append(dst, 10)
+	}
+	return appendUint(dst, uint64(nanf.primaryIdentifier), 10)
+}
+
 func (nanf NameAndNumberForm) Equal(n NameAndNumberForm) bool {
+	if nanf.huge != nil || n.huge != nil {
+		return eq(nanf.identifier, n.identifier) &&
+			nanf.Big().Cmp(n.Big()) == 0
+	}
+
 	return eq(nanf.identifier, n.identifier) &&
 		nanf.primaryIdentifier == n.primaryIdentifier
 }
 
+/*
+cmp compares the primaryIdentifier of the receiver with that of n, returning -1, 0 or +1.
+*/
+func (nanf NameAndNumberForm) cmp(n NameAndNumberForm) int {
+	if nanf.huge != nil || n.huge != nil {
+		return nanf.Big().Cmp(n.Big())
+	} else if nanf.primaryIdentifier < n.primaryIdentifier {
+		return -1
+	} else if nanf.primaryIdentifier > n.primaryIdentifier {
+		return 1
+	}
+
+	return 0
+}
+
+/*
+setNumberForm assigns the decimal string n as the primaryIdentifier of the receiver. Values too large to be represented by a uint are stored as a *big.Int.
+*/
+func (nanf *NameAndNumberForm) setNumberForm(n string) (err error) {
+	if u, e := parseUint(n, 10, 0); e == nil {
+		nanf.primaryIdentifier = uint(u)
+		return
+	}
+
+	b, ok := new(big.Int).SetString(n, 10)
+	if !ok || b.Sign() < 0 {
+		err = errorw(ErrInvalidNumberForm, "Bad number form '%s'", n)
+		return
+	}
+	nanf.huge = b
+
+	return
+}
+
+/*
+setBig assigns b as the primaryIdentifier of the receiver, storing it as a uint when possible.
+*/
+func (nanf *NameAndNumberForm) setBig(b *big.Int) {
+	if b.IsUint64() && b.Uint64() <= uint64(^uint(0)) {
+		nanf.primaryIdentifier = uint(b.Uint64())
+		nanf.huge = nil
+		return
+	}
+	nanf.huge = new(big.Int).Set(b)
+}
+
 func parseNaNFstr(x string) (nanf *NameAndNumberForm, err error) {
+	var n NameAndNumberForm
+	if n, err = parseNaNF(x, false); err == nil {
+		nanf = new(NameAndNumberForm)
+		*nanf = n
+	}
+
+	return
+}
+
+/*
+parseNaNF parses a single nameAndNumber form, e.g. dod(6), without allocating an intermediate *NameAndNumberForm. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func parseNaNF(x string, upper bool) (nanf NameAndNumberForm, err error) {
 	if len(x) == 0 {
-		err = errorf("No content for parseNaNFstr to read")
+		err = errorw(ErrEmptyInput, "No NameAndNumberForm content to read")
 		return
 	} else if x[len(x)-1] != ')' {
-		err = errorf("No closing parenthesis for parseNaNFstr to read")
+		err = errorw(ErrInvalidNumberForm, "No closing parenthesis in NameAndNumberForm '%s'", x)
 		return
 	}
 
 	idx := indexRune(x, '(')
 	if idx == -1 {
-		err = errorf("No opening parenthesis for parseNaNFstr to read")
+		err = errorw(ErrInvalidNumberForm, "No opening parenthesis in NameAndNumberForm '%s'", x)
+		return
+	} else if idx == 0 {
+		err = errorw(ErrInvalidIdentifier, "No identifier in NameAndNumberForm '%s'", x)
 		return
 	}
 
 	n := x[idx+1 : len(x)-1]
 	if !isDigit(n) {
-		err = errorf("Bad primaryIdentifier '%s'", n)
+		err = errorw(ErrInvalidNumberForm, "Bad number form '%s'", n)
 		return
 	}
 
 	var valid bool
-	if valid, err = identifierIsValid(x[:idx-1]); !valid {
+	if valid, err = identifierIsValid(x[:idx], upper); !valid {
 		return
 	}
 
-	nanf = new(NameAndNumberForm)
-	f, _ := atoi(n)
-	nanf.primaryIdentifier = uint(f)
+	if err = nanf.setNumberForm(n); err != nil {
+		return
+	}
 
 	// identifier seems safe to assign
-	nanf.identifier = x[:idx]
+	nanf.identifier = intern(x[:idx])
 	return
 }
 
-func identifierIsValid(val string) (valid bool, err error) {
-        for c := 0; c < len(val); c++ {
-                ch := rune(val[c])
-
-                if c == 0 {
-                        if !('a' <= ch && ch <= 'z') {
-                                err = errorf("Bad identifier '%s' at char #%d [%c] [hint: must only start with lowercase alpha]", val, c, ch)
-                                return
-                        }
-                }
-
-                if ('a' <= ch && ch <= 'z') ||
-                        ('A' <= ch && ch <= 'Z') ||
-                        ('0' <= ch && ch <= '9') || ch == '-' {
-                        // cool
-                } else {
-                        err = errorf("Bad identifier '%s' at char #%d [%c], unsupported character(s) [hint: must be A-Z, a-z, 0-9 or '-']", val, c, ch)
-                        return
-                }
-
-                if c == len(val)-1 {
-                        if ch == '-' {
-                                err = errorf("Bad identifier '%s' at char #%d [%c] [hint: final identifier character cannot be a hyphen]", val, c, ch)
-				return
-                        }
-                }
-        }
+/*
+identifierIsValid returns a Boolean value indicative of whether val, in its entirety, qualifies as an ASN.1 identifier, alongside an error describing the first offending character, if any. If upper is true, an uppercase first character is tolerated, per CaseLenient and CasePreserve. Scanning is performed rune by rune, such that multi-byte input is reported accurately; character positions within errors are rune positions, counted from zero (0).
+*/
+func identifierIsValid(val string, upper bool) (valid bool, err error) {
+	for i, c := 0, 0; i < len(val); c++ {
+		ch, size := utf8.DecodeRuneInString(val[i:])
+		i += size
+
+		if c == 0 && !('a' <= ch && ch <= 'z') && !(upper && 'A' <= ch && ch <= 'Z') {
+			err = errorw(ErrInvalidIdentifier, "Bad identifier '%s' at char #%d [%c] [hint: must only start with lowercase alpha]", val, c, ch)
+			return
+		}
+
+		if !(('a' <= ch && ch <= 'z') ||
+			('A' <= ch && ch <= 'Z') ||
+			('0' <= ch && ch <= '9') || ch == '-') {
+			err = errorw(ErrInvalidIdentifier, "Bad identifier '%s' at char #%d [%c], unsupported character(s) [hint: must be A-Z, a-z, 0-9 or '-']", val, c, ch)
+			return
+		}
+
+		if i == len(val) && ch == '-' {
+			err = errorw(ErrInvalidIdentifier, "Bad identifier '%s' at char #%d [%c] [hint: final identifier character cannot be a hyphen]", val, c, ch)
+			return
+		}
+	}
 
 	valid = true
 	return
 }
 
+/*
+NormalizeIdentifier returns the ASN.1 identifier derived from x alongside an error, which is useful when importing names from sources that do not observe the rules of ITU-T Rec. X.680, e.g.:
+
+	id, err := oid.NormalizeIdentifier(`Common_Name`)
+	// id == "common-Name", err == nil
+
+Underscores, spaces and tabs are converted to hyphens, and runs of these and any hyphens are collapsed into a single hyphen, such that the result bears no consecutive hyphens. Leading and trailing hyphens are removed, and an uppercase first character is lowercased. Case is otherwise preserved.
+
+An error is returned should the result not qualify as an identifier, e.g. if it begins with a digit or bears characters other than letters, digits and hyphens.
+*/
+func NormalizeIdentifier(x string) (id string, err error) {
+	dst := make([]byte, 0, len(x))
+	var hyphen bool
+	for i := 0; i < len(x); i++ {
+		switch ch := x[i]; ch {
+		case '_', ' ', '\t', '-':
+			hyphen = len(dst) > 0
+		default:
+			if hyphen {
+				dst = append(dst, '-')
+				hyphen = false
+			}
+			if len(dst) == 0 && 'A' <= ch && ch <= 'Z' {
+				ch += 'a' - 'A'
+			}
+			dst = append(dst, ch)
+		}
+	}
+
+	if len(dst) == 0 {
+		err = errorw(ErrEmptyInput, "No content for NormalizeIdentifier to read")
+		return
+	} else if _, err = identifierIsValid(string(dst), false); err == nil {
+		id = string(dst)
+	}
+
+	return
+}
+
 func NewNameAndNumberForm(x any) (nanf *NameAndNumberForm, err error) {
 
 	switch tv := x.(type) {
@@ -108,20 +308,29 @@ func NewNameAndNumberForm(x any) (nanf *NameAndNumberForm, err error) {
 		if !isDigit(tv) {
 			nanf, err = parseNaNFstr(tv)
 		} else {
-			z, _ := atoi(tv)
-			nanf, err = NewNameAndNumberForm(uint(z))
+			nanf = new(NameAndNumberForm)
+			if err = nanf.setNumberForm(tv); err != nil {
+				nanf = nil
+			}
 		}
 	case uint:
 		nanf = new(NameAndNumberForm)
 		nanf.primaryIdentifier = tv
+	case *big.Int:
+		if tv == nil || tv.Sign() < 0 {
+			err = errorw(ErrInvalidNumberForm, "Number form cannot be nil or negative")
+		} else {
+			nanf = new(NameAndNumberForm)
+			nanf.setBig(tv)
+		}
 	case int:
 		if tv < 0 {
-			err = errorf("primaryIdentifier cannot be negative")
+			err = errorw(ErrInvalidNumberForm, "Number form cannot be negative")
 		} else {
 			nanf, err = NewNameAndNumberForm(uint(tv))
 		}
 	default:
-		err = errorf("Unsupported NameAndNumberForm input type '%T'", tv)
+		err = errorw(ErrUnsupportedType, "Unsupported NameAndNumberForm input type '%T'", tv)
 	}
 
 	return
@@ -0,0 +1,126 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseNaNFIdentifier(t *testing.T) {
+	for _, tc := range []struct {
+		in, id string
+		err    error
+		hint   string // substring expected within the error
+	}{
+		{`dod(6)`, `dod`, nil, ``},
+		{`identified-organization(3)`, `identified-organization`, nil, ``},
+		{`x509(3)`, `x509`, nil, ``},
+		{`dodx(6)`, `dodx`, nil, ``},
+		{`dod-(6)`, ``, ErrInvalidIdentifier, `char #3 [-]`},
+		{`Dod(6)`, ``, ErrInvalidIdentifier, `char #0 [D]`},
+		{`1dod(6)`, ``, ErrInvalidIdentifier, `char #0 [1]`},
+		{`do_d(6)`, ``, ErrInvalidIdentifier, `char #2 [_]`},
+		{`dödx(6)`, ``, ErrInvalidIdentifier, `char #1 [ö]`},
+		{`déf(6)`, ``, ErrInvalidIdentifier, `char #1 [é]`},
+		{`dod€(6)`, ``, ErrInvalidIdentifier, `char #3 [€]`},
+		{`dod(6`, ``, ErrInvalidNumberForm, ``},
+	} {
+		nanf, err := parseNaNF(tc.in, false)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if err != nil {
+			if !strings.Contains(err.Error(), tc.hint) {
+				t.Errorf("%q: error %q lacks %q", tc.in, err, tc.hint)
+			}
+		} else if nanf.Identifier() != tc.id {
+			t.Errorf("%q: got identifier %q, want %q", tc.in, nanf.Identifier(), tc.id)
+		}
+	}
+}
+
+func TestNormalizeIdentifier(t *testing.T) {
+	for _, tc := range []struct {
+		in, want string
+		err      error
+	}{
+		{`Common_Name`, `common-Name`, nil},
+		{`commonName`, `commonName`, nil},
+		{`id--kp`, `id-kp`, nil},
+		{"id _-\tkp", `id-kp`, nil},
+		{`-leading and trailing-`, `leading-and-trailing`, nil},
+		{`X509`, `x509`, nil},
+		{`509x`, ``, ErrInvalidIdentifier},
+		{`a.b`, ``, ErrInvalidIdentifier},
+		{`__`, ``, ErrEmptyInput},
+		{``, ``, ErrEmptyInput},
+	} {
+		id, err := NormalizeIdentifier(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if id != tc.want {
+			t.Errorf("%q: got %q, want %q", tc.in, id, tc.want)
+		}
+	}
+}
+
+func TestStrictConsecutiveHyphens(t *testing.T) {
+	// the identifier is held by a lenient source, as only
+	// WithStrict forbids consecutive hyphens.
+	x, err := NewObjectIdentifier(`{ 1 3 6 1 4 1 my--org(56521) }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := ObjectIdentifierMap{`1.3.6.1.4.1.56521`: x}
+
+	checkOptionCases(t, []optionCase{
+		{`{ iso(1) my--org(3) }`, []Option{WithStrict()}, ``, ErrInvalidIdentifier},
+		{`{ iso(1) my--org(3) }`, nil, `1.3`, nil},
+		{[]int{1, 3, 6, 1, 4, 1, 56521}, []Option{WithStrict(), WithResolver(r)}, ``, ErrInvalidIdentifier},
+		{[]int{1, 3, 6, 1, 4, 1, 56521}, []Option{WithResolver(r)}, `1.3.6.1.4.1.56521`, nil},
+	})
+}
+
+func TestDecimal(t *testing.T) {
+	for _, tc := range []struct {
+		in    string
+		dec64 int64
+		ok    bool
+	}{
+		{`0`, 0, true},
+		{`56521`, 56521, true},
+		{`2147483647`, 2147483647, true},
+		{`2147483648`, 2147483648, true},
+		{`9223372036854775807`, 9223372036854775807, true},
+		{`9223372036854775808`, 0, false},
+		{`18446744073709551615`, 0, false},
+		{`18446744073709551616`, 0, false},
+	} {
+		nanf, err := NewNameAndNumberForm(tc.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.in, err)
+		}
+
+		if d64, ok := nanf.Decimal64(); d64 != tc.dec64 || ok != tc.ok {
+			t.Errorf("%s: Decimal64 got %d, %t, want %d, %t", tc.in, d64, ok, tc.dec64, tc.ok)
+		}
+
+		// whether an int suffices depends upon the platform
+		want := -1
+		if tc.ok && int64(int(tc.dec64)) == tc.dec64 {
+			want = int(tc.dec64)
+		}
+
+		if d := nanf.Decimal(); d != want {
+			t.Errorf("%s: Decimal got %d, want %d", tc.in, d, want)
+		}
+
+		d, err := nanf.DecimalE()
+		if want == -1 {
+			if !errors.Is(err, ErrInvalidNumberForm) || d != 0 {
+				t.Errorf("%s: DecimalE got %d, %v, want 0, %v", tc.in, d, err, ErrInvalidNumberForm)
+			}
+		} else if err != nil || d != want {
+			t.Errorf("%s: DecimalE got %d, %v, want %d", tc.in, d, err, want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package oid
+
+/*
+normalize.go deals with the canonical, form-independent representation of an ObjectIdentifier.
+*/
+
+/*
+Normalize returns a copy of the receiver bearing only its number forms, devoid of arc identifiers, arc annotations, names, description and status. This is the canonical ObjectIdentifier shared by every textual form of a given OID, since dotNotation, OID-IRI, URN and DER carry no identifiers.
+
+The following guarantee holds for every ObjectIdentifier o, and every form f among DotNotation, StringAs(FormNaNF), IRI, URN and the hexadecimal form of AppendDER (with tag):
+
+	p, err := ParseAny(f(o))
+	// err == nil && p.Normalize().DotNotation() == o.Normalize().DotNotation()
+
+Normalize differs from Canonicalize, which fills in names rather than removing them. A nil instance is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) Normalize() (n *ObjectIdentifier) {
+	if o.IsZero() {
+		return
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	n = newObjectIdentifier(len(o.nANF))
+	for i := 0; i < len(o.nANF); i++ {
+		arc := o.nANF[i]
+		arc.identifier = ``
+		arc.annotation = ``
+		n.nANF = append(n.nANF, arc)
+	}
+
+	return
+}
+
+/*
+EqualForms returns a Boolean value indicative of whether a and b denote the same OID, alongside an error should either fail to parse. Each may be given in any textual form accepted by ParseAny, e.g.:
+
+	same, err := oid.EqualForms(`urn:oid:1.3.6.1`, `06032b0601`)
+	// same == true, err == nil
+
+Only number forms are compared; arc identifiers and names are disregarded. This is useful when ingesting data of mixed format.
+*/
+func EqualForms(a, b string) (equal bool, err error) {
+	var x, y *ObjectIdentifier
+	if x, err = ParseAny(a); err != nil {
+		return
+	} else if y, err = ParseAny(b); err != nil {
+		return
+	}
+
+	equal = compareArcs(x, y) == 0
+
+	return
+}
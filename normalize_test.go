@@ -0,0 +1,97 @@
+package oid
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+/*
+FuzzParseAny checks the round-trip guarantee documented by Normalize: any input accepted by ParseAny must, once rendered in each textual form, parse back to the same normalized OID.
+*/
+func FuzzParseAny(f *testing.F) {
+	for _, seed := range FuzzSeeds() {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, x string) {
+		o, err := ParseAny(x)
+		if err != nil {
+			return
+		}
+
+		want := o.Normalize().DotNotation()
+		forms := map[string]string{
+			`dot`:  o.DotNotation(),
+			`NaNF`: o.StringAs(FormNaNF),
+			`IRI`:  o.IRI(),
+			`URN`:  o.URN(),
+		}
+		if der := o.AppendDER(nil, true); len(der) > 0 {
+			forms[`DER`] = hex.EncodeToString(der)
+		}
+
+		for name, form := range forms {
+			p, err := ParseAny(form)
+			if err != nil {
+				t.Fatalf("%q: %s form %q: %v", x, name, form, err)
+			} else if got := p.Normalize().DotNotation(); got != want {
+				t.Fatalf("%q: %s form %q: got %s, want %s", x, name, form, got, want)
+			}
+		}
+	})
+}
+
+func TestEqualForms(t *testing.T) {
+	for _, tc := range []struct {
+		a, b  string
+		equal bool
+		err   error
+	}{
+		{`1.3.6.1`, `{ iso(1) identified-organization(3) dod(6) internet(1) }`, true, nil},
+		{`urn:oid:2.5.4.3`, `/2/5/4/3`, true, nil},
+		{`06032b0601`, `.1.3.6.1`, true, nil},
+		{`06032B0601`, `1.3.6.1`, true, nil},
+		{`1.3.6.1`, `1.3.6.2`, false, nil},
+		{`1.3.6`, `1.3.6.1`, false, nil},
+		{``, `1.3.6`, false, ErrEmptyInput},
+		{`1.3.6`, `{ iso(1) abc }`, false, ErrInvalidNumberForm},
+	} {
+		equal, err := EqualForms(tc.a, tc.b)
+		if tc.err != nil && !errors.Is(err, tc.err) {
+			t.Errorf("%q, %q: got error %v, want %v", tc.a, tc.b, err, tc.err)
+		} else if equal != tc.equal {
+			t.Errorf("%q, %q: got %t, want %t", tc.a, tc.b, equal, tc.equal)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	var nilOID *ObjectIdentifier
+	if nilOID.Normalize() != nil {
+		t.Errorf("Normalize of nil instance is non-nil")
+	}
+
+	for _, x := range []string{
+		`1.3.6.1.4.1.56521`,
+		`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 }`,
+		`urn:oid:1.3.6.1.4.1.56521`,
+		`06082b0601040183b949`,
+	} {
+		o, err := ParseAny(x)
+		if err != nil {
+			t.Errorf("%q: %v", x, err)
+			continue
+		}
+
+		n := o.Normalize()
+		if d := n.DotNotation(); d != `1.3.6.1.4.1.56521` {
+			t.Errorf("%q: got %s", x, d)
+		}
+		for i := 0; i < n.len(); i++ {
+			if id := n.nANF[i].identifier; id != `` {
+				t.Errorf("%q: arc #%d retains identifier %q", x, i, id)
+			}
+		}
+	}
+}
@@ -1,27 +1,200 @@
 package oid
 
 import (
+	"encoding"
 	"encoding/asn1"
-	"strings"
+	"fmt"
+	"sync"
 )
 
 /*
 ObjectIdentifier facilitates the storage, and varied representation of, an ASN.1 object identifier in
 a manner that goes beyond mere dotNotation and may be more convenient than using the asn1.ObjectIdentifier instance.
+
+An *ObjectIdentifier may be shared among goroutines, as is the case with those stored within a Registry or WellKnown. Its mutators, such as SetName, SetAltNames, RemoveAltName, SetDescription, SetStatus, SetArcAnnotation and InferNames, may be called concurrently with one another and with its readers, such as Name, AltNames, Equal, String and MarshalJSON. UnmarshalJSON, which replaces the receiver wholesale, is the exception and must not be used upon a shared instance. The slice returned by AltNames is a copy owned by the caller.
 */
 type ObjectIdentifier struct {
-	nANF []NameAndNumberForm
-	aka  []string
+	*oidState
+}
+
+/*
+oidState contains the contents of an ObjectIdentifier. It is held by pointer, such that copies of an ObjectIdentifier made by its value methods, such as String and Equal, share the same contents and lock rather than reading them unsynchronized.
+*/
+type oidState struct {
+	nANF       []NameAndNumberForm
+	name       string
+	aka        []string
+	desc       string
+	status     Status
+	cache      *renderCache
+	mu         *sync.RWMutex
+	noFold     bool
+	descrNames bool
 }
 
 /*
 ASN1 returns a populated instance of asn1.ObjectIdentifier using the contents of the receiver.
+
+The return value is computed once and shared by subsequent calls, thus it must not be modified by the caller.
+
+Arcs which cannot be represented by an int, such as those found beneath the {joint-iso-itu-t uuid(25)} arc, are silently misrepresented. Use ASN1E when the receiver may contain such arcs.
+
+A nil instance is returned if the receiver is zero.
 */
 func (o ObjectIdentifier) ASN1() (a asn1.ObjectIdentifier) {
-	a = make(asn1.ObjectIdentifier, len(o.nANF), len(o.nANF))
+	if o.IsZero() {
+		return
+	}
+
+	c := o.renderCache()
+	if c == nil {
+		return o.asn1()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.asn1 == nil {
+		c.asn1 = o.asn1()
+	}
+
+	return c.asn1
+}
+
+/*
+ASN1E returns a populated instance of asn1.ObjectIdentifier using the contents of the receiver alongside an error. An error is returned if any arc of the receiver cannot be represented by an int on the current platform, in which case the asn1.ObjectIdentifier is nil.
+
+The return value is shared as with ASN1, thus it must not be modified by the caller. An error is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) ASN1E() (a asn1.ObjectIdentifier, err error) {
+	if o.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", o)
+		return
+	}
+
+	arcs := o.arcs()
+	for i := 0; i < len(arcs); i++ {
+		if arcs[i].huge != nil || arcs[i].primaryIdentifier > maxInt {
+			err = errorw(ErrInvalidNumberForm, "Arc #%d (%s) cannot be represented by an int", i, arcs[i].number())
+			return
+		}
+	}
+
+	a = o.ASN1()
+	return
+}
+
+func (o *ObjectIdentifier) asn1() (a asn1.ObjectIdentifier) {
+	arcs := o.arcs()
+	a = make(asn1.ObjectIdentifier, len(arcs), len(arcs))
+	for i := 0; i < len(arcs); i++ {
+		a[i] = arcs[i].Decimal()
+	}
+	return
+}
+
+/*
+DotNotation returns the dotNotation form of the receiver, e.g.:
+
+	1.3.6.1
+
+Unlike the string representation of the instance returned by the ASN1 method, arcs too large to be represented by an int are rendered correctly. A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) DotNotation() string {
+	if o.IsZero() {
+		return ``
+	}
+
+	c := o.renderCache()
+	if c == nil {
+		return o.dotNotation()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.dot) == 0 {
+		c.dot = o.dotNotation()
+	}
+
+	return c.dot
+}
+
+func (o *ObjectIdentifier) dotNotation() string {
+	var scratch [64]byte
+	return string(o.AppendDotNotation(scratch[:0]))
+}
+
+/*
+AppendDotNotation appends the dotNotation form of the receiver to dst and returns the extended buffer. Unlike DotNotation, no allocation is performed when dst has sufficient capacity.
+*/
+func (o *ObjectIdentifier) AppendDotNotation(dst []byte) []byte {
+	return appendDotArcs(dst, o.arcs())
+}
+
+/*
+appendDotArcs appends the dotNotation form of arcs to dst and returns the extended buffer.
+*/
+func appendDotArcs(dst []byte, arcs []NameAndNumberForm) []byte {
+	for i := 0; i < len(arcs); i++ {
+		if i > 0 {
+			dst = append(dst, '.')
+		}
+		dst = arcs[i].appendNumber(dst)
+	}
+
+	return dst
+}
+
+/*
+dotArcs returns the dotNotation form of arcs, e.g. a leading subset of the arcs of an instance.
+*/
+func dotArcs(arcs []NameAndNumberForm) string {
+	var scratch [64]byte
+	return string(appendDotArcs(scratch[:0], arcs))
+}
+
+/*
+Strings returns the string form of each arc of the receiver, i.e. "name(n)" where an identifier is present and "n" otherwise, e.g.:
+
+	[]string{`iso(1)`, `identified-organization(3)`, `dod(6)`, `1`}
+
+The result is suitable for use with NewObjectIdentifier and Equal. A nil slice is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) Strings() (s []string) {
+	if o.IsZero() {
+		return
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	s = make([]string, len(o.nANF))
+	for i := 0; i < len(o.nANF); i++ {
+		s[i] = o.nANF[i].String()
+	}
+
+	return
+}
+
+/*
+NumberStrings returns the number form of each arc of the receiver as a string, e.g.:
+
+	[]string{`1`, `3`, `6`, `1`}
+
+Unlike ASN1, arcs too large to be represented by an int are rendered correctly. The result is suitable for use with NewObjectIdentifier. A nil slice is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) NumberStrings() (s []string) {
+	if o.IsZero() {
+		return
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	s = make([]string, len(o.nANF))
 	for i := 0; i < len(o.nANF); i++ {
-		a[i] = int(o.nANF[i].primaryIdentifier)
+		s[i] = o.nANF[i].number()
 	}
+
 	return
 }
 
@@ -29,108 +202,431 @@ func (o ObjectIdentifier) ASN1() (a asn1.ObjectIdentifier) {
 Equal returns a boolean indicative of whether the provided type instance effectively matches the receiver.
 
 This method supports asn1.ObjectIdentifier, []int, string and []string type instances for comparison. In the case of string input, a dotNotation match is attempted first, followed by an ASN.1 NameAndNumberForm sequence match and lastly a case folded string match of any alternative names by which the OID may be known.
+
+Any other type implementing encoding.TextMarshaler or fmt.Stringer, such as the OID types of third-party packages, is compared using its textual output in the manner of string input. TextMarshaler is preferred when both are implemented.
 */
 func (o ObjectIdentifier) Equal(x any) bool {
+	if o.IsZero() {
+		return false
+	}
+
 	switch tv := x.(type) {
 	case asn1.ObjectIdentifier:
-		return intSliceEqual([]int(tv), []int(o.ASN1()))
+		return o.equalASN1(tv)
+	case []int:
+		return o.equalASN1(asn1.ObjectIdentifier(tv))
 	case string:
-		if o.ASN1().String() == tv {
+		if len(tv) == 0 {
+			return false
+		} else if '0' <= tv[0] && tv[0] <= '9' && o.equalDot(tv) {
 			// dotNotation
 			return true
-		} else if o.String() == tv {
+		} else if tv[0] == '{' && len(tv) > 2*o.len() && o.nanfString() == tv {
 			// ASN.1 NameAndNumberForm sequence
 			return true
-		} else {
-			// alt names
-			for i := 0; i < len(o.aka); i++ {
-				if strings.EqualFold(o.aka[i], tv) {
-					return true
-				}
-			}
 		}
+
+		// principal name and alt names
+		o.rlock()
+		defer o.runlock()
+		if len(o.name) > 0 && o.nameEqual(o.name, tv) {
+			return true
+		}
+
+		return o.altNameIndex(tv) != -1
 	case []string:
-		if len(o.nANF) != len(tv) {
+		arcs := o.arcs()
+		if len(arcs) != len(tv) {
 			return false
 		}
 
-		for i := 0; i < len(o.nANF); i++ {
-			if o.nANF[i].String() != tv[i] {
+		for i := 0; i < len(arcs); i++ {
+			if arcs[i].String() != tv[i] {
 				return false
 			}
 		}
 		return true
+	case encoding.TextMarshaler:
+		if text, err := tv.MarshalText(); err == nil {
+			return o.Equal(string(text))
+		}
+	case fmt.Stringer:
+		return o.Equal(tv.String())
 	}
 
 	return false
 }
 
+/*
+nameEqual compares two names in a case-folded manner, unless the receiver was constructed using WithoutNameFolding.
+*/
+func (o *ObjectIdentifier) nameEqual(a, b string) bool {
+	if o.noFold {
+		return a == b
+	}
+	return eq(a, b)
+}
+
+/*
+equalASN1 compares the number forms of the receiver with a, arc by arc.
+*/
+func (o *ObjectIdentifier) equalASN1(a asn1.ObjectIdentifier) bool {
+	arcs := o.arcs()
+	if len(a) == 0 || len(a) != len(arcs) {
+		return false
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if arcs[i].huge != nil || a[i] < 0 || uint(a[i]) != arcs[i].primaryIdentifier {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+equalDot compares the number forms of the receiver with the dotNotation value d, arc by arc, without rendering the receiver as a string.
+*/
+func (o *ObjectIdentifier) equalDot(d string) bool {
+	arcs := o.arcs()
+	if countByte(d, '.')+1 != len(arcs) {
+		return false
+	}
+
+	for start, i, a := 0, 0, 0; i <= len(d); i++ {
+		if i < len(d) && d[i] != '.' {
+			continue
+		}
+
+		n := d[start:i]
+		if arcs[a].huge != nil {
+			if n != arcs[a].huge.String() {
+				return false
+			}
+		} else if u, err := parseUint(n, 10, 0); err != nil || uint(u) != arcs[a].primaryIdentifier {
+			return false
+		}
+
+		start = i + 1
+		a++
+	}
+
+	return true
+}
+
 /*
 String returns the ASN.1 NameAndNumberForm sequence stored within the receiver in full, e.g.:
 
 	{ iso(1) identified-organization(3) dod(6) }
+
+Another representation may be returned instead should the package default have been altered using SetDefaultStringForm. Use StringAs to obtain a specific representation regardless of the default.
+
+A zero string is returned if the receiver is zero.
+*/
+func (o ObjectIdentifier) String() string {
+	if f := DefaultStringForm(); f != FormNaNF {
+		return o.StringAs(f)
+	}
+
+	return o.nanfString()
+}
+
+/*
+nanfString returns the ASN.1 NameAndNumberForm sequence of the receiver, which is cached upon first use.
 */
-func (o ObjectIdentifier) String() (a string) {
-	a = `{`
+func (o *ObjectIdentifier) nanfString() string {
+	if o.IsZero() {
+		return ``
+	}
+
+	c := o.renderCache()
+	if c == nil {
+		return o.string()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.nanf) == 0 {
+		c.nanf = o.string()
+	}
+
+	return c.nanf
+}
+
+func (o *ObjectIdentifier) string() string {
+	var scratch [128]byte
+	return string(o.AppendString(scratch[:0]))
+}
+
+/*
+AppendString appends the ASN.1 NameAndNumberForm sequence of the receiver to dst and returns the extended buffer, regardless of the package default described by SetDefaultStringForm. Unlike String, no allocation is performed when dst has sufficient capacity.
+
+If the final arc of the receiver lacks an identifier, the principal name of the receiver is used in its place, provided it qualifies as an ASN.1 identifier.
+*/
+func (o *ObjectIdentifier) AppendString(dst []byte) []byte {
+	if o.IsZero() {
+		return dst
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	dst = append(dst, '{')
 	for i := 0; i < len(o.nANF); i++ {
-		a += sprintf(" %s", o.nANF[i])
+		dst = append(dst, ' ')
+		if i == len(o.nANF)-1 && len(o.nANF[i].identifier) == 0 && isIdentifier(o.name) {
+			dst = NameAndNumberForm{
+				identifier:        o.name,
+				primaryIdentifier: o.nANF[i].primaryIdentifier,
+				huge:              o.nANF[i].huge,
+			}.AppendString(dst)
+			continue
+		}
+		dst = o.nANF[i].AppendString(dst)
 	}
-	a += ` }`
 
-	return
+	return append(dst, ` }`...)
 }
 
 /*
-IsZero checks the receiver for nilness and returns a boolean indicative of the result.
+IsZero checks the receiver for nilness, or for the absence of contents as with a zero ObjectIdentifier, and returns a boolean indicative of the result.
 */
 func (oid *ObjectIdentifier) IsZero() bool {
-	return oid == nil
+	return oid == nil || oid.oidState == nil
 }
 
 /*
-Valid returns a boolean value indicative of whether the receiver's length is greater than or equal to one (1) slice member.
+Valid returns a boolean value indicative of whether the receiver's length is greater than or equal to one (1) slice member, and whether its root arc is 0, 1 or 2. False is returned if the receiver is zero or has no arcs.
 */
 func (o ObjectIdentifier) Valid() bool {
-	if o.IsZero() {
+	return o.valid()
+}
+
+/*
+valid implements Valid, returning false if the receiver is nil.
+*/
+func (o *ObjectIdentifier) valid() bool {
+	arcs := o.arcs()
+	if len(arcs) == 0 || arcs[0].huge != nil {
 		return false
 	}
 
 	// If the first arc is 0, 1 or 2,
 	// then we passed verification.
-	return 0 <= o.nANF[0].primaryIdentifier && o.nANF[0].primaryIdentifier <= 2
+	return arcs[0].primaryIdentifier <= MaxRootArc
 }
 
 /*
-SetAltNames assigns alternative names by which the receiver may be known in the wild in addition to its "principal" name. Duplicates are filtered out.
+SetAltNames assigns alternative names by which the receiver may be known in the wild in addition to its "principal" name. Duplicates, as well as zero length names, are filtered out. Names are compared in a case-folded manner unless the receiver was constructed using WithoutNameFolding.
+
+If the receiver was constructed using WithDescriptorAltNames, an error is returned should any name fail to qualify as a descriptor per RFC 4512, in which case no names are assigned.
 
 One example of an alternate name in the wild is the OID `id-kp-serverAuth(1)` (1.3.6.1.5.5.7.3.1), which is also known simply as 'serverAuth'.
 */
-func (o *ObjectIdentifier) SetAltNames(name ...string) {
-	for i := 0; i < len(name); i++ {
-		for j := 0; i < len(o.aka); i++ {
-			if strings.EqualFold(name[j], o.aka[i]) {
-				continue
+func (o *ObjectIdentifier) SetAltNames(name ...string) (err error) {
+	if o.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", o)
+		return
+	}
+
+	if o.descrNames {
+		for i := 0; i < len(name); i++ {
+			if !isDescr(name[i]) {
+				err = errorw(ErrInvalidIdentifier, "Bad alt name '%s' [hint: must be a descriptor]", name[i])
+				return
 			}
 		}
-		o.aka = append(o.aka, name[i])
 	}
 
+	o.lock()
+	o.setAltNames(name...)
+	o.unlock()
+
 	return
 }
 
 /*
-AltNames returns slices of string values, each representing an alternate name by which the receiver OID may be known in the wild.
+setAltNames implements SetAltNames. The caller must hold the write lock of the receiver. A new slice is assigned rather than the existing one extended.
+*/
+func (o *ObjectIdentifier) setAltNames(name ...string) {
+	aka := o.aka[:len(o.aka):len(o.aka)]
+	for i := 0; i < len(name); i++ {
+		if len(name[i]) > 0 && o.altNameIndex(name[i]) == -1 && !o.nameEqual(o.name, name[i]) {
+			aka = append(aka, name[i])
+			o.aka = aka
+		}
+	}
+}
+
+/*
+HasAltName returns a Boolean value indicative of whether name is among the alt names of the receiver.
 */
-func (o ObjectIdentifier) AltNames() []string { return o.aka }
+func (o *ObjectIdentifier) HasAltName(name string) bool {
+	if o.IsZero() {
+		return false
+	}
 
-func (o ObjectIdentifier) len() int { return len(o.nANF) }
+	o.rlock()
+	defer o.runlock()
 
-func (o ObjectIdentifier) NameAndNumberForm() (nanf NameAndNumberForm) {
-	if o.len() == 0 {
+	return o.altNameIndex(name) != -1
+}
+
+/*
+RemoveAltName removes name from the alt names of the receiver, returning a Boolean value indicative of whether it was present.
+*/
+func (o *ObjectIdentifier) RemoveAltName(name string) (removed bool) {
+	if o.IsZero() {
+		return
+	}
+
+	o.lock()
+	defer o.unlock()
+
+	return o.removeAltName(name)
+}
+
+/*
+removeAltName implements RemoveAltName. The caller must hold the write lock of the receiver.
+*/
+func (o *ObjectIdentifier) removeAltName(name string) (removed bool) {
+	if idx := o.altNameIndex(name); idx != -1 {
+		o.aka = append(o.aka[:idx:idx], o.aka[idx+1:]...)
+		removed = true
+	}
+
+	return
+}
+
+/*
+altNameIndex returns the index of name among the alt names of the receiver, or -1 if absent. The caller must hold a lock of the receiver.
+*/
+func (o *ObjectIdentifier) altNameIndex(name string) int {
+	for i := 0; i < len(o.aka); i++ {
+		if o.nameEqual(o.aka[i], name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+/*
+AltNames returns slices of string values, each representing an alternate name by which the receiver OID may be known in the wild. A nil slice is returned if the receiver is zero or bears no alt names.
+
+The returned slice is a copy owned by the caller, who may modify it freely without affecting the receiver; use SetAltNames and RemoveAltName to alter the alt names of the receiver. Callers requiring only the number of alt names should use AltNamesLen, which does not allocate.
+*/
+func (o ObjectIdentifier) AltNames() (aka []string) {
+	if o.IsZero() {
 		return
 	}
 
-	return o.nANF[len(o.nANF)-1]
+	o.rlock()
+	defer o.runlock()
+
+	if len(o.aka) > 0 {
+		aka = make([]string, len(o.aka))
+		copy(aka, o.aka)
+	}
+
+	return
+}
+
+/*
+AltNamesLen returns the number of alt names borne by the receiver. Zero (0) is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) AltNamesLen() int {
+	if o.IsZero() {
+		return 0
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	return len(o.aka)
+}
+
+func (o ObjectIdentifier) len() int {
+	return len(o.arcs())
+}
+
+/*
+under returns a Boolean value indicative of whether the receiver is equal to, or a descendant of, base. Only number forms are considered. False is returned if either is nil.
+*/
+func (o *ObjectIdentifier) under(base *ObjectIdentifier) bool {
+	return arcsUnder(o.arcs(), base.arcs())
+}
+
+/*
+arcsUnder returns a Boolean value indicative of whether arcs are equal to, or descend from, base, which must not be empty.
+*/
+func arcsUnder(arcs, base []NameAndNumberForm) bool {
+	if len(base) == 0 || len(arcs) < len(base) {
+		return false
+	}
+
+	for i := 0; i < len(base); i++ {
+		if arcs[i].cmp(base[i]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+compareArcs compares the number forms of a and b arc by arc, returning -1, 0 or +1. An OID sorts immediately before its descendants, and a nil instance before all others.
+*/
+func compareArcs(a, b *ObjectIdentifier) int {
+	return compareArcSlices(a.arcs(), b.arcs())
+}
+
+/*
+compareArcSlices implements compareArcs upon snapshots of the arcs of each operand.
+*/
+func compareArcSlices(a, b []NameAndNumberForm) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := a[i].cmp(b[i]); c != 0 {
+			return c
+		}
+	}
+
+	if len(a) < len(b) {
+		return -1
+	} else if len(a) > len(b) {
+		return 1
+	}
+
+	return 0
+}
+
+/*
+clone returns a copy of the receiver which shares no slices with it.
+*/
+func (o *ObjectIdentifier) clone() (c *ObjectIdentifier) {
+	o.rlock()
+	defer o.runlock()
+
+	c = newObjectIdentifier(len(o.nANF))
+	c.nANF = append(c.nANF, o.nANF...)
+	c.name = o.name
+	c.desc = o.desc
+	c.status = o.status
+	c.aka = append([]string{}, o.aka...)
+
+	return
+}
+
+/*
+NameAndNumberForm returns the final arc of the receiver. A zero instance is returned if the receiver is zero or has no arcs.
+*/
+func (o ObjectIdentifier) NameAndNumberForm() (nanf NameAndNumberForm) {
+	if arcs := o.arcs(); len(arcs) > 0 {
+		nanf = arcs[len(arcs)-1]
+	}
+
+	return
 }
 
 /*
@@ -145,48 +641,232 @@ Not all NameAndNumberForm values (arcs) require actual names; they can be number
 	{ iso(1) identified-organization(3) 6 }
 
 ... is perfectly valid but generally not recommended when clarity is desired.
+
+In addition to string input, x may be a []int of number forms, a []string of arcs, each of which is either a bare number form or a nameAndNumber form, or a []any mixing the two, as produced by JSON and YAML decoders, e.g.:
+
+	o, err := NewObjectIdentifier([]any{`iso(1)`, 3, `dod(6)`, 1})
+
+Numeric members of a []any may be of any integer type, a float64 bearing a whole number, a json.Number or a *big.Int.
+
+An error wrapping ErrEmptyInput is returned should x be nil, or a string or slice bearing no arcs.
+
+Zero or more Option instances may be provided to alter the behavior of the constructor, e.g.:
+
+	o, err := NewObjectIdentifier(`1 3 6 1 4 1 311`, WithStrict(), WithResolver(WellKnown()))
 */
-func NewObjectIdentifier(x any) (o *ObjectIdentifier, err error) {
-	t := new(ObjectIdentifier)
+func NewObjectIdentifier(x any, opts ...Option) (o *ObjectIdentifier, err error) {
+	if len(opts) > 0 {
+		return newObjectIdentifierOptions(x, newOptions(opts...))
+	}
 
 	switch tv := x.(type) {
+	case nil:
+		err = errorw(ErrEmptyInput, "No input for %T", o)
+		return
 	case string:
-		f := fields(trimR(trimL(tv, `{ `), ` }`))
-		for i := 0; i < len(f); i++ {
-			var nanf *NameAndNumberForm
-			if nanf, err = NewNameAndNumberForm(f[i]); err != nil {
-				return
-			}
-			t.nANF = append(t.nANF, *nanf)
-		}
+		return NewFromNaNF(tv)
+	case []int:
+		return NewFromInts(tv)
 	case []string:
-		for i := 0; i < len(tv); i++ {
-			var nanf *NameAndNumberForm
-			if nanf, err = NewNameAndNumberForm(tv[i]); err != nil {
-				return
-			}
-			t.nANF = append(t.nANF, *nanf)
+		return newFromStrings(tv, false)
+	case []any:
+		return newFromAny(tv, false)
+	default:
+		err = errorw(ErrUnsupportedType, "Unsupported %T input type %T", o, x)
+		return
+	}
+}
+
+/*
+newFromStrings parses each member of x as an arc, which is either a bare number form or a nameAndNumber form. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func newFromStrings(x []string, upper bool) (o *ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No arcs for %T", o)
+		return
+	}
+
+	t := newObjectIdentifier(len(x))
+	for i := 0; i < len(x); i++ {
+		var arc NameAndNumberForm
+		if arc, err = parseArc(x[i], upper); err != nil {
+			return
 		}
-	case []int:
-		for i := 0; i < len(tv); i++ {
-			var nanf *NameAndNumberForm
-			if nanf, err = NewNameAndNumberForm(tv[i]); err != nil {
-				return
-			}
-			t.nANF = append(t.nANF, *nanf)
+		t.nANF = append(t.nANF, arc)
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+newFromAny parses each member of x as an arc in the manner of parseAnyArc. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func newFromAny(x []any, upper bool) (o *ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No arcs for %T", o)
+		return
+	}
+
+	t := newObjectIdentifier(len(x))
+	for i := 0; i < len(x); i++ {
+		var arc NameAndNumberForm
+		if arc, err = parseAnyArc(x[i], upper); err != nil {
+			return
 		}
-	default:
-		err = errorf("Unsupported %T input type %T\n", *o, x)
+		t.nANF = append(t.nANF, arc)
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+newObjectIdentifierOptions implements NewObjectIdentifier when options are in effect.
+*/
+func newObjectIdentifierOptions(x any, opts options) (o *ObjectIdentifier, err error) {
+	if err = opts.checkInput(x); err != nil {
 		return
 	}
 
-	if !t.Valid() {
-		err = errorf("%T instance did not pass validity checks: %#v", t, *t)
+	var t *ObjectIdentifier
+	upper := opts.cases != CaseStrict
+	if dot, ok := opts.lenientDot(x); ok {
+		t, err = NewFromDot(dot)
+	} else if s, ok := x.(string); ok && opts.spaces {
+		t, err = newFromSpacedNaNF(s, upper)
+	} else if ok && upper {
+		t, err = newFromNaNF(s, upper)
+	} else if a, ok := x.([]string); ok && upper {
+		t, err = newFromStrings(a, upper)
+	} else if a, ok := x.([]any); ok && upper {
+		t, err = newFromAny(a, upper)
+	} else {
+		t, err = NewObjectIdentifier(x)
+	}
+
+	if err != nil {
+		return
+	}
+
+	if err = opts.apply(t); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+newFromSpacedNaNF parses x as an ASN.1 NameAndNumberForm sequence which may bear whitespace within its arcs, as permitted by WithLenientSpacing. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func newFromSpacedNaNF(x string, upper bool) (o *ObjectIdentifier, err error) {
+	var toks []Token
+	if toks, err = Tokenize(x); err != nil {
+		return
+	} else if len(toks) == 0 || (len(toks) == 2 && toks[0].Type == TokenLBrace) {
+		err = errorw(ErrEmptyInput, "No content for %T to read", o)
 		return
 	}
 
-	o = new(ObjectIdentifier)
-	*o = *t
+	return newFromTokens(toks, upper)
+}
+
+/*
+NewFromNaNF returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as an ASN.1 NameAndNumberForm sequence, e.g.:
+
+	{ iso(1) identified-organization(3) dod(6) }
+
+This is equivalent to calling NewObjectIdentifier with string input, but bypasses its type switch.
+*/
+func NewFromNaNF(x string) (o *ObjectIdentifier, err error) {
+	return newFromNaNF(x, false)
+}
+
+/*
+newFromNaNF implements NewFromNaNF. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func newFromNaNF(x string, upper bool) (o *ObjectIdentifier, err error) {
+	n := nanfSequenceLen(x)
+	if n == 0 {
+		err = errorw(ErrEmptyInput, "No content for NewFromNaNF to read")
+		return
+	}
+
+	t := newObjectIdentifier(n)
+	if t.nANF, err = parseNaNFSequence(t.nANF, x, upper); err != nil {
+		return
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+NewFromDot returns an instance of *ObjectIdentifier alongside an error following an attempt to parse x as a dotNotation value, e.g.:
+
+	1.3.6.1
+*/
+func NewFromDot(x string) (o *ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No content for NewFromDot to read")
+		return
+	}
+
+	t := newObjectIdentifier(countByte(x, '.') + 1)
+	if t.nANF, err = parseDotNotation(t.nANF, x, false); err != nil {
+		return
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+NewFromInts returns an instance of *ObjectIdentifier alongside an error following an attempt to use each member of x as a number form. No member may be negative.
+
+This is equivalent to calling NewObjectIdentifier with []int input, but bypasses its type switch.
+*/
+func NewFromInts(x []int) (o *ObjectIdentifier, err error) {
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No arcs for NewFromInts to read")
+		return
+	}
+
+	t := newObjectIdentifier(len(x))
+	for i := 0; i < len(x); i++ {
+		if x[i] < 0 {
+			err = errorw(ErrInvalidNumberForm, "Number form cannot be negative")
+			return
+		}
+		t.nANF = append(t.nANF, NameAndNumberForm{primaryIdentifier: uint(x[i])})
+	}
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
+
+/*
+checkValid returns an error if the receiver has no arcs or otherwise fails the Valid method.
+*/
+func (o *ObjectIdentifier) checkValid() (err error) {
+	if len(o.nANF) == 0 || !o.valid() {
+		err = errorw(ErrInvalidRoot, "%T instance did not pass validity checks: '%s'", o, dotArcs(o.nANF))
+	}
 
 	return
 }
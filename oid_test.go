@@ -0,0 +1,420 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func benchEqualOID(b *testing.B) *ObjectIdentifier {
+	o, err := NewFromNaNF(benchNaNF)
+	if err != nil {
+		b.Fatal(err)
+	}
+	o.SetName(`exampleWidget`)
+	o.SetAltNames(`widget`, `exampleGadget`)
+
+	return o
+}
+
+func BenchmarkEqualDot(b *testing.B) {
+	o := benchEqualOID(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !o.Equal(benchDot) {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkEqualDotMiss(b *testing.B) {
+	o := benchEqualOID(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if o.Equal(`1.3.6.1.4.1.56521.1.6`) {
+			b.Fatal("unexpected match")
+		}
+	}
+}
+
+func BenchmarkEqualNaNF(b *testing.B) {
+	o := benchEqualOID(b)
+	want := o.String()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !o.Equal(want) {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkEqualAltName(b *testing.B) {
+	o := benchEqualOID(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !o.Equal(`exampleGadget`) {
+			b.Fatal("no match")
+		}
+	}
+}
+
+func BenchmarkEqualASN1(b *testing.B) {
+	o := benchEqualOID(b)
+	a := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 56521, 1, 5}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if !o.Equal(a) {
+			b.Fatal("no match")
+		}
+	}
+}
+
+/*
+TestEqualAllocs guards the numeric comparison of dotNotation and asn1.ObjectIdentifier input, which must not render the receiver.
+*/
+func TestEqualAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable under the race detector")
+	}
+
+	o, _ := NewFromDot(benchDot)
+	a := asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 56521, 1, 6}
+
+	if n := testing.AllocsPerRun(100, func() { o.Equal(`1.3.6.1.4.1.56521.1.6`) }); n > 0 {
+		t.Errorf("got %.0f allocations per dotNotation comparison, want none", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { o.Equal(a) }); n > 1 {
+		// boxing a within an interface may allocate
+		t.Errorf("got %.0f allocations per asn1.ObjectIdentifier comparison, want at most 1", n)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	o := benchEqualOID(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = o.String()
+	}
+}
+
+func BenchmarkAppendDotNotation(b *testing.B) {
+	o := benchEqualOID(b)
+	dst := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = o.AppendDotNotation(dst[:0])
+	}
+}
+
+func BenchmarkAppendString(b *testing.B) {
+	o := benchEqualOID(b)
+	dst := make([]byte, 0, 256)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = o.AppendString(dst[:0])
+	}
+}
+
+/*
+TestAppendAllocs guards the documented behavior of AppendDotNotation and AppendString, neither of which may allocate when dst has sufficient capacity.
+*/
+func TestAppendAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable under the race detector")
+	}
+
+	o, _ := NewFromNaNF(benchNaNF)
+	o.SetName(`exampleWidget`)
+	dst := make([]byte, 0, 256)
+
+	if n := testing.AllocsPerRun(100, func() { dst = o.AppendDotNotation(dst[:0]) }); n > 0 {
+		t.Errorf("got %.0f allocations per AppendDotNotation, want none", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { dst = o.AppendString(dst[:0]) }); n > 0 {
+		t.Errorf("got %.0f allocations per AppendString, want none", n)
+	}
+	if got := string(o.AppendString(dst[:0])); got != o.String() {
+		t.Errorf("AppendString: got %q, want %q", got, o.String())
+	}
+}
+
+/*
+TestValueMethods verifies that the value methods of ObjectIdentifier tolerate a zero instance, and that a copy shares the contents of the instance from which it was made.
+*/
+func TestValueMethods(t *testing.T) {
+	o, err := NewFromDot(`1.3.6.1.4.1.56521.1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = o.SetAltNames(`example`); err != nil {
+		t.Fatal(err)
+	}
+	cp := *o
+
+	for _, tc := range []struct {
+		name  string
+		x     ObjectIdentifier
+		valid bool
+		str   string
+		arcs  int
+		aka   int
+	}{
+		{`zero`, ObjectIdentifier{}, false, ``, 0, 0},
+		{`copy`, cp, true, `{ 1 3 6 1 4 1 56521 1 }`, 8, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.x.Valid(); v != tc.valid {
+				t.Errorf("Valid: got %t, want %t", v, tc.valid)
+			}
+			if s := tc.x.String(); s != tc.str {
+				t.Errorf("String: got %q, want %q", s, tc.str)
+			}
+			if n := len(tc.x.ASN1()); n != tc.arcs {
+				t.Errorf("ASN1: got %d arcs, want %d", n, tc.arcs)
+			}
+			if n := len(tc.x.AltNames()); n != tc.aka {
+				t.Errorf("AltNames: got %d, want %d", n, tc.aka)
+			}
+			if eq := tc.x.Equal(`example`); eq != tc.valid {
+				t.Errorf("Equal: got %t, want %t", eq, tc.valid)
+			}
+			if d := tc.x.NameAndNumberForm().Decimal(); tc.valid && d != 1 {
+				t.Errorf("NameAndNumberForm: got %d, want 1", d)
+			}
+		})
+	}
+
+	if err = o.SetName(`renamed`); err != nil {
+		t.Fatal(err)
+	} else if !cp.Equal(`renamed`) {
+		t.Errorf("Equal: copy does not reflect SetName upon its original")
+	}
+}
+
+type textOID struct {
+	text string
+	err  error
+}
+
+func (x textOID) MarshalText() ([]byte, error) { return []byte(x.text), x.err }
+func (x textOID) String() string               { return `2.999` }
+
+type stringerOID string
+
+func (x stringerOID) String() string { return string(x) }
+
+func TestEqualTypes(t *testing.T) {
+	o, _ := NewFromNaNF(`{ iso(1) identified-organization(3) dod(6) internet(1) }`)
+	o.SetAltNames(`internet`)
+	for _, tc := range []struct {
+		x    any
+		want bool
+	}{
+		{`1.3.6.1`, true},
+		{`{ iso(1) identified-organization(3) dod(6) internet(1) }`, true},
+		{`INTERNET`, true},
+		{asn1.ObjectIdentifier{1, 3, 6, 1}, true},
+		{[]int{1, 3, 6, 1}, true},
+		{[]int{1, 3, 6}, false},
+		{[]string{`iso(1)`, `identified-organization(3)`, `dod(6)`, `internet(1)`}, true},
+		{``, false},
+		{42, false},
+		{stringerOID(`1.3.6.1`), true},
+		{stringerOID(`internet`), true},
+		{stringerOID(`1.3.6`), false},
+		{textOID{text: `1.3.6.1`}, true},
+		{textOID{err: errors.New("unrepresentable")}, false},
+	} {
+		if got := o.Equal(tc.x); got != tc.want {
+			t.Errorf("Equal(%v): got %t, want %t", tc.x, got, tc.want)
+		}
+	}
+}
+
+/*
+TestConcreteConstructors verifies that the concrete-typed constructors agree with NewObjectIdentifier for the same input.
+*/
+func TestConcreteConstructors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		fn   func() (*ObjectIdentifier, error)
+		x    any
+		want string
+		err  error
+	}{
+		{`NewFromNaNF`, func() (*ObjectIdentifier, error) { return NewFromNaNF(`{ iso(1) 3 6 }`) },
+			`{ iso(1) 3 6 }`, `1.3.6`, nil},
+		{`NewFromNaNF empty`, func() (*ObjectIdentifier, error) { return NewFromNaNF(``) },
+			``, ``, ErrEmptyInput},
+		{`NewFromInts`, func() (*ObjectIdentifier, error) { return NewFromInts([]int{2, 5, 4, 3}) },
+			[]int{2, 5, 4, 3}, `2.5.4.3`, nil},
+		{`NewFromInts negative`, func() (*ObjectIdentifier, error) { return NewFromInts([]int{1, -3}) },
+			[]int{1, -3}, ``, ErrInvalidNumberForm},
+		{`NewFromInts empty`, func() (*ObjectIdentifier, error) { return NewFromInts(nil) },
+			[]int{}, ``, ErrEmptyInput},
+		{`NewFromInts root`, func() (*ObjectIdentifier, error) { return NewFromInts([]int{3, 1}) },
+			[]int{3, 1}, ``, ErrInvalidRoot},
+	} {
+		o, err := tc.fn()
+		p, perr := NewObjectIdentifier(tc.x)
+		if !errors.Is(err, tc.err) || !errors.Is(perr, tc.err) {
+			t.Errorf("%s: got errors %v and %v, want %v", tc.name, err, perr, tc.err)
+		} else if err == nil && (o.DotNotation() != tc.want || !o.Equal(p)) {
+			t.Errorf("%s: got %s and %s, want %s", tc.name, o.DotNotation(), p.DotNotation(), tc.want)
+		}
+	}
+
+	if o, err := NewFromDot(`1.3.6.1.4.1.56521`); err != nil || o.String() != `{ 1 3 6 1 4 1 56521 }` {
+		t.Errorf("NewFromDot: got %v (%v)", o, err)
+	}
+}
+
+func TestASN1E(t *testing.T) {
+	for _, tc := range []struct {
+		dot  string
+		want asn1.ObjectIdentifier
+		err  error
+	}{
+		{`1.3.6.1.4.1.56521`, asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 56521}, nil},
+		{`2.25.329800735698586629295641978511506172918`, nil, ErrInvalidNumberForm},
+		{`2.25.18446744073709551615`, nil, ErrInvalidNumberForm},
+		{``, nil, ErrInvalidRoot},
+	} {
+		var o *ObjectIdentifier
+		if len(tc.dot) > 0 {
+			o = mustDot(t, tc.dot)
+		}
+
+		a, err := o.ASN1E()
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.dot, err, tc.err)
+		} else if !a.Equal(tc.want) {
+			t.Errorf("%q: got %v, want %v", tc.dot, a, tc.want)
+		}
+	}
+}
+
+func TestAltNames(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		opts   []Option
+		set    []string
+		remove string
+		want   []string
+		err    error
+	}{
+		{`dedup`, nil, []string{`serverAuth`, ``, `SERVERAUTH`, `tlsServer`, `serverAuth`}, ``,
+			[]string{`serverAuth`, `tlsServer`}, nil},
+		{`principal`, nil, []string{`id-kp-serverAuth`, `serverAuth`}, ``,
+			[]string{`serverAuth`}, nil},
+		{`no folding`, []Option{WithoutNameFolding()}, []string{`serverAuth`, `SERVERAUTH`}, ``,
+			[]string{`serverAuth`, `SERVERAUTH`}, nil},
+		{`remove`, nil, []string{`serverAuth`, `tlsServer`}, `TLSSERVER`,
+			[]string{`serverAuth`}, nil},
+		{`descriptor`, []Option{WithDescriptorAltNames()}, []string{`serverAuth`, `server auth`}, ``,
+			nil, ErrInvalidIdentifier},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			o, err := NewObjectIdentifier(`{ 1 3 6 1 5 5 7 3 1 }`, tc.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			o.SetName(`id-kp-serverAuth`)
+
+			if err = o.SetAltNames(tc.set...); !errors.Is(err, tc.err) {
+				t.Fatalf("SetAltNames: got error %v, want %v", err, tc.err)
+			}
+			if len(tc.remove) > 0 && !o.RemoveAltName(tc.remove) {
+				t.Errorf("RemoveAltName(%q): got false", tc.remove)
+			}
+
+			got := o.AltNames()
+			if len(got) != len(tc.want) || o.AltNamesLen() != len(tc.want) {
+				t.Fatalf("AltNames: got %v, want %v", got, tc.want)
+			}
+			for i := 0; i < len(got); i++ {
+				if got[i] != tc.want[i] || !o.HasAltName(tc.want[i]) {
+					t.Errorf("AltNames: got %v, want %v", got, tc.want)
+				}
+			}
+			if len(tc.remove) > 0 && o.HasAltName(tc.remove) {
+				t.Errorf("HasAltName(%q): got true following removal", tc.remove)
+			}
+
+			// the slice is owned by the caller
+			if len(got) > 0 {
+				got[0] = `modified`
+				if o.HasAltName(`modified`) || o.AltNames()[0] != tc.want[0] {
+					t.Errorf("AltNames: modifying the result altered the receiver")
+				}
+			}
+		})
+	}
+}
+
+func TestNewObjectIdentifierEmpty(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		x    any
+		opts []Option
+		err  error
+	}{
+		{`nil`, nil, nil, ErrEmptyInput},
+		{`string`, ``, nil, ErrEmptyInput},
+		{`spaces`, `   `, nil, ErrEmptyInput},
+		{`braces`, `{ }`, nil, ErrEmptyInput},
+		{`ints`, []int{}, nil, ErrEmptyInput},
+		{`strings`, []string{}, nil, ErrEmptyInput},
+		{`any`, []any{}, nil, ErrEmptyInput},
+		{`options`, ``, []Option{WithStrict()}, ErrEmptyInput},
+		{`unsupported`, 3.14, nil, ErrUnsupportedType},
+	} {
+		o, err := NewObjectIdentifier(tc.x, tc.opts...)
+		if !errors.Is(err, tc.err) || o != nil {
+			t.Errorf("%s: got %v (%v), want %v", tc.name, o, err, tc.err)
+		}
+	}
+}
+
+func TestStrings(t *testing.T) {
+	for _, tc := range []struct {
+		in      any
+		strs    []string
+		numbers []string
+	}{
+		{`{ iso(1) identified-organization(3) dod(6) 1 }`, []string{`iso(1)`, `identified-organization(3)`, `dod(6)`, `1`}, []string{`1`, `3`, `6`, `1`}},
+		{[]int{2, 5, 4, 3}, []string{`2`, `5`, `4`, `3`}, []string{`2`, `5`, `4`, `3`}},
+		{`{ 2 25 329800735698586629295641978511506172918 }`, []string{`2`, `25`, `329800735698586629295641978511506172918`}, []string{`2`, `25`, `329800735698586629295641978511506172918`}},
+	} {
+		o, err := NewObjectIdentifier(tc.in)
+		if err != nil {
+			t.Fatalf("%v: %v", tc.in, err)
+		}
+
+		strs, numbers := o.Strings(), o.NumberStrings()
+		if fmt.Sprint(strs) != fmt.Sprint(tc.strs) {
+			t.Errorf("%v: Strings got %q, want %q", tc.in, strs, tc.strs)
+		}
+		if fmt.Sprint(numbers) != fmt.Sprint(tc.numbers) {
+			t.Errorf("%v: NumberStrings got %q, want %q", tc.in, numbers, tc.numbers)
+		}
+
+		// both must survive a round trip through the constructor
+		if p, err := NewObjectIdentifier(strs); err != nil {
+			t.Errorf("%q: %v", strs, err)
+		} else if !p.Equal(o) {
+			t.Errorf("%q: got %s, want %s", strs, p, o)
+		}
+		if p, err := NewObjectIdentifier(numbers); err != nil {
+			t.Errorf("%q: %v", numbers, err)
+		} else if p.DotNotation() != o.DotNotation() {
+			t.Errorf("%q: got %s, want %s", numbers, p.DotNotation(), o.DotNotation())
+		}
+	}
+
+	var nilOID *ObjectIdentifier
+	if nilOID.Strings() != nil || nilOID.NumberStrings() != nil {
+		t.Errorf("nil receiver: got non-nil slices")
+	}
+}
@@ -10,6 +10,10 @@ func (o ObjectIdentifierMap) Exists(term any) (exists bool) {
 }
 
 func (o ObjectIdentifierMap) Set(key string, x *ObjectIdentifier) {
+	if o == nil {
+		return
+	}
+
 	mut := &sync.Mutex{}
 	mut.Lock()
 	defer mut.Unlock()
@@ -17,10 +21,15 @@ func (o ObjectIdentifierMap) Set(key string, x *ObjectIdentifier) {
 	o[key] = x
 }
 
-func (o ObjectIdentifierMap) New(key, nanf string) (err error) {
+func (o ObjectIdentifierMap) New(key, nanf string, opts ...Option) (err error) {
+	if o == nil {
+		err = errorw(ErrNilInstance, "%T is nil", o)
+		return
+	}
+
 	// create preliminary instance
 	var x *ObjectIdentifier
-	if x, err = NewObjectIdentifier(nanf); err != nil {
+	if x, err = NewObjectIdentifier(nanf, opts...); err != nil {
 		return
 	}
 
@@ -35,7 +44,7 @@ func (o ObjectIdentifierMap) New(key, nanf string) (err error) {
 func (o ObjectIdentifierMap) Get(term any) (*ObjectIdentifier, bool) {
 	for k, v := range o {
 		// lookup various forms of oid and asn1
-		if v.Equal(term) {
+		if !v.IsZero() && v.Equal(term) {
 			return v, !v.IsZero()
 		}
 
@@ -0,0 +1,46 @@
+package oid
+
+import (
+	"strconv"
+	"testing"
+)
+
+/*
+benchMap returns an ObjectIdentifierMap of n entries keyed by dotNotation, each bearing a principal name.
+*/
+func benchMap(b *testing.B, n int) (m ObjectIdentifierMap, dots []string) {
+	dots = benchDots(n)
+	m = make(ObjectIdentifierMap, n)
+	for i := 0; i < n; i++ {
+		o, err := NewFromDot(dots[i])
+		if err != nil {
+			b.Fatal(err)
+		}
+		o.SetName(`entry` + strconv.Itoa(i))
+		m[dots[i]] = o
+	}
+
+	return
+}
+
+func BenchmarkObjectIdentifierMapGet(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		m, dots := benchMap(b, n)
+		b.Run(`dot/`+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, found := m.Get(dots[i%n]); !found {
+					b.Fatal("not found")
+				}
+			}
+		})
+		b.Run(`name/`+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, found := m.Get(`entry` + strconv.Itoa(i%n)); !found {
+					b.Fatal("not found")
+				}
+			}
+		})
+	}
+}
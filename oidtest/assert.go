@@ -0,0 +1,108 @@
+package oidtest
+
+/*
+assert.go deals with assertions upon ObjectIdentifier instances, which report failures to a testing.TB in the manner of RoundTrip.
+*/
+
+import (
+	"testing"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+/*
+AssertEqual reports a failure to t should got not match want, returning a Boolean value indicative of whether it matched. If want is an *oid.ObjectIdentifier or oid.ObjectIdentifier, only number forms are compared, such that differing names do not cause a failure; any other type is compared by way of the Equal method of got, e.g.:
+
+	oidtest.AssertEqual(t, o, `1.3.6.1.4.1.56521`)
+	oidtest.AssertEqual(t, o, []int{1, 3, 6, 1, 4, 1, 56521})
+	oidtest.AssertEqual(t, o, oidtest.ServerAuth())
+*/
+func AssertEqual(t testing.TB, got *oid.ObjectIdentifier, want any) (ok bool) {
+	t.Helper()
+
+	var desc any = want
+	switch tv := want.(type) {
+	case *oid.ObjectIdentifier:
+		ok = !got.IsZero() && got.Valid() && got.DotNotation() == tv.DotNotation()
+		desc = describe(tv)
+	case oid.ObjectIdentifier:
+		ok = !got.IsZero() && got.Valid() && got.DotNotation() == tv.DotNotation()
+		desc = describe(&tv)
+	default:
+		ok = !got.IsZero() && got.Equal(want)
+	}
+
+	if !ok {
+		t.Errorf("AssertEqual: got %s, want %v", describe(got), desc)
+	}
+
+	return
+}
+
+/*
+AssertUnder reports a failure to t should got not be equal to, or a descendant of, prefix, returning a Boolean value indicative of whether it was. Only number forms are compared. prefix may be an *oid.ObjectIdentifier or oid.ObjectIdentifier, such as oid.PKIX(), any string form accepted by oid.ParseAny, or any other type accepted by oid.NewObjectIdentifier, e.g.:
+
+	oidtest.AssertUnder(t, o, oid.Enterprise())
+	oidtest.AssertUnder(t, o, `1.3.6.1.4.1.56521`)
+*/
+func AssertUnder(t testing.TB, got *oid.ObjectIdentifier, prefix any) (ok bool) {
+	t.Helper()
+
+	base, err := toOID(prefix)
+	if err != nil {
+		t.Errorf("AssertUnder: bad prefix %v: %v", prefix, err)
+		return
+	}
+
+	spec := oid.SubtreeSpec{Base: base}
+	if ok = spec.Matches(got); !ok {
+		t.Errorf("AssertUnder: %s is not within %s", describe(got), base.DotNotation())
+	}
+
+	return
+}
+
+/*
+MustParse returns the *oid.ObjectIdentifier described by x, which may be of any type accepted by AssertUnder as a prefix, stopping the test by way of t.Fatalf should x be invalid.
+*/
+func MustParse(t testing.TB, x any) (o *oid.ObjectIdentifier) {
+	t.Helper()
+
+	var err error
+	if o, err = toOID(x); err != nil {
+		t.Fatalf("MustParse %v: %v", x, err)
+	}
+
+	return
+}
+
+/*
+toOID returns the *oid.ObjectIdentifier described by x alongside an error. Strings are parsed by way of oid.ParseAny, and other types by way of oid.NewObjectIdentifier.
+*/
+func toOID(x any) (o *oid.ObjectIdentifier, err error) {
+	switch tv := x.(type) {
+	case *oid.ObjectIdentifier:
+		if o = tv; o.IsZero() || !o.Valid() {
+			o, err = nil, oid.ErrInvalidRoot
+		}
+	case oid.ObjectIdentifier:
+		o, err = oid.NewFromDot(tv.DotNotation())
+	case string:
+		o, err = oid.ParseAny(tv)
+	default:
+		o, err = oid.NewObjectIdentifier(x)
+	}
+
+	return
+}
+
+/*
+describe returns the dotNotation of o for use within failure messages, or "<nil>" should o be nil.
+*/
+func describe(o *oid.ObjectIdentifier) string {
+	if o.IsZero() {
+		return `<nil>`
+	}
+
+	return o.DotNotation()
+}
@@ -0,0 +1,101 @@
+package oidtest
+
+import (
+	"testing"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+func TestAssertEqual(t *testing.T) {
+	named := ServerAuth()
+	named.SetName(`serverAuth`)
+
+	for _, tc := range []struct {
+		name string
+		got  *oid.ObjectIdentifier
+		want any
+		ok   bool
+	}{
+		{`dotNotation`, ServerAuth(), `1.3.6.1.5.5.7.3.1`, true},
+		{`ints`, ServerAuth(), []int{1, 3, 6, 1, 5, 5, 7, 3, 1}, true},
+		{`names disregarded`, named, ServerAuth(), true},
+		{`value`, ServerAuth(), *ServerAuth(), true},
+		{`mismatch`, ServerAuth(), ClientAuth(), false},
+		{`mismatched string`, ServerAuth(), `1.3.6.1.5.5.7.3.2`, false},
+		{`nil`, nil, `1.3.6.1.5.5.7.3.1`, false},
+	} {
+		r := &recorder{TB: t}
+		if ok := AssertEqual(r, tc.got, tc.want); ok != tc.ok || (len(r.errors) == 0) != tc.ok {
+			t.Errorf("%s: got %t with failures %q, want %t", tc.name, ok, r.errors, tc.ok)
+		}
+	}
+}
+
+func TestAssertUnder(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		got    *oid.ObjectIdentifier
+		prefix any
+		ok     bool
+	}{
+		{`Enterprise`, MustParse(t, `1.3.6.1.4.1.56521`), oid.Enterprise(), true},
+		{`self`, Example(), Example(), true},
+		{`string`, Example(), `2.999`, true},
+		{`ints`, ServerAuth(), []int{1, 3, 6, 1}, true},
+		{`value`, ServerAuth(), *Internet(), true},
+		{`outside`, CommonName(), oid.Enterprise(), false},
+		{`sibling`, Example(), `2.998`, false},
+		{`ancestor`, Internet(), ServerAuth(), false},
+		{`bad prefix`, Example(), `bogus!`, false},
+		{`nil`, nil, oid.Enterprise(), false},
+	} {
+		r := &recorder{TB: t}
+		if ok := AssertUnder(r, tc.got, tc.prefix); ok != tc.ok || (len(r.errors) == 0) != tc.ok {
+			t.Errorf("%s: got %t with failures %q, want %t", tc.name, ok, r.errors, tc.ok)
+		}
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	for _, tc := range []struct {
+		x     any
+		dot   string
+		fatal bool
+	}{
+		{`1.3.6.1`, `1.3.6.1`, false},
+		{`{ iso(1) 3 }`, `1.3`, false},
+		{[]int{2, 5, 4, 3}, `2.5.4.3`, false},
+		{*Internet(), `1.3.6.1`, false},
+		{`bogus!`, ``, true},
+		{(*oid.ObjectIdentifier)(nil), ``, true},
+	} {
+		r := &recorder{TB: t}
+		if o := MustParse(r, tc.x); r.fatal != tc.fatal || o.DotNotation() != tc.dot {
+			t.Errorf("MustParse(%v): got %s (fatal %t), want %s (fatal %t)", tc.x, o.DotNotation(), r.fatal, tc.dot, tc.fatal)
+		}
+	}
+}
+
+func TestFixtures(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		fn   func() *oid.ObjectIdentifier
+		dot  string
+	}{
+		{`Internet`, Internet, `1.3.6.1`},
+		{`ServerAuth`, ServerAuth, `1.3.6.1.5.5.7.3.1`},
+		{`ClientAuth`, ClientAuth, `1.3.6.1.5.5.7.3.2`},
+		{`CommonName`, CommonName, `2.5.4.3`},
+		{`RSAEncryption`, RSAEncryption, `1.2.840.113549.1.1.1`},
+		{`SHA256`, SHA256, `2.16.840.1.101.3.4.2.1`},
+		{`Example`, Example, `2.999`},
+		{`UUID`, UUID, `2.25.329800735698586629295641978511506172918`},
+	} {
+		a, b := tc.fn(), tc.fn()
+		if !AssertEqual(t, a, tc.dot) {
+			continue
+		} else if a == b {
+			t.Errorf("%s: instances are shared", tc.name)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package oidtest contains helpers for the test suites of packages that use package oid, such as those embedding *oid.ObjectIdentifier within their own types, e.g.:
+
+	func TestPolicyOID(t *testing.T) {
+		o, _ := oid.NewFromDot(`1.3.6.1.4.1.56521.1.1`)
+		oidtest.RoundTrip(t, o)
+		oidtest.AssertUnder(t, o, oid.Enterprise())
+	}
+
+Assertions such as AssertEqual and AssertUnder return a Boolean value indicative of success, allowing a test to skip checks that depend upon them, while constructors such as ServerAuth and UUID provide fresh instances of commonly used OIDs and of those which exercise the edge cases of encoders. Unlike the shared instances of the WellKnown database of package oid, such as oid.CN(), these instances may be passed to SetName, SetAltNames and the like.
+
+Failures are reported through the testing.TB provided to each helper, thus this package is intended for import by _test.go files only.
+*/
+package oidtest
@@ -0,0 +1,63 @@
+package oidtest
+
+/*
+fixtures.go contains constructors for commonly used OIDs, as well as for those which exercise the edge cases of encoders and decoders. Each returns a new instance upon every call, bearing the names by which the OID is commonly known, such that tests may modify the result freely.
+*/
+
+import "github.com/JesseCoretta/go-oid"
+
+/*
+Internet returns the Internet arc, 1.3.6.1, per RFC 1155.
+*/
+func Internet() *oid.ObjectIdentifier {
+	return oid.Literal(`{ iso(1) identified-organization(3) dod(6) internet(1) }`, `internet`)
+}
+
+/*
+ServerAuth returns the id-kp-serverAuth extended key usage, 1.3.6.1.5.5.7.3.1, per RFC 5280.
+*/
+func ServerAuth() *oid.ObjectIdentifier {
+	return oid.Literal(`{ iso(1) identified-organization(3) dod(6) internet(1) security(5) mechanisms(5) pkix(7) kp(3) 1 }`, `id-kp-serverAuth`, `serverAuth`)
+}
+
+/*
+ClientAuth returns the id-kp-clientAuth extended key usage, 1.3.6.1.5.5.7.3.2, per RFC 5280.
+*/
+func ClientAuth() *oid.ObjectIdentifier {
+	return oid.Literal(`{ iso(1) identified-organization(3) dod(6) internet(1) security(5) mechanisms(5) pkix(7) kp(3) 2 }`, `id-kp-clientAuth`, `clientAuth`)
+}
+
+/*
+CommonName returns the cn attribute type, 2.5.4.3, per X.520.
+*/
+func CommonName() *oid.ObjectIdentifier {
+	return oid.Literal(`{ joint-iso-itu-t(2) ds(5) attributeType(4) 3 }`, `cn`, `commonName`, `id-at-commonName`)
+}
+
+/*
+RSAEncryption returns the rsaEncryption algorithm, 1.2.840.113549.1.1.1, per RFC 8017.
+*/
+func RSAEncryption() *oid.ObjectIdentifier {
+	return oid.Literal(`{ iso(1) member-body(2) us(840) rsadsi(113549) pkcs(1) pkcs-1(1) 1 }`, `rsaEncryption`)
+}
+
+/*
+SHA256 returns the id-sha256 hash algorithm, 2.16.840.1.101.3.4.2.1, per RFC 5754.
+*/
+func SHA256() *oid.ObjectIdentifier {
+	return oid.Literal(`{ joint-iso-itu-t(2) country(16) us(840) organization(1) gov(101) csor(3) nistAlgorithm(4) hashAlgs(2) 1 }`, `id-sha256`)
+}
+
+/*
+Example returns the example arc, 2.999, per ITU-T Rec. X.660. Its second arc exceeds 39, thus its first subidentifier spans two octets when DER encoded.
+*/
+func Example() *oid.ObjectIdentifier {
+	return oid.Literal(`{ joint-iso-itu-t(2) 999 }`, `example`)
+}
+
+/*
+UUID returns the UUID-based OID of ITU-T Rec. X.667, 2.25.329800735698586629295641978511506172918, whose final arc cannot be represented by a uint64.
+*/
+func UUID() *oid.ObjectIdentifier {
+	return oid.Literal(`{ joint-iso-itu-t(2) uuid(25) 329800735698586629295641978511506172918 }`, ``)
+}
@@ -0,0 +1,193 @@
+package oidtest
+
+/*
+roundtrip.go deals with RoundTrip, which verifies that an ObjectIdentifier survives each representation supported by package oid.
+*/
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"fmt"
+	"testing"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+/*
+roundTrip describes a single pair of functions by which an ObjectIdentifier is represented and recovered. The recovered instance is compared with the original by way of same.
+*/
+type roundTrip struct {
+	name string
+	fn   func(*oid.ObjectIdentifier) (*oid.ObjectIdentifier, error)
+	same func(a, b *oid.ObjectIdentifier) bool
+}
+
+var roundTrips = []roundTrip{
+	{`JSON`, viaJSON, sameJSON},
+	{`NameAndNumberForm`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.NewFromNaNF(o.StringAs(oid.FormNaNF))
+	}, sameNaNF},
+	{`dotNotation`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.NewFromDot(o.DotNotation())
+	}, sameDot},
+	{`URN`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.ParseURN(o.URN())
+	}, sameDot},
+	{`OID-IRI`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.ParseIRI(o.IRI())
+	}, sameDot},
+	{`Strings`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.NewObjectIdentifier(o.Strings())
+	}, sameStrings},
+	{`NumberStrings`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.NewObjectIdentifier(o.NumberStrings())
+	}, sameDot},
+	{`ParseAny`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.ParseAny(o.DotNotation())
+	}, sameDot},
+}
+
+/*
+derRoundTrips are exercised only for those ObjectIdentifier instances which can be DER encoded.
+*/
+var derRoundTrips = []roundTrip{
+	{`DER`, func(o *oid.ObjectIdentifier) (*oid.ObjectIdentifier, error) {
+		return oid.NewFromDER(o.AppendDER(nil, true))
+	}, sameDot},
+	{`DER SEQUENCE`, viaDERSequence, sameDot},
+}
+
+/*
+RoundTrip verifies that o survives each pair of representation and recovery supported by package oid, reporting a failure to t for each pair that does not yield an equivalent instance. The pairs exercised are:
+
+  - MarshalJSON and UnmarshalJSON, compared by their JSON output
+  - StringAs(FormNaNF) and NewFromNaNF, compared by NameAndNumberForm sequence
+  - DotNotation and NewFromDot
+  - URN and ParseURN
+  - IRI and ParseIRI
+  - Strings and NewObjectIdentifier, compared by Strings
+  - NumberStrings and NewObjectIdentifier
+  - DotNotation and ParseAny
+  - AppendDER and NewFromDER, should o be DER encodable
+  - MarshalDERSequence and ParseDERSequence, should o be DER encodable
+  - ASN1E and NewFromInts, should each arc of o fit within an int
+
+The DER encoding of o is also compared with that produced by encoding/asn1 where possible. Pairs compared by number form alone disregard names, as the representations in question do not carry them.
+
+Instances constructed using CasePreserve, and which bear identifiers with an uppercase first character, fail those pairs that recover by way of the default constructors.
+*/
+func RoundTrip(t testing.TB, o *oid.ObjectIdentifier) {
+	t.Helper()
+
+	if o.IsZero() || !o.Valid() {
+		t.Errorf("RoundTrip: %v is not a valid OID", o)
+		return
+	}
+
+	pairs := roundTrips
+	if o.EncodedLen() >= 0 {
+		pairs = append(append([]roundTrip{}, roundTrips...), derRoundTrips...)
+	}
+
+	for i := 0; i < len(pairs); i++ {
+		got, err := pairs[i].fn(o)
+		if err != nil {
+			t.Errorf("RoundTrip %s of %s: %v", pairs[i].name, o.DotNotation(), err)
+		} else if !pairs[i].same(o, got) {
+			t.Errorf("RoundTrip %s of %s: got %s", pairs[i].name, o.StringAs(oid.FormNaNF), got.StringAs(oid.FormNaNF))
+		}
+	}
+
+	roundTripASN1(t, o)
+}
+
+/*
+roundTripASN1 verifies that o survives ASN1E and NewFromInts, and that its DER encoding agrees with that of encoding/asn1, provided that each of its arcs fit within an int.
+*/
+func roundTripASN1(t testing.TB, o *oid.ObjectIdentifier) {
+	t.Helper()
+
+	a, err := o.ASN1E()
+	if err != nil {
+		return
+	}
+
+	if got, err := oid.NewFromInts([]int(a)); err != nil {
+		t.Errorf("RoundTrip ASN1E of %s: %v", o.DotNotation(), err)
+	} else if !sameDot(o, got) {
+		t.Errorf("RoundTrip ASN1E of %s: got %s", o.DotNotation(), got.DotNotation())
+	}
+
+	if o.EncodedLen() < 0 {
+		return
+	}
+
+	if want, err := asn1.Marshal(a); err == nil && !bytes.Equal(o.AppendDER(nil, true), want) {
+		t.Errorf("RoundTrip DER of %s: got %x, encoding/asn1 produced %x", o.DotNotation(), o.AppendDER(nil, true), want)
+	}
+}
+
+func viaJSON(o *oid.ObjectIdentifier) (got *oid.ObjectIdentifier, err error) {
+	var b []byte
+	if b, err = o.MarshalJSON(); err != nil {
+		return
+	}
+
+	got = new(oid.ObjectIdentifier)
+	if err = got.UnmarshalJSON(b); err != nil {
+		got = nil
+	}
+
+	return
+}
+
+func viaDERSequence(o *oid.ObjectIdentifier) (got *oid.ObjectIdentifier, err error) {
+	var b []byte
+	if b, err = oid.MarshalDERSequence([]*oid.ObjectIdentifier{o, o}); err != nil {
+		return
+	}
+
+	var oids []*oid.ObjectIdentifier
+	if oids, err = oid.ParseDERSequence(b); err != nil {
+		return
+	} else if len(oids) != 2 || !sameDot(oids[0], oids[1]) {
+		err = fmt.Errorf("DER SEQUENCE bearing two copies of %s yielded %v", o.DotNotation(), oids)
+		return
+	}
+
+	got = oids[0]
+	return
+}
+
+func sameJSON(a, b *oid.ObjectIdentifier) bool {
+	x, err := a.MarshalJSON()
+	if err != nil {
+		return false
+	}
+
+	y, err := b.MarshalJSON()
+	return err == nil && bytes.Equal(x, y)
+}
+
+func sameNaNF(a, b *oid.ObjectIdentifier) bool {
+	return a.StringAs(oid.FormNaNF) == b.StringAs(oid.FormNaNF)
+}
+
+func sameStrings(a, b *oid.ObjectIdentifier) bool {
+	x, y := a.Strings(), b.Strings()
+	if len(x) != len(y) {
+		return false
+	}
+
+	for i := 0; i < len(x); i++ {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sameDot(a, b *oid.ObjectIdentifier) bool {
+	return a.DotNotation() == b.DotNotation()
+}
@@ -0,0 +1,62 @@
+package oidtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+/*
+recorder is a testing.TB which records failures rather than reporting them, allowing the helpers of this package to be tested for the failures they report.
+*/
+type recorder struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	r.fatal = true
+}
+
+func TestRoundTrip(t *testing.T) {
+	named, _ := oid.NewFromNaNF(`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 }`)
+	named.SetName(`example`)
+	named.SetDescription(`Example arc`)
+	preserved, err := oid.NewObjectIdentifier(`{ iso(1) identified-organization(3) TeleTrust(36) }`, oid.WithCasePolicy(oid.CasePreserve))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		o    *oid.ObjectIdentifier
+		fail bool
+	}{
+		{`Internet`, Internet(), false},
+		{`ServerAuth`, ServerAuth(), false},
+		{`CommonName`, CommonName(), false},
+		{`SHA256`, SHA256(), false},
+		{`UUID`, UUID(), false},
+		{`named`, named, false},
+		{`root arc`, MustParse(t, []int{2}), false},
+		{`CasePreserve`, preserved, true},
+		{`nil`, nil, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &recorder{TB: t}
+			RoundTrip(r, tc.o)
+			if (len(r.errors) > 0) != tc.fail {
+				t.Errorf("got failures %q, want failure %t", r.errors, tc.fail)
+			}
+		})
+	}
+}
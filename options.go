@@ -0,0 +1,405 @@
+package oid
+
+/*
+options.go contains the functional options accepted by NewObjectIdentifier, the ObjectIdentifierMap New method and NewRegistry.
+*/
+
+import "context"
+
+/*
+Option is a functional option that alters the behavior of an ObjectIdentifier constructor.
+*/
+type Option func(*options)
+
+/*
+options contains the settings assembled from zero or more Option instances.
+*/
+type options struct {
+	strict   bool
+	noFold   bool
+	descr    bool
+	dots     bool
+	spaces   bool
+	roots    bool
+	arcNames bool
+	named    bool
+	cases    CasePolicy
+	parents  *ParentConstraint
+	dup      DuplicatePolicy
+	infer    Resolver
+	limits   Limits
+	resolver Resolver
+	ctx      context.Context
+}
+
+/*
+Limits contains bounds imposed upon constructor input, which is useful when parsing OIDs from untrusted sources. A zero value for any field means no limit is imposed.
+*/
+type Limits struct {
+	// MaxInputLen is the maximum length of string input.
+	MaxInputLen int
+
+	// MaxArcs is the maximum number of arcs.
+	MaxArcs int
+
+	// MaxArcBits is the maximum bit length of any single number form.
+	MaxArcBits int
+}
+
+/*
+check returns an error should o exceed the MaxArcs or MaxArcBits limits of the receiver.
+*/
+func (l Limits) check(o *ObjectIdentifier) (err error) {
+	arcs := o.arcs()
+	if l.MaxArcs > 0 && len(arcs) > l.MaxArcs {
+		err = errorw(ErrLimitExceeded, "Arc count %d exceeds limit of %d", len(arcs), l.MaxArcs)
+		return
+	}
+
+	for i := 0; l.MaxArcBits > 0 && i < len(arcs); i++ {
+		if arcs[i].Big().BitLen() > l.MaxArcBits {
+			err = errorw(ErrLimitExceeded, "Arc #%d (%s) exceeds limit of %d bits", i, arcs[i].number(), l.MaxArcBits)
+			return
+		}
+	}
+
+	return
+}
+
+/*
+CasePolicy describes the treatment of identifiers whose first character is an uppercase letter, such as "TeleTrust", which ITU-T Rec. X.680 forbids but which are found within real-world data.
+*/
+type CasePolicy uint8
+
+const (
+	CaseStrict   CasePolicy = iota // reject such identifiers, per X.680 (default)
+	CaseLenient                    // accept such identifiers, lowercasing the first character, e.g. TeleTrust becomes teleTrust
+	CasePreserve                   // accept such identifiers verbatim, e.g. TeleTrust remains TeleTrust
+)
+
+/*
+String returns the string name of the receiver, e.g. "strict".
+*/
+func (p CasePolicy) String() string {
+	switch p {
+	case CaseLenient:
+		return `lenient`
+	case CasePreserve:
+		return `preserve`
+	}
+	return `strict`
+}
+
+/*
+WithStrict enforces the rules of ITU-T Rec. X.660 and X.680 that are not otherwise checked: number forms may not bear leading zeros, the second arc may not exceed 39 beneath the itu-t(0) and iso(1) root arcs and identifiers may not contain consecutive hyphens, including those assigned by WithResolver or WithInferredNames. Identifiers may never end with a hyphen, regardless of this option.
+*/
+func WithStrict() Option {
+	return func(opts *options) {
+		opts.strict = true
+	}
+}
+
+/*
+WithResolver instructs the constructor to consult r for the identifier of any arc which lacks one, e.g. when parsing bare numeric input. Arcs for which r has no answer are left unnamed.
+*/
+func WithResolver(r Resolver) Option {
+	return func(opts *options) {
+		opts.resolver = r
+	}
+}
+
+/*
+WithLimits imposes the bounds described by l upon constructor input.
+*/
+func WithLimits(l Limits) Option {
+	return func(opts *options) {
+		opts.limits = l
+	}
+}
+
+/*
+WithoutNameFolding instructs the resulting ObjectIdentifier to compare alt names in a case-sensitive manner within its Equal method, rather than the default case-folded manner.
+*/
+func WithoutNameFolding() Option {
+	return func(opts *options) {
+		opts.noFold = true
+	}
+}
+
+/*
+WithDescriptorAltNames instructs the resulting ObjectIdentifier to reject, within its SetAltNames method, any alt name that does not qualify as a descriptor per RFC 4512.
+*/
+func WithDescriptorAltNames() Option {
+	return func(opts *options) {
+		opts.descr = true
+	}
+}
+
+/*
+WithLenientDots instructs the constructor to accept string input in dotNotation, tolerating a single leading dot, as emitted by SNMP tooling (e.g. .1.3.6.1.2.1), and a single trailing dot, as found in sloppy configuration files (e.g. 1.3.6.1.2.1.). Such input is normalized to plain dotNotation.
+
+This option has no effect when WithStrict is also in effect, in which case such input continues to be rejected.
+*/
+func WithLenientDots() Option {
+	return func(opts *options) {
+		opts.dots = true
+	}
+}
+
+/*
+WithLenientSpacing instructs the constructor to accept ASN.1 NameAndNumberForm sequences bearing whitespace within an arc, as found within ITU-T documents and scanned specifications, e.g.:
+
+	{ iso (1) identified-organization( 3 ) dod ( 6 ) }
+
+Such spacing is permitted by ITU-T Rec. X.680, which allows whitespace between lexical items. ASN.1 comments are likewise tolerated. Input is read using a Lexer; see NewFromTokens.
+*/
+func WithLenientSpacing() Option {
+	return func(opts *options) {
+		opts.spaces = true
+	}
+}
+
+/*
+WithRootNameValidation instructs the constructor to verify that the identifier of the root arc, when present, is one sanctioned by ITU-T Rec. X.660 for its number form: itu-t or ccitt (or itu-r) for 0, iso for 1 and joint-iso-itu-t or joint-iso-ccitt for 2. Input such as `{ frobozz(1) 3 }` is rejected, whereas `{ 1 3 }` is not.
+
+The identifier of the second arc, when present, is likewise verified should its number form bear a standard identifier, e.g. member-body(2) beneath iso(1), thus `{ iso(1) member-body(3) }` is rejected.
+*/
+func WithRootNameValidation() Option {
+	return func(opts *options) {
+		opts.roots = true
+	}
+}
+
+/*
+WithStandardArcNames instructs the constructor to assign the standard identifier of the root arc and second arc where either lacks one, e.g. 1.2.840 yields { iso(1) member-body(2) 840 }.
+*/
+func WithStandardArcNames() Option {
+	return func(opts *options) {
+		opts.arcNames = true
+	}
+}
+
+/*
+WithInferredNames instructs the constructor to populate the identifier of every recognizable arc lacking one, such that String immediately produces a readable NameAndNumberForm sequence, e.g. 1.3.6.1.4.1.311 yields:
+
+	{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) microsoft(311) }
+
+The root and second arcs are named in the manner of WithStandardArcNames, and all remaining arcs are looked up using each of r in turn. If none are provided, the golden corpus (see GoldenCorpus) is consulted, as it bears the standard identifier of each arc, followed by the WellKnown database. A *Registry may be provided alongside WellKnown to recognize an organization's own arcs. Arcs which are not recognized are left unnamed.
+
+Plain dotNotation string input, e.g. `1.3.6.1.4.1.311`, is accepted when this option is in effect.
+*/
+func WithInferredNames(r ...Resolver) Option {
+	return func(opts *options) {
+		opts.arcNames = true
+		if len(r) == 0 {
+			opts.infer = defaultInference()
+		} else {
+			opts.infer = resolverChain(r)
+		}
+	}
+}
+
+/*
+WithRequiredNames instructs the constructor to reject any ObjectIdentifier bearing an arc that lacks an identifier, such that only fully-named NameAndNumberForm sequences are accepted, e.g. `{ iso(1) identified-organization(3) dod(6) }` is accepted whereas `{ iso(1) 3 dod(6) }` is not.
+
+This requirement is enforced after any identifiers have been assigned by WithStandardArcNames, WithResolver or WithInferredNames.
+*/
+func WithRequiredNames() Option {
+	return func(opts *options) {
+		opts.named = true
+	}
+}
+
+/*
+WithCasePolicy instructs the constructor to treat identifiers whose first character is an uppercase letter in the manner described by p, e.g.:
+
+	o, err := oid.NewObjectIdentifier(`{ iso(1) identified-organization(3) TeleTrust(36) }`, oid.WithCasePolicy(oid.CaseLenient))
+	// o.String() yields { iso(1) identified-organization(3) teleTrust(36) }
+
+CaseLenient produces identifiers that conform to X.680, and thus survive a round trip through the default constructor, whereas CasePreserve retains the original spelling for output at the expense of such conformance. All other characters are validated as usual regardless of p.
+*/
+func WithCasePolicy(p CasePolicy) Option {
+	return func(opts *options) {
+		opts.cases = p
+	}
+}
+
+/*
+WithAllowedParents instructs the constructor to reject any ObjectIdentifier not permitted by c, i.e. one that is neither equal to, nor a descendant of, any of its bases, with an error wrapping ErrDisallowedParent. When passed to NewRegistry, c is used as the ParentConstraint of the resulting *Registry; see its SetParentConstraint method.
+*/
+func WithAllowedParents(c *ParentConstraint) Option {
+	return func(opts *options) {
+		opts.parents = c
+	}
+}
+
+/*
+WithDuplicatePolicy sets p as the DuplicatePolicy of the *Registry returned by NewRegistry; see its SetDuplicatePolicy method. This option has no effect upon ObjectIdentifier constructors.
+*/
+func WithDuplicatePolicy(p DuplicatePolicy) Option {
+	return func(opts *options) {
+		opts.dup = p
+	}
+}
+
+/*
+newOptions assembles an options instance from opts.
+*/
+func newOptions(opts ...Option) (o options) {
+	for i := 0; i < len(opts); i++ {
+		if opts[i] != nil {
+			opts[i](&o)
+		}
+	}
+
+	if o.ctx == nil {
+		o.ctx = context.Background()
+	}
+
+	return
+}
+
+/*
+checkInput enforces any limits that apply to constructor input prior to parsing.
+*/
+func (r options) checkInput(x any) (err error) {
+	var n int
+	switch tv := x.(type) {
+	case string:
+		if r.limits.MaxInputLen > 0 && len(tv) > r.limits.MaxInputLen {
+			err = errorw(ErrLimitExceeded, "Input length %d exceeds limit of %d", len(tv), r.limits.MaxInputLen)
+			return
+		}
+
+		if r.strict && hasLeadingZero(tv) {
+			err = errorw(ErrInvalidNumberForm, "Number form with leading zero in '%s' [hint: leading zeros are not permitted]", tv)
+			return
+		}
+		return
+	case []string:
+		n = len(tv)
+		for i := 0; i < len(tv) && r.strict; i++ {
+			if hasLeadingZero(tv[i]) {
+				err = errorw(ErrInvalidNumberForm, "Number form with leading zero in '%s' [hint: leading zeros are not permitted]", tv[i])
+				return
+			}
+		}
+	case []any:
+		n = len(tv)
+		for i := 0; i < len(tv) && r.strict; i++ {
+			if s, ok := tv[i].(string); ok && hasLeadingZero(s) {
+				err = errorw(ErrInvalidNumberForm, "Number form with leading zero in '%s' [hint: leading zeros are not permitted]", s)
+				return
+			}
+		}
+	case []int:
+		n = len(tv)
+	}
+
+	if r.limits.MaxArcs > 0 && n > r.limits.MaxArcs {
+		err = errorw(ErrLimitExceeded, "Arc count %d exceeds limit of %d", n, r.limits.MaxArcs)
+	}
+
+	return
+}
+
+/*
+lenientDot returns the normalized dotNotation form of x alongside a Boolean value indicative of whether x is a string that should be parsed as such under WithLenientDots or WithInferredNames.
+*/
+func (r options) lenientDot(x any) (dot string, ok bool) {
+	lenient := r.dots && !r.strict
+	if !lenient && r.infer == nil {
+		return
+	}
+
+	if dot, ok = x.(string); ok {
+		if lenient && len(dot) > 1 && dot[0] == '.' {
+			dot = dot[1:]
+		}
+		if lenient && len(dot) > 1 && dot[len(dot)-1] == '.' {
+			dot = dot[:len(dot)-1]
+		}
+		ok = isDotNotation(dot)
+	}
+
+	return
+}
+
+/*
+apply enforces any remaining options upon o following a successful parse.
+*/
+func (r options) apply(o *ObjectIdentifier) (err error) {
+	if err = r.limits.check(o); err != nil {
+		return
+	}
+
+	for i := 0; i < o.len(); i++ {
+		if id := o.nANF[i].identifier; r.cases == CaseLenient && len(id) > 0 && 'A' <= id[0] && id[0] <= 'Z' {
+			o.nANF[i].identifier = intern(string(id[0]+('a'-'A')) + id[1:])
+			o.invalidate()
+		}
+	}
+
+	if r.strict && o.len() > 1 && secondArcExceeds(o.nANF[0], o.nANF[1]) {
+		err = errorw(ErrInvalidNumberForm, "Second arc %s exceeds %d beneath root arc %d", o.nANF[1].number(), MaxSecondArc, o.nANF[0].primaryIdentifier)
+		return
+	}
+
+	if err = r.parents.Check(o); err != nil {
+		return
+	}
+
+	if r.roots {
+		if err = o.checkArcNames(); err != nil {
+			return
+		}
+	}
+
+	if r.arcNames {
+		o.setStandardArcNames()
+	}
+
+	if r.resolver != nil {
+		if err = o.resolveNames(r.ctx, r.resolver); err != nil {
+			return
+		}
+	}
+
+	if r.infer != nil {
+		if err = o.resolveNames(r.ctx, r.infer); err != nil {
+			return
+		}
+	}
+
+	// identifiers are checked once all have been assigned, such that
+	// those supplied by a Resolver are held to the same standard.
+	for i := 0; i < o.len(); i++ {
+		id := o.nANF[i].identifier
+		if r.named && len(id) == 0 {
+			err = errorw(ErrInvalidIdentifier, "Arc #%d (%s) lacks an identifier [hint: every arc must be named]", i, o.nANF[i].number())
+			return
+		} else if r.strict && contains(id, `--`) {
+			err = errorw(ErrInvalidIdentifier, "Bad identifier '%s' [hint: consecutive hyphens are not permitted; see NormalizeIdentifier]", id)
+			return
+		}
+	}
+
+	o.noFold = r.noFold
+	o.descrNames = r.descr
+
+	return
+}
+
+/*
+does 'val' contain a number with a leading zero?
+*/
+func hasLeadingZero(val string) bool {
+	for i := 0; i < len(val)-1; i++ {
+		if val[i] == '0' && '0' <= val[i+1] && val[i+1] <= '9' &&
+			(i == 0 || !('0' <= val[i-1] && val[i-1] <= '9')) {
+			return true
+		}
+	}
+
+	return false
+}
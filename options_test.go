@@ -0,0 +1,170 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+/*
+optionCase describes the expected outcome of the parsing of in using opts.
+*/
+type optionCase struct {
+	in   any
+	opts []Option
+	want string // the expected dotNotation, or a zero string upon error
+	err  error  // the expected error, if any
+}
+
+/*
+checkOptionCases parses each of cases, comparing the outcome with that expected.
+*/
+func checkOptionCases(t *testing.T, cases []optionCase) {
+	t.Helper()
+	for _, tc := range cases {
+		o, err := NewObjectIdentifier(tc.in, tc.opts...)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%v: got error %v, want %v", tc.in, err, tc.err)
+		} else if d := o.DotNotation(); err == nil && d != tc.want {
+			t.Errorf("%v: got %s, want %s", tc.in, d, tc.want)
+		}
+	}
+}
+
+func TestWithLenientDots(t *testing.T) {
+	lenient := []Option{WithLenientDots()}
+	checkOptionCases(t, []optionCase{
+		{`.1.3.6.1.2.1`, lenient, `1.3.6.1.2.1`, nil},
+		{`1.3.6.1.2.1.`, lenient, `1.3.6.1.2.1`, nil},
+		{`.1.3.6.1.2.1.`, lenient, `1.3.6.1.2.1`, nil},
+		{`1.3.6.1.2.1`, lenient, `1.3.6.1.2.1`, nil},
+		{`..1.3.6`, lenient, ``, ErrInvalidNumberForm},
+		{`.`, lenient, ``, ErrInvalidNumberForm},
+		{`.1.3.6.1.2.1`, nil, ``, ErrInvalidNumberForm},
+		{`.1.3.6.1.2.1`, []Option{WithLenientDots(), WithStrict()}, ``, ErrInvalidNumberForm},
+	})
+}
+
+func TestWithRootNameValidation(t *testing.T) {
+	roots := []Option{WithRootNameValidation()}
+	checkOptionCases(t, []optionCase{
+		{`{ iso(1) 3 6 }`, roots, `1.3.6`, nil},
+		{`{ 1 3 6 }`, roots, `1.3.6`, nil},
+		{`{ itu-t(0) 9 }`, roots, `0.9`, nil},
+		{`{ ccitt(0) 9 }`, roots, `0.9`, nil},
+		{`{ joint-iso-ccitt(2) 5 }`, roots, `2.5`, nil},
+		{`{ frobozz(1) 3 }`, roots, ``, ErrInvalidIdentifier},
+		{`{ iso(2) 5 }`, roots, ``, ErrInvalidIdentifier},
+		{[]string{`itu-t(1)`, `3`}, roots, ``, ErrInvalidIdentifier},
+		{`{ frobozz(1) 3 }`, nil, `1.3`, nil},
+	})
+}
+
+func TestSecondArcNames(t *testing.T) {
+	roots := []Option{WithRootNameValidation()}
+	checkOptionCases(t, []optionCase{
+		{`{ iso(1) member-body(2) 840 }`, roots, `1.2.840`, nil},
+		{`{ 1 identified-organization(3) 6 }`, roots, `1.3.6`, nil},
+		{`{ joint-iso-itu-t(2) mhs-motis(6) }`, roots, `2.6`, nil},
+		{`{ iso(1) member-body(3) }`, roots, ``, ErrInvalidIdentifier},
+		{`{ 2 example(5) }`, roots, ``, ErrInvalidIdentifier},
+		{`{ 1 frobozz(7) }`, roots, `1.7`, nil}, // no standard identifier
+	})
+
+	for _, tc := range []struct {
+		in   any
+		want string
+	}{
+		{[]string{`1`, `2`, `840`}, `{ iso(1) member-body(2) 840 }`},
+		{[]int{2, 999, 1}, `{ joint-iso-itu-t(2) example(999) 1 }`},
+		{[]int{0, 9, 2342}, `{ itu-t(0) data(9) 2342 }`},
+		{`{ 1 mine(3) 6 }`, `{ iso(1) mine(3) 6 }`},
+		{[]int{1, 7}, `{ iso(1) 7 }`},
+	} {
+		o, err := NewObjectIdentifier(tc.in, WithStandardArcNames())
+		if err != nil {
+			t.Errorf("%v: %v", tc.in, err)
+		} else if got := o.String(); got != tc.want {
+			t.Errorf("%v: got %s, want %s", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestWithInferredNames(t *testing.T) {
+	ex := mustDot(t, `1.3.6.1.4.1.56521`)
+	ex.SetName(`example`)
+	own := ObjectIdentifierMap{`example`: ex}
+
+	for _, tc := range []struct {
+		in   any
+		opts []Option
+		want string
+	}{
+		{`1.3.6.1.4.1.311`, []Option{WithInferredNames()},
+			`{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) microsoft(311) }`},
+		{[]int{2, 5, 4, 3}, []Option{WithInferredNames()},
+			`{ joint-iso-itu-t(2) ds(5) attributeType(4) commonName(3) }`},
+		{`{ 1 3 6 1 4 1 56521 7 }`, []Option{WithInferredNames(own)},
+			`{ iso(1) identified-organization(3) 6 1 4 1 example(56521) 7 }`},
+		{`{ 1 3 6 mine(1) }`, []Option{WithInferredNames()},
+			`{ iso(1) identified-organization(3) dod(6) mine(1) }`},
+		{`2.999.1`, []Option{WithInferredNames()},
+			`{ joint-iso-itu-t(2) example(999) 1 }`},
+	} {
+		o, err := NewObjectIdentifier(tc.in, tc.opts...)
+		if err != nil {
+			t.Errorf("%v: %v", tc.in, err)
+		} else if s := o.String(); s != tc.want {
+			t.Errorf("%v: got %s, want %s", tc.in, s, tc.want)
+		}
+	}
+}
+
+func TestWithRequiredNames(t *testing.T) {
+	named := []Option{WithRequiredNames()}
+	checkOptionCases(t, []optionCase{
+		{`{ iso(1) identified-organization(3) dod(6) }`, named, `1.3.6`, nil},
+		{`{ iso(1) 3 dod(6) }`, named, ``, ErrInvalidIdentifier},
+		{[]int{1, 3, 6}, named, ``, ErrInvalidIdentifier},
+		{`{ iso(1) 3 dod(6) }`, nil, `1.3.6`, nil},
+		{[]int{1, 3, 6}, []Option{WithRequiredNames(), WithInferredNames()}, `1.3.6`, nil},
+		{[]int{2, 999, 1}, []Option{WithRequiredNames(), WithInferredNames()}, ``, ErrInvalidIdentifier},
+	})
+}
+
+func TestWithLenientSpacing(t *testing.T) {
+	spaced := []Option{WithLenientSpacing()}
+	checkOptionCases(t, []optionCase{
+		{`{ iso (1) identified-organization( 3 ) dod ( 6 ) }`, spaced, `1.3.6`, nil},
+		{`{iso(1) 3 dod(6)}`, spaced, `1.3.6`, nil},
+		{"{ iso(1) -- ISO --\n 3 dod(6) }", spaced, `1.3.6`, nil},
+		{`{ iso ( 1 ) 3 }`, spaced, `1.3`, nil},
+		{`{ }`, spaced, ``, ErrEmptyInput},
+		{``, spaced, ``, ErrEmptyInput},
+		{`{ iso ( 1 }`, spaced, ``, ErrInvalidNumberForm},
+		{`{ iso(1) ; }`, spaced, ``, ErrSyntax},
+		{`{ iso (1) 3 }`, nil, ``, ErrInvalidNumberForm},
+	})
+}
+
+func TestWithCasePolicy(t *testing.T) {
+	for _, tc := range []struct {
+		in   any
+		p    CasePolicy
+		want string
+		err  error
+	}{
+		{`{ iso(1) identified-organization(3) TeleTrust(36) }`, CaseLenient, `{ iso(1) identified-organization(3) teleTrust(36) }`, nil},
+		{`{ iso(1) identified-organization(3) TeleTrust(36) }`, CasePreserve, `{ iso(1) identified-organization(3) TeleTrust(36) }`, nil},
+		{`{ iso(1) identified-organization(3) TeleTrust(36) }`, CaseStrict, ``, ErrInvalidIdentifier},
+		{[]string{`iso(1)`, `3`, `TeleTrust(36)`}, CaseLenient, `{ iso(1) 3 teleTrust(36) }`, nil},
+		{`{ iso(1) Tele_Trust(36) }`, CaseLenient, ``, ErrInvalidIdentifier},
+		{`{ iso(1) 3Com(36) }`, CasePreserve, ``, ErrInvalidIdentifier},
+	} {
+		o, err := NewObjectIdentifier(tc.in, WithCasePolicy(tc.p))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%v (%s): got error %v, want %v", tc.in, tc.p, err, tc.err)
+		} else if err == nil && o.String() != tc.want {
+			t.Errorf("%v (%s): got %s, want %s", tc.in, tc.p, o.String(), tc.want)
+		}
+	}
+}
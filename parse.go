@@ -0,0 +1,166 @@
+package oid
+
+/*
+parse.go contains the NaNF sequence and dotNotation parsers used by the various constructors. Both scan their input by index and produce the arcs of an ObjectIdentifier directly, avoiding intermediate slices and substrings.
+*/
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+/*
+nanfSequenceBounds returns the indices of x that exclude any enclosing braces and whitespace.
+*/
+func nanfSequenceBounds(x string) (start, end int) {
+	start, end = 0, len(x)
+	for start < end && (x[start] == '{' || isSpace(x[start])) {
+		start++
+	}
+	for end > start && (x[end-1] == '}' || isSpace(x[end-1])) {
+		end--
+	}
+
+	return
+}
+
+/*
+nanfSequenceLen returns the number of arcs present within the ASN.1 NameAndNumberForm sequence x.
+*/
+func nanfSequenceLen(x string) (n int) {
+	start, end := nanfSequenceBounds(x)
+	for i := start; i < end; i++ {
+		if !isSpace(x[i]) && (i == start || isSpace(x[i-1])) {
+			n++
+		}
+	}
+
+	return
+}
+
+/*
+parseNaNFSequence parses an ASN.1 NameAndNumberForm sequence, e.g.:
+
+	{ iso(1) identified-organization(3) dod(6) }
+
+The enclosing braces are optional. Arcs are appended to dst, which should be sized using nanfSequenceLen. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func parseNaNFSequence(dst []NameAndNumberForm, x string, upper bool) (nanf []NameAndNumberForm, err error) {
+	start, end := nanfSequenceBounds(x)
+
+	nanf = dst
+	for i := start; i < end; {
+		for i < end && isSpace(x[i]) {
+			i++
+		}
+
+		j := i
+		for j < end && !isSpace(x[j]) {
+			j++
+		}
+
+		if j > i {
+			var arc NameAndNumberForm
+			if arc, err = parseArc(x[i:j], upper); err != nil {
+				nanf = nil
+				return
+			}
+			nanf = append(nanf, arc)
+		}
+		i = j
+	}
+
+	return
+}
+
+/*
+parseArc parses a single arc, which is either a bare number form or a nameAndNumber form. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func parseArc(x string, upper bool) (arc NameAndNumberForm, err error) {
+	if isDigit(x) {
+		err = arc.setNumberForm(x)
+		return
+	}
+
+	return parseNaNF(x, upper)
+}
+
+/*
+parseAnyArc parses a single member of a []any, which is either a string bearing a bare number form or a nameAndNumber form, or a non-negative number of any integer type, a float64 bearing a whole number, a json.Number or a *big.Int. If upper is true, identifiers bearing an uppercase first character are accepted.
+*/
+func parseAnyArc(x any, upper bool) (arc NameAndNumberForm, err error) {
+	switch tv := x.(type) {
+	case string:
+		return parseArc(tv, upper)
+	case json.Number:
+		if !isDigit(string(tv)) {
+			err = errorw(ErrInvalidNumberForm, "Bad number form '%s'", tv)
+			return
+		}
+		err = arc.setNumberForm(string(tv))
+	case int, int8, int16, int32, int64:
+		n := reflect.ValueOf(tv).Int()
+		if n < 0 {
+			err = errorw(ErrInvalidNumberForm, "Number form cannot be negative")
+			return
+		}
+		arc.setBig(big.NewInt(n))
+	case uint, uint8, uint16, uint32, uint64:
+		arc.setBig(new(big.Int).SetUint64(reflect.ValueOf(tv).Uint()))
+	case float64:
+		if tv < 0 || tv != math.Trunc(tv) || tv > 1<<53 {
+			err = errorw(ErrInvalidNumberForm, "Number form %v is not a whole number within range", tv)
+			return
+		}
+		arc.setBig(new(big.Int).SetUint64(uint64(tv)))
+	case *big.Int:
+		if tv == nil || tv.Sign() < 0 {
+			err = errorw(ErrInvalidNumberForm, "Number form cannot be nil or negative")
+			return
+		}
+		arc.setBig(tv)
+	default:
+		err = errorw(ErrUnsupportedType, "Unsupported arc type %T", x)
+	}
+
+	return
+}
+
+/*
+parseDotNotation parses x, e.g. 1.3.6.1, into NameAndNumberForm instances bearing number forms only, which are appended to dst. If strict is true, number forms bearing leading zeros are rejected.
+*/
+func parseDotNotation(dst []NameAndNumberForm, x string, strict bool) (nanf []NameAndNumberForm, err error) {
+	if len(x) == 0 {
+		err = errorw(ErrEmptyInput, "No dotNotation content to read")
+		return
+	}
+
+	a := len(dst)
+	nanf = append(dst, make([]NameAndNumberForm, countByte(x, '.')+1)...)
+	for start, i := 0, 0; i <= len(x); i++ {
+		if i < len(x) && x[i] != '.' {
+			continue
+		}
+
+		n := x[start:i]
+		if len(n) == 0 || !isDigit(n) {
+			err = errorw(ErrInvalidNumberForm, "Bad dotNotation arc '%s' in '%s'", n, x)
+		} else if strict && len(n) > 1 && n[0] == '0' {
+			err = errorw(ErrInvalidNumberForm, "Bad dotNotation arc '%s' in '%s' [hint: leading zeros are not permitted]", n, x)
+		} else {
+			err = nanf[a].setNumberForm(n)
+		}
+
+		if err != nil {
+			nanf = nil
+			return
+		}
+
+		start = i + 1
+		a++
+	}
+
+	return
+}
@@ -0,0 +1,161 @@
+package oid
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"testing"
+)
+
+const (
+	benchNaNF = `{ iso(1) identified-organization(3) dod(6) internet(1) private(4) enterprise(1) 56521 1 5 }`
+	benchDot  = `1.3.6.1.4.1.56521.1.5`
+)
+
+/*
+benchDots returns n distinct dotNotation values beneath the Enterprise arc, in the manner of a bulk import.
+*/
+func benchDots(n int) (dots []string) {
+	dots = make([]string, n)
+	for i := 0; i < n; i++ {
+		dots[i] = `1.3.6.1.4.1.` + strconv.Itoa(i) + `.1.` + strconv.Itoa(i%97)
+	}
+
+	return
+}
+
+func BenchmarkNewFromNaNF(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewFromNaNF(benchNaNF); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewFromDot(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewFromDot(benchDot); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+/*
+BenchmarkNewFromDotArcs reports the bytes allocated per OID for each inline storage tier, and either side of inlineArcs.
+*/
+func BenchmarkNewFromDotArcs(b *testing.B) {
+	for _, dot := range []string{`2.5.4`, `2.5.4.3`, `1.3.6.1.4.1.56521.1`, benchDot, `1.3.6.1.4.1.311.21.8.1.2.3`, `1.3.6.1.4.1.311.21.8.1.2.3.4.5.6`} {
+		b.Run(strconv.Itoa(len(split(dot, `.`))), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := NewFromDot(dot); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkNewFromDotBulk(b *testing.B) {
+	dots := benchDots(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewFromDot(dots[i%len(dots)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParserParse(b *testing.B) {
+	p := AcquireParser()
+	defer ReleaseParser(p)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.Parse(benchNaNF); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParserParseDot(b *testing.B) {
+	p := AcquireParser()
+	defer ReleaseParser(p)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := p.ParseDot(benchDot); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+/*
+TestParserAllocs guards the allocation-free parsing of number forms by a *Parser. Identifiers are interned by the NaNF parser, and are thus excluded by way of a sequence bearing none.
+*/
+func TestParserAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable under the race detector")
+	}
+
+	p := AcquireParser()
+	defer ReleaseParser(p)
+
+	for _, fn := range []func() error{
+		func() (err error) { _, err = p.ParseDot(benchDot); return },
+		func() (err error) { _, err = p.Parse(`{ 1 3 6 1 4 1 56521 1 5 }`); return },
+	} {
+		var err error
+		if n := testing.AllocsPerRun(100, func() { err = fn() }); err != nil {
+			t.Fatal(err)
+		} else if n > 0 {
+			t.Errorf("got %.0f allocations per parse, want none", n)
+		}
+	}
+}
+
+func TestNewFromDotAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("allocation counts are unreliable under the race detector")
+	}
+
+	// the ObjectIdentifier, its inline arcs, cache and lock are
+	// allocated at once; OIDs longer than inlineArcs spill their
+	// arcs over into a second allocation.
+	for _, tc := range []struct {
+		dot  string
+		want float64
+	}{
+		{`2.5.4`, 1},
+		{`1.3.6.1.4.1.56521.1`, 1},
+		{benchDot, 1},
+		{`1.3.6.1.4.1.311.21.8.1.2.3`, 1},
+		{`1.3.6.1.4.1.311.21.8.1.2.3.4.5.6`, 2},
+	} {
+		if n := testing.AllocsPerRun(100, func() { NewFromDot(tc.dot) }); n > tc.want {
+			t.Errorf("%s: got %.0f allocations per NewFromDot, want %.0f", tc.dot, n, tc.want)
+		}
+	}
+}
+
+func TestNewFromAny(t *testing.T) {
+	checkOptionCases(t, []optionCase{
+		{[]any{`iso(1)`, 3, `dod(6)`, 1}, nil, `1.3.6.1`, nil},
+		{[]any{int8(1), int16(3), int32(6), int64(1), uint8(4), uint16(1), uint32(56521), uint64(1), uint(5)}, nil, `1.3.6.1.4.1.56521.1.5`, nil},
+		{[]any{float64(2), float64(999), json.Number(`18446744073709551616`)}, nil, `2.999.18446744073709551616`, nil},
+		{[]any{2, 25, new(big.Int).Lsh(big.NewInt(1), 100)}, nil, `2.25.1267650600228229401496703205376`, nil},
+		{[]any{`1`, `03`}, []Option{WithStrict()}, ``, ErrInvalidNumberForm},
+		{[]any{1, -3}, nil, ``, ErrInvalidNumberForm},
+		{[]any{1, 3.5}, nil, ``, ErrInvalidNumberForm},
+		{[]any{1, float64(-3)}, nil, ``, ErrInvalidNumberForm},
+		{[]any{1, json.Number(`3e2`)}, nil, ``, ErrInvalidNumberForm},
+		{[]any{1, (*big.Int)(nil)}, nil, ``, ErrInvalidNumberForm},
+		{[]any{1, true}, nil, ``, ErrUnsupportedType},
+		{[]any{`Iso(1)`, 3}, nil, ``, ErrInvalidIdentifier},
+		{[]any{`Iso(1)`, 3}, []Option{WithCasePolicy(CaseLenient)}, `1.3`, nil},
+		{[]any{}, nil, ``, ErrEmptyInput},
+	})
+}
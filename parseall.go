@@ -0,0 +1,89 @@
+package oid
+
+/*
+parseall.go deals with the bulk parsing of OIDs, reporting every failure at once.
+*/
+
+/*
+ParseError describes the failure to parse a single input value within a bulk operation such as ParseAll.
+*/
+type ParseError struct {
+	// Index is the position of the offending value within the input.
+	Index int
+
+	// Input is the offending value.
+	Input string
+
+	// Err is the reason for the failure.
+	Err error
+}
+
+/*
+Error returns the string form of the receiver, e.g.:
+
+	#3 ("1..3"): invalid number form: ...
+*/
+func (e ParseError) Error() string {
+	return sprintf("#%d (%q): %v", e.Index, e.Input, e.Err)
+}
+
+/*
+Unwrap returns the underlying error of the receiver, allowing the use of errors.Is and errors.As.
+*/
+func (e ParseError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ParseErrors contains one ParseError per failing input value, in input order.
+*/
+type ParseErrors []ParseError
+
+/*
+Error returns the string form of the receiver, listing each failure upon its own line.
+*/
+func (e ParseErrors) Error() string {
+	lines := make([]string, 0, len(e)+1)
+	lines = append(lines, sprintf("%d of the input values could not be parsed:", len(e)))
+	for i := 0; i < len(e); i++ {
+		lines = append(lines, "\t"+e[i].Error())
+	}
+
+	return join(lines, "\n")
+}
+
+/*
+Unwrap returns the individual errors of the receiver, allowing errors.Is and errors.As to match the underlying reason of any failure, e.g. errors.Is(err, oid.ErrInvalidNumberForm).
+*/
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i := 0; i < len(e); i++ {
+		errs[i] = e[i]
+	}
+
+	return errs
+}
+
+/*
+ParseAll parses each of x in the manner of ParseAny, returning every value parsed successfully, in input order, alongside an error. Rather than stopping at the first failure, every value is attempted; should any fail, the error is a ParseErrors instance identifying the index of, and reason for, each failure. This allows bulk imports to report every problem in a single pass.
+
+Each value returned is independent of any other, including those of the WellKnown database which resolve descriptors, and may be modified freely by the caller.
+*/
+func ParseAll(x []string) (oids []ObjectIdentifier, err error) {
+	var errs ParseErrors
+	oids = make([]ObjectIdentifier, 0, len(x))
+	for i := 0; i < len(x); i++ {
+		o, perr := ParseAny(x[i])
+		if perr != nil {
+			errs = append(errs, ParseError{Index: i, Input: x[i], Err: perr})
+			continue
+		}
+		oids = append(oids, *o)
+	}
+
+	if len(errs) > 0 {
+		err = errs
+	}
+
+	return
+}
@@ -0,0 +1,68 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAll(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		in      []string
+		want    []string
+		indices []int
+		err     error
+	}{
+		{`all valid`, []string{`1.3.6`, `urn:oid:2.5.4.3`, `commonName`}, []string{`1.3.6`, `2.5.4.3`, `2.5.4.3`}, nil, nil},
+		{`some invalid`, []string{`1.3.6`, `1..3`, `2.5`, ``}, []string{`1.3.6`, `2.5`}, []int{1, 3}, ErrInvalidNumberForm},
+		{`empty member`, []string{``}, nil, []int{0}, ErrEmptyInput},
+		{`no input`, nil, nil, nil, nil},
+	} {
+		oids, err := ParseAll(tc.in)
+		if len(oids) != len(tc.want) {
+			t.Errorf("%s: got %d OIDs, want %d", tc.name, len(oids), len(tc.want))
+			continue
+		}
+		for i := 0; i < len(oids); i++ {
+			if d := oids[i].DotNotation(); d != tc.want[i] {
+				t.Errorf("%s: #%d: got %s, want %s", tc.name, i, d, tc.want[i])
+			}
+		}
+
+		if tc.indices == nil {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tc.name, err)
+			}
+			continue
+		}
+
+		var errs ParseErrors
+		if !errors.As(err, &errs) {
+			t.Errorf("%s: got %T, want %T", tc.name, err, errs)
+			continue
+		} else if !errors.Is(err, tc.err) {
+			t.Errorf("%s: error %v does not wrap %v", tc.name, err, tc.err)
+		}
+		if len(errs) != len(tc.indices) {
+			t.Errorf("%s: got %d failures, want %d", tc.name, len(errs), len(tc.indices))
+			continue
+		}
+		for i := 0; i < len(errs); i++ {
+			if errs[i].Index != tc.indices[i] || errs[i].Input != tc.in[tc.indices[i]] {
+				t.Errorf("%s: failure #%d: got index %d (%q)", tc.name, i, errs[i].Index, errs[i].Input)
+			}
+		}
+	}
+}
+
+func TestParseAllIndependent(t *testing.T) {
+	oids, err := ParseAll([]string{`commonName`})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oids[0].SetName(`renamed`)
+	if o, _ := WellKnown().Get(`commonName`); o.Name() == `renamed` {
+		t.Errorf("ParseAll returned a value shared with WellKnown")
+	}
+}
@@ -0,0 +1,180 @@
+package oid
+
+/*
+partial.go deals with ObjectIdentifier instances bearing an arc whose number form is not yet known.
+*/
+
+/*
+Getter is satisfied by any type capable of returning an *ObjectIdentifier by name, such as ObjectIdentifierMap, *Registry and RegistryTx.
+*/
+type Getter interface {
+	Get(term any) (*ObjectIdentifier, bool)
+}
+
+/*
+PartialObjectIdentifier is an ASN.1 NameAndNumberForm sequence bearing a single placeholder arc, whose number form is assigned elsewhere, e.g.:
+
+	{ enterprise my-org(?) 5 }
+
+Such an instance is not a valid OID, and cannot be used as one, until bound using the Bind method. This permits two-phase loading of documents which reference arcs that are defined within other documents.
+
+The first arc may optionally be a bare identifier, such as enterprise above, which refers to an OID defined elsewhere and is likewise resolved upon binding.
+*/
+type PartialObjectIdentifier struct {
+	ref  string
+	arcs []NameAndNumberForm
+	hole int
+}
+
+/*
+NewPartialObjectIdentifier returns an instance of *PartialObjectIdentifier alongside an error following an attempt to parse x as an ASN.1 NameAndNumberForm sequence bearing exactly one placeholder arc, i.e. an identifier followed by (?).
+*/
+func NewPartialObjectIdentifier(x string) (p *PartialObjectIdentifier, err error) {
+	if nanfSequenceLen(x) == 0 {
+		err = errorw(ErrEmptyInput, "No content for NewPartialObjectIdentifier to read")
+		return
+	}
+
+	start, end := nanfSequenceBounds(x)
+	f := fields(x[start:end])
+
+	t := &PartialObjectIdentifier{hole: -1}
+	for i := 0; i < len(f); i++ {
+		switch {
+		case i == 0 && indexRune(f[i], '(') == -1 && !isDigit(f[i]):
+			if !isIdentifier(f[i]) {
+				err = errorw(ErrInvalidIdentifier, "Bad reference '%s' in '%s'", f[i], x)
+				return
+			}
+			t.ref = f[i]
+		case hasSuffix(f[i], `(?)`):
+			id := f[i][:len(f[i])-3]
+			if t.hole != -1 {
+				err = errorw(ErrInvalidNumberForm, "Multiple placeholder arcs in '%s' [hint: only one is permitted]", x)
+				return
+			} else if !isIdentifier(id) {
+				err = errorw(ErrInvalidIdentifier, "Bad placeholder identifier '%s' in '%s'", id, x)
+				return
+			}
+			t.hole = len(t.arcs)
+			t.arcs = append(t.arcs, NameAndNumberForm{identifier: id})
+		default:
+			var arc NameAndNumberForm
+			if arc, err = parseArc(f[i], false); err != nil {
+				return
+			}
+			t.arcs = append(t.arcs, arc)
+		}
+	}
+
+	if t.hole == -1 {
+		err = errorw(ErrInvalidNumberForm, "No placeholder arc in '%s' [hint: use NewObjectIdentifier for complete OIDs]", x)
+		return
+	}
+	p = t
+
+	return
+}
+
+/*
+Placeholder returns the identifier of the placeholder arc of the receiver, e.g. my-org.
+*/
+func (p *PartialObjectIdentifier) Placeholder() string {
+	if p == nil {
+		return ``
+	}
+	return p.arcs[p.hole].identifier
+}
+
+/*
+Reference returns the bare identifier with which the receiver begins, e.g. enterprise, or a zero string if none.
+*/
+func (p *PartialObjectIdentifier) Reference() string {
+	if p == nil {
+		return ``
+	}
+	return p.ref
+}
+
+/*
+Valid always returns false, as the receiver does not denote an OID until bound. See Bind.
+*/
+func (p *PartialObjectIdentifier) Valid() bool {
+	return false
+}
+
+/*
+String returns the ASN.1 NameAndNumberForm sequence of the receiver, with the placeholder arc rendered as its identifier followed by (?).
+*/
+func (p *PartialObjectIdentifier) String() string {
+	if p == nil {
+		return ``
+	}
+
+	dst := []byte{'{', ' '}
+	if len(p.ref) > 0 {
+		dst = append(dst, p.ref...)
+		dst = append(dst, ' ')
+	}
+
+	for i := 0; i < len(p.arcs); i++ {
+		if i == p.hole {
+			dst = append(dst, p.arcs[i].identifier...)
+			dst = append(dst, `(?)`...)
+		} else {
+			dst = p.arcs[i].AppendString(dst)
+		}
+		dst = append(dst, ' ')
+	}
+
+	return string(append(dst, '}'))
+}
+
+/*
+Bind returns an instance of *ObjectIdentifier alongside an error following an attempt to resolve the receiver using g.
+
+The placeholder arc is assigned the final number form of the OID known to g by the placeholder identifier, which must be an immediate child of the arcs preceding it. Any leading reference is replaced by the arcs of the OID known to g by that name. The receiver is not modified, and may be bound again should g change.
+*/
+func (p *PartialObjectIdentifier) Bind(g Getter) (o *ObjectIdentifier, err error) {
+	if p == nil || g == nil {
+		err = errorw(ErrNilInstance, "%T or its %T is nil", p, g)
+		return
+	}
+
+	t := newObjectIdentifier(len(p.arcs) + 8)
+	if len(p.ref) > 0 {
+		base, found := g.Get(p.ref)
+		if !found {
+			err = errorw(ErrNotFound, "Reference '%s' not found", p.ref)
+			return
+		}
+		t.nANF = append(t.nANF, base.arcs()...)
+		if last := len(t.nANF) - 1; last >= 0 && len(t.nANF[last].identifier) == 0 {
+			t.nANF[last].identifier = p.ref
+		}
+	}
+	t.nANF = append(t.nANF, p.arcs...)
+
+	id := p.Placeholder()
+	x, found := g.Get(id)
+	if !found {
+		err = errorw(ErrNotFound, "Placeholder '%s' not found", id)
+		return
+	}
+
+	hole := len(t.nANF) - len(p.arcs) + p.hole
+	parent := t.nANF[:hole]
+	if !x.isChildOf(parent) {
+		err = errorw(ErrInvalidRoot, "Placeholder '%s' (%s) is not an arc beneath %s", id, x.DotNotation(), dotArcs(parent))
+		return
+	}
+
+	t.nANF[hole] = x.arcs()[hole]
+	t.nANF[hole].identifier = id
+
+	if err = t.checkValid(); err == nil {
+		o = t
+	}
+
+	return
+}
@@ -0,0 +1,84 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPartialObjectIdentifier(t *testing.T) {
+	for _, tc := range []struct {
+		in, str, ref, hole string
+		err                error
+	}{
+		{`{ enterprise my-org(?) 5 }`, `{ enterprise my-org(?) 5 }`, `enterprise`, `my-org`, nil},
+		{`{ iso(1) 3 6 1 4 1 my-org(?) }`, `{ iso(1) 3 6 1 4 1 my-org(?) }`, ``, `my-org`, nil},
+		{`{enterprise  my-org(?)}`, `{ enterprise my-org(?) }`, `enterprise`, `my-org`, nil},
+		{``, ``, ``, ``, ErrEmptyInput},
+		{`{ enterprise 5 }`, ``, ``, ``, ErrInvalidNumberForm},
+		{`{ a(?) b(?) }`, ``, ``, ``, ErrInvalidNumberForm},
+		{`{ enterprise My-Org(?) }`, ``, ``, ``, ErrInvalidIdentifier},
+		{`{ Enterprise my-org(?) }`, ``, ``, ``, ErrInvalidIdentifier},
+		{`{ enterprise my-org(?) x(y) }`, ``, ``, ``, ErrInvalidNumberForm},
+	} {
+		p, err := NewPartialObjectIdentifier(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+			continue
+		} else if err != nil {
+			continue
+		}
+
+		if s := p.String(); s != tc.str {
+			t.Errorf("%q: got %s, want %s", tc.in, s, tc.str)
+		}
+		if r := p.Reference(); r != tc.ref {
+			t.Errorf("%q: got reference %q, want %q", tc.in, r, tc.ref)
+		}
+		if h := p.Placeholder(); h != tc.hole {
+			t.Errorf("%q: got placeholder %q, want %q", tc.in, h, tc.hole)
+		}
+		if p.Valid() {
+			t.Errorf("%q: partial instance reports as valid", tc.in)
+		}
+	}
+}
+
+func TestPartialBind(t *testing.T) {
+	g := ObjectIdentifierMap{
+		`enterprise`: mustDot(t, `1.3.6.1.4.1`),
+		`my-org`:     mustDot(t, `1.3.6.1.4.1.56521`),
+		`stray`:      mustDot(t, `2.999.7`),
+	}
+
+	for _, tc := range []struct {
+		in   string
+		g    Getter
+		want string
+		err  error
+	}{
+		{`{ enterprise my-org(?) 5 }`, g, `1.3.6.1.4.1.56521.5`, nil},
+		{`{ iso(1) 3 6 1 4 1 my-org(?) }`, g, `1.3.6.1.4.1.56521`, nil},
+		{`{ unknown my-org(?) }`, g, ``, ErrNotFound},
+		{`{ enterprise missing(?) }`, g, ``, ErrNotFound},
+		{`{ enterprise stray(?) }`, g, ``, ErrInvalidRoot},
+		{`{ enterprise 9 my-org(?) }`, g, ``, ErrInvalidRoot},
+		{`{ enterprise my-org(?) }`, nil, ``, ErrNilInstance},
+	} {
+		p, err := NewPartialObjectIdentifier(tc.in)
+		if err != nil {
+			t.Fatalf("%q: %v", tc.in, err)
+		}
+
+		var o *ObjectIdentifier
+		if o, err = p.Bind(tc.g); !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+		} else if d := o.DotNotation(); err == nil && d != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, d, tc.want)
+		}
+	}
+
+	var nilPartial *PartialObjectIdentifier
+	if _, err := nilPartial.Bind(g); !errors.Is(err, ErrNilInstance) {
+		t.Errorf("nil receiver: got error %v, want %v", err, ErrNilInstance)
+	}
+}
@@ -0,0 +1,189 @@
+package oid
+
+/*
+policy.go deals with Policy, an allow/deny list of OID rules.
+*/
+
+/*
+Decision is the outcome of a Policy evaluation.
+*/
+type Decision uint8
+
+const (
+	Deny  Decision = iota // the OID is not permitted
+	Allow                 // the OID is permitted
+)
+
+/*
+String returns the string name of the receiver, e.g. "allow".
+*/
+func (d Decision) String() string {
+	if d == Allow {
+		return `allow`
+	}
+	return `deny`
+}
+
+/*
+Policy is a compiled set of allow and deny rules against which OIDs may be evaluated, e.g. for the gating of certificate extensions, the filtering of LDAP attributes or the subsetting of SNMP views.
+
+Instances are immutable once compiled, and are safe for concurrent use.
+*/
+type Policy struct {
+	allow []policyRule
+	deny  []policyRule
+	def   Decision
+}
+
+/*
+policyRule is a single compiled rule, consisting of one matcher per arc and whether the rule matches descendants.
+*/
+type policyRule struct {
+	arcs   []policyArc
+	prefix bool
+}
+
+/*
+policyArc matches a single arc whose number form falls within lo through hi inclusive, or any arc if any is true.
+*/
+type policyArc struct {
+	lo, hi NameAndNumberForm
+	any    bool
+}
+
+/*
+NewPolicy compiles the provided allow and deny rules into an instance of *Policy, alongside an error. Each rule is in dotNotation, any arc of which may be replaced by one of the following:
+
+  - A wildcard (*), which matches any single arc, e.g. 1.3.6.1.4.1.*.1
+  - A range (lo-hi), which matches any single arc whose number form falls within lo through hi inclusive, e.g. 1.3.6.1.4.1.1-100
+
+A rule ending with a wildcard is a prefix rule, which matches all descendants of the preceding arcs at any depth, e.g. 1.3.6.1.2.1.* matches 1.3.6.1.2.1.1 and 1.3.6.1.2.1.1.1, but not 1.3.6.1.2.1 itself. All other rules match OIDs of the same length only.
+
+See Evaluate for the manner in which rules are applied, and the role of def.
+*/
+func NewPolicy(allow, deny []string, def Decision) (p *Policy, err error) {
+	t := &Policy{def: def}
+	if t.allow, err = compilePolicyRules(allow); err != nil {
+		return
+	}
+	if t.deny, err = compilePolicyRules(deny); err != nil {
+		return
+	}
+
+	p = t
+	return
+}
+
+/*
+Evaluate returns the Decision reached for o. Deny rules take precedence: if any deny rule matches o, Deny is returned. Otherwise, if any allow rule matches o, Allow is returned. Otherwise, the default Decision provided to NewPolicy is returned.
+
+Deny is returned if the receiver or o is nil.
+*/
+func (p *Policy) Evaluate(o *ObjectIdentifier) Decision {
+	if p == nil || o.IsZero() {
+		return Deny
+	}
+
+	for i := 0; i < len(p.deny); i++ {
+		if p.deny[i].match(o) {
+			return Deny
+		}
+	}
+
+	for i := 0; i < len(p.allow); i++ {
+		if p.allow[i].match(o) {
+			return Allow
+		}
+	}
+
+	return p.def
+}
+
+/*
+Allowed returns a Boolean value indicative of whether the receiver evaluates o to Allow.
+*/
+func (p *Policy) Allowed(o *ObjectIdentifier) bool {
+	return p.Evaluate(o) == Allow
+}
+
+/*
+match returns a Boolean value indicative of whether o satisfies the receiver.
+*/
+func (r policyRule) match(o *ObjectIdentifier) bool {
+	arcs := o.arcs()
+	if r.prefix {
+		if len(arcs) <= len(r.arcs) {
+			return false
+		}
+	} else if len(arcs) != len(r.arcs) {
+		return false
+	}
+
+	for i := 0; i < len(r.arcs); i++ {
+		a := r.arcs[i]
+		if !a.any && (arcs[i].cmp(a.lo) < 0 || arcs[i].cmp(a.hi) > 0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+compilePolicyRules compiles each of the provided rules.
+*/
+func compilePolicyRules(rules []string) (compiled []policyRule, err error) {
+	for i := 0; i < len(rules); i++ {
+		var r policyRule
+		if r, err = compilePolicyRule(rules[i]); err != nil {
+			compiled = nil
+			return
+		}
+		compiled = append(compiled, r)
+	}
+
+	return
+}
+
+/*
+compilePolicyRule compiles a single rule. See NewPolicy for the syntax.
+*/
+func compilePolicyRule(rule string) (r policyRule, err error) {
+	if len(rule) == 0 {
+		err = errorw(ErrEmptyInput, "No content for policy rule")
+		return
+	}
+
+	arcs := split(rule, `.`)
+	if arcs[len(arcs)-1] == `*` {
+		r.prefix = true
+		arcs = arcs[:len(arcs)-1]
+	}
+
+	for i := 0; i < len(arcs); i++ {
+		var a policyArc
+		if a.any = arcs[i] == `*`; !a.any {
+			lo, hi := arcs[i], arcs[i]
+			if idx := indexRune(arcs[i], '-'); idx != -1 {
+				lo, hi = arcs[i][:idx], arcs[i][idx+1:]
+			}
+
+			if !isDigit(lo) || !isDigit(hi) {
+				err = errorw(ErrInvalidNumberForm, "Bad arc '%s' in policy rule '%s'", arcs[i], rule)
+				return
+			} else if err = a.lo.setNumberForm(lo); err == nil {
+				err = a.hi.setNumberForm(hi)
+			}
+
+			if err != nil {
+				return
+			} else if a.lo.cmp(a.hi) > 0 {
+				err = errorw(ErrInvalidNumberForm, "Inverted range '%s' in policy rule '%s'", arcs[i], rule)
+				return
+			}
+		}
+		r.arcs = append(r.arcs, a)
+	}
+
+	return
+}
@@ -0,0 +1,83 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	p, err := NewPolicy(
+		[]string{`1.3.6.1.2.1.*`, `1.3.6.1.4.1.1-100`, `2.5.4.*.1`},
+		[]string{`1.3.6.1.2.1.25.*`, `1.3.6.1.4.1.50`},
+		Deny)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		dot  string
+		want Decision
+	}{
+		{`1.3.6.1.2.1.1`, Allow},
+		{`1.3.6.1.2.1.1.5.0`, Allow},
+		{`1.3.6.1.2.1`, Deny},
+		{`1.3.6.1.2.1.25`, Allow},
+		{`1.3.6.1.2.1.25.1`, Deny},
+		{`1.3.6.1.4.1.1`, Allow},
+		{`1.3.6.1.4.1.100`, Allow},
+		{`1.3.6.1.4.1.101`, Deny},
+		{`1.3.6.1.4.1.50`, Deny},
+		{`1.3.6.1.4.1.1.1`, Deny},
+		{`2.5.4.3.1`, Allow},
+		{`2.5.4.3.2`, Deny},
+	} {
+		o, _ := NewFromDot(tc.dot)
+		if got := p.Evaluate(o); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.dot, got, tc.want)
+		}
+	}
+
+	open, _ := NewPolicy(nil, []string{`2.*`}, Allow)
+	for _, tc := range []struct {
+		p    *Policy
+		o    *ObjectIdentifier
+		want bool
+	}{
+		{open, NewEnterpriseOID(56521), true},
+		{open, mustDot(t, `2.25.1`), false},
+		{open, nil, false},
+		{nil, NewEnterpriseOID(56521), false},
+	} {
+		if got := tc.p.Allowed(tc.o); got != tc.want {
+			t.Errorf("Allowed(%v): got %t, want %t", tc.o, got, tc.want)
+		}
+	}
+}
+
+func TestNewPolicyErrors(t *testing.T) {
+	for _, tc := range []struct {
+		rule string
+		err  error
+	}{
+		{``, ErrEmptyInput},
+		{`1.3.x`, ErrInvalidNumberForm},
+		{`1.3.6-`, ErrInvalidNumberForm},
+		{`1.3.9-2`, ErrInvalidNumberForm},
+		{`1..3`, ErrInvalidNumberForm},
+	} {
+		if _, err := NewPolicy([]string{tc.rule}, nil, Deny); !errors.Is(err, tc.err) {
+			t.Errorf("allow %q: got %v, want %v", tc.rule, err, tc.err)
+		}
+		if _, err := NewPolicy(nil, []string{tc.rule}, Deny); !errors.Is(err, tc.err) {
+			t.Errorf("deny %q: got %v, want %v", tc.rule, err, tc.err)
+		}
+	}
+}
+
+func mustDot(t *testing.T, dot string) *ObjectIdentifier {
+	o, err := NewFromDot(dot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
@@ -0,0 +1,111 @@
+package oid
+
+/*
+pool.go contains a pooled parsing facility for transient use, such as the parsing of OIDs found within each inbound request of a server.
+*/
+
+import "sync"
+
+/*
+Parser parses OIDs into scratch storage that is reused across calls, keeping allocations flat when large numbers of short-lived ObjectIdentifier instances are parsed. Instances are obtained using AcquireParser and returned using ReleaseParser.
+
+The *ObjectIdentifier returned by the Parse and ParseDot methods is owned by the Parser. It remains valid only until the next call of either method, or until the Parser is released; use the Copy method to retain it beyond that point. A Parser is not safe for concurrent use.
+*/
+type Parser struct {
+	oid   ObjectIdentifier
+	state oidState
+	cache renderCache
+	arcs  []NameAndNumberForm
+}
+
+var parserPool sync.Pool = sync.Pool{
+	New: func() any {
+		p := &Parser{arcs: make([]NameAndNumberForm, 0, inlineArcs)}
+		p.oid.oidState = &p.state
+		return p
+	},
+}
+
+/*
+AcquireParser returns a *Parser from the package parser pool.
+*/
+func AcquireParser() *Parser {
+	return parserPool.Get().(*Parser)
+}
+
+/*
+ReleaseParser returns p to the package parser pool. Neither p nor any *ObjectIdentifier it returned may be used afterwards.
+*/
+func ReleaseParser(p *Parser) {
+	if p == nil {
+		return
+	}
+
+	p.reset()
+	parserPool.Put(p)
+}
+
+/*
+Parse parses x, an ASN.1 NameAndNumberForm sequence, returning an *ObjectIdentifier owned by the receiver alongside an error.
+*/
+func (p *Parser) Parse(x string) (o *ObjectIdentifier, err error) {
+	p.reset()
+	var arcs []NameAndNumberForm
+	if arcs, err = parseNaNFSequence(p.arcs, x, false); err != nil {
+		return
+	}
+	p.arcs = arcs
+
+	return p.result()
+}
+
+/*
+ParseDot parses x, a dotNotation value, returning an *ObjectIdentifier owned by the receiver alongside an error.
+*/
+func (p *Parser) ParseDot(x string) (o *ObjectIdentifier, err error) {
+	p.reset()
+	var arcs []NameAndNumberForm
+	if arcs, err = parseDotNotation(p.arcs, x, false); err != nil {
+		return
+	}
+	p.arcs = arcs
+
+	return p.result()
+}
+
+/*
+Copy returns a copy of the most recently parsed *ObjectIdentifier which is not owned by the receiver, or nil if nothing was parsed.
+*/
+func (p *Parser) Copy() *ObjectIdentifier {
+	if len(p.oid.nANF) == 0 {
+		return nil
+	}
+
+	return p.oid.clone()
+}
+
+func (p *Parser) result() (o *ObjectIdentifier, err error) {
+	p.oid.nANF = p.arcs
+	p.oid.cache = &p.cache
+	if len(p.arcs) == 0 || !p.oid.valid() {
+		err = errorw(ErrInvalidRoot, "%T instance did not pass validity checks: '%s'", p.oid, dotArcs(p.arcs))
+		p.oid.nANF = nil
+		return
+	}
+
+	o = &p.oid
+	return
+}
+
+/*
+reset discards the most recent parse result, retaining the capacity of the scratch storage.
+*/
+func (p *Parser) reset() {
+	for i := 0; i < len(p.arcs); i++ {
+		p.arcs[i] = NameAndNumberForm{}
+	}
+	p.arcs = p.arcs[:0]
+
+	p.state = oidState{}
+	p.cache = renderCache{}
+}
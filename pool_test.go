@@ -0,0 +1,53 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParser(t *testing.T) {
+	p := AcquireParser()
+	defer ReleaseParser(p)
+
+	for _, tc := range []struct {
+		in   string
+		nanf bool
+		want string
+		err  error
+	}{
+		{`1.3.6.1.4.1.56521`, false, `1.3.6.1.4.1.56521`, nil},
+		{`{ iso(1) identified-organization(3) dod(6) }`, true, `1.3.6`, nil},
+		{``, false, ``, ErrEmptyInput},
+		{`3.1`, false, ``, ErrInvalidRoot},
+		{`1.3.x`, false, ``, ErrInvalidNumberForm},
+		{`{ iso(1) abc }`, true, ``, ErrInvalidNumberForm},
+	} {
+		parse := p.ParseDot
+		if tc.nanf {
+			parse = p.Parse
+		}
+
+		o, err := parse(tc.in)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q: got error %v, want %v", tc.in, err, tc.err)
+			continue
+		} else if err != nil {
+			if p.Copy() != nil {
+				t.Errorf("%q: Copy returned a result following a failed parse", tc.in)
+			}
+			continue
+		}
+
+		cp := p.Copy()
+		if d := o.DotNotation(); d != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, d, tc.want)
+		}
+
+		// the copy must survive the reuse of the parser
+		if _, err = p.ParseDot(`2.999`); err != nil {
+			t.Fatal(err)
+		} else if d := cp.DotNotation(); d != tc.want {
+			t.Errorf("%q: copy changed to %s following reuse", tc.in, d)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package oid
+
+/*
+quick.go implements the testing/quick Generator interface, enabling property-based testing of code that consumes this package.
+*/
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+/*
+Generate returns a structurally valid, randomly generated *ObjectIdentifier as a reflect.Value, satisfying the testing/quick Generator interface. The number of arcs does not exceed size, save that at least one arc is always present; roughly half of the arcs bear identifiers.
+
+The receiver is not used, thus property functions should accept *ObjectIdentifier parameters, e.g.:
+
+	quick.Check(func(o *oid.ObjectIdentifier) bool { ... }, nil)
+*/
+func (o *ObjectIdentifier) Generate(r *rand.Rand, size int) reflect.Value {
+	if size < 1 {
+		size = 1
+	}
+
+	n := 1 + r.Intn(size)
+	t := newObjectIdentifier(n)
+	for i := 0; i < n; i++ {
+		arc := NameAndNumberForm{}.Generate(r, size).Interface().(NameAndNumberForm)
+		switch i {
+		case 0:
+			arc.primaryIdentifier %= MaxRootArc + 1
+		case 1:
+			if t.nANF[0].primaryIdentifier < MaxRootArc {
+				arc.primaryIdentifier %= MaxSecondArc + 1
+			}
+		}
+		t.nANF = append(t.nANF, arc)
+	}
+
+	return reflect.ValueOf(t)
+}
+
+/*
+Generate returns a randomly generated NameAndNumberForm as a reflect.Value, satisfying the testing/quick Generator interface. Roughly half of the generated instances bear identifiers, each of which is no longer than size characters.
+*/
+func (nanf NameAndNumberForm) Generate(r *rand.Rand, size int) reflect.Value {
+	var g NameAndNumberForm
+	g.primaryIdentifier = uint(r.Int31())
+	if r.Intn(2) == 0 {
+		g.identifier = randomIdentifier(r, size)
+	}
+
+	return reflect.ValueOf(g)
+}
+
+/*
+randomIdentifier returns a valid ASN.1 identifier of between one (1) and size characters in length.
+*/
+func randomIdentifier(r *rand.Rand, size int) string {
+	const (
+		lower = `abcdefghijklmnopqrstuvwxyz`
+		chars = lower + `ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789`
+	)
+
+	if size < 1 {
+		size = 1
+	}
+
+	n := 1 + r.Intn(size)
+	id := make([]byte, n)
+	id[0] = lower[r.Intn(len(lower))]
+	for i := 1; i < n; i++ {
+		// hyphens may neither end an identifier, nor follow another hyphen
+		if i < n-1 && id[i-1] != '-' && r.Intn(8) == 0 {
+			id[i] = '-'
+			continue
+		}
+		id[i] = chars[r.Intn(len(chars))]
+	}
+
+	return string(id)
+}
+
+/*
+GenerateUnder returns a randomly generated descendant of base alongside an error. Exactly depth arcs are appended to base, each bearing a number form between zero (0) and maxArc inclusive. This is useful for load testing registries and exercising subtree-matching logic.
+*/
+func GenerateUnder(base ObjectIdentifier, depth int, maxArc uint) (*ObjectIdentifier, error) {
+	return GenerateUnderRand(rand.New(rand.NewSource(rand.Int63())), base, depth, maxArc)
+}
+
+/*
+GenerateUnderRand is the same as GenerateUnder, but draws from r, allowing reproducible results.
+*/
+func GenerateUnderRand(r *rand.Rand, base ObjectIdentifier, depth int, maxArc uint) (o *ObjectIdentifier, err error) {
+	if !base.valid() {
+		err = errorw(ErrInvalidRoot, "%T base did not pass validity checks", base)
+		return
+	} else if depth < 1 {
+		err = errorw(ErrInvalidValue, "Depth must be greater than zero (0)")
+		return
+	}
+
+	arcs := base.arcs()
+	o = newObjectIdentifier(len(arcs) + depth)
+	o.nANF = append(o.nANF, arcs...)
+	for i := 0; i < depth; i++ {
+		var n uint
+		if maxArc == ^uint(0) {
+			n = uint(r.Uint64())
+		} else {
+			n = uint(r.Uint64() % uint64(maxArc+1))
+		}
+		o.nANF = append(o.nANF, NameAndNumberForm{primaryIdentifier: n})
+	}
+
+	return
+}
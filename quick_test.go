@@ -0,0 +1,66 @@
+package oid
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, size := range []int{0, 1, 5, 50} {
+		cfg := &quick.Config{Rand: rand.New(rand.NewSource(int64(size))), MaxCount: 200}
+		cfg.Values = func(args []reflect.Value, r *rand.Rand) {
+			args[0] = (*ObjectIdentifier)(nil).Generate(r, size)
+		}
+
+		prop := func(o *ObjectIdentifier) bool {
+			if !o.Valid() || o.len() > size && o.len() > 1 {
+				return false
+			}
+			p, err := NewFromNaNF(o.String())
+			return err == nil && p.String() == o.String()
+		}
+
+		if err := quick.Check(prop, cfg); err != nil {
+			t.Errorf("size %d: %v", size, err)
+		}
+	}
+}
+
+func TestGenerateUnderRand(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, tc := range []struct {
+		base   ObjectIdentifier
+		depth  int
+		maxArc uint
+		err    error
+	}{
+		{*mustDot(t, `1.3.6.1.4.1.56521`), 1, 0, nil},
+		{*mustDot(t, `1.3.6.1.4.1.56521`), 3, 9, nil},
+		{*mustDot(t, `2.999`), 2, ^uint(0), nil},
+		{*mustDot(t, `2.999`), 0, 9, ErrInvalidValue},
+		{ObjectIdentifier{}, 1, 9, ErrInvalidRoot},
+	} {
+		for i := 0; i < 20; i++ {
+			o, err := GenerateUnderRand(r, tc.base, tc.depth, tc.maxArc)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("%s/%d: got error %v, want %v", tc.base.DotNotation(), tc.depth, err, tc.err)
+			} else if err != nil {
+				break
+			}
+
+			base := tc.base
+			if o.len() != base.len()+tc.depth || !strings.HasPrefix(o.DotNotation(), base.DotNotation()+`.`) {
+				t.Errorf("%s/%d: got %s", base.DotNotation(), tc.depth, o.DotNotation())
+			}
+			for _, arc := range o.arcs()[base.len():] {
+				if arc.primaryIdentifier > tc.maxArc {
+					t.Errorf("%s/%d: arc %d exceeds %d", base.DotNotation(), tc.depth, arc.primaryIdentifier, tc.maxArc)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !race
+
+package oid
+
+// raceEnabled reports whether the race detector, which perturbs
+// allocation counts, is in use.
+const raceEnabled = false
@@ -0,0 +1,153 @@
+package oid
+
+/*
+registry.go deals with Registry, a stateful and concurrency-safe store of ObjectIdentifier instances.
+*/
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+/*
+Registry is a concurrency-safe store of ObjectIdentifier instances, keyed in the manner of ObjectIdentifierMap. Unlike ObjectIdentifierMap, a Registry is able to track state concerning its contents, such as the reservation of numeric ranges for allocation.
+
+The zero value is not ready for use; see NewRegistry.
+*/
+type Registry struct {
+	mu      sync.RWMutex
+	oids    ObjectIdentifierMap
+	plans   map[string][]Reservation
+	audit   *AuditLog
+	hist    map[string][]Version
+	lease   map[string]time.Time
+	dup     DuplicatePolicy
+	parents *ParentConstraint
+	limits  Limits
+}
+
+/*
+NewRegistry returns a new, empty instance of *Registry configured using opts, of which the following apply:
+
+  - WithDuplicatePolicy sets the DuplicatePolicy of the receiver, as with SetDuplicatePolicy
+  - WithAllowedParents sets the ParentConstraint of the receiver, as with SetParentConstraint
+  - WithLimits causes any ObjectIdentifier exceeding its MaxArcs or MaxArcBits limits to be refused, with an error wrapping ErrLimitExceeded, by Set, Lease, Rollback and Allocate
+
+Options concerning the parsing of input, such as WithStrict, have no effect, as a *Registry is handed instances that have already been constructed.
+*/
+func NewRegistry(opts ...Option) *Registry {
+	o := newOptions(opts...)
+	return &Registry{
+		oids:    make(ObjectIdentifierMap),
+		plans:   make(map[string][]Reservation),
+		lease:   make(map[string]time.Time),
+		dup:     o.dup,
+		parents: o.parents,
+		limits:  o.limits,
+	}
+}
+
+/*
+Set assigns x to the receiver under key, replacing any existing assignment. Should x bear an OID already present within the receiver, the DuplicatePolicy of the receiver applies; see SetDuplicatePolicy.
+*/
+func (r *Registry) Set(key string, x *ObjectIdentifier) (err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if x.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", x)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if key, x, err = r.place(r.oids, key, x); err == nil {
+		r.oids[key] = x
+		r.record(AuditSet, key, x)
+	}
+
+	return
+}
+
+/*
+Get returns the *ObjectIdentifier matching term alongside a Boolean value indicative of success. See ObjectIdentifierMap.Get for details.
+*/
+func (r *Registry) Get(term any) (*ObjectIdentifier, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.oids.Get(term)
+}
+
+/*
+Resolve returns the *ObjectIdentifier within the receiver matching the dotNotation value dot, alongside an error. This method satisfies the Resolver interface, allowing the receiver to be used with WithResolver and WithInferredNames. See ObjectIdentifierMap.Resolve for details.
+*/
+func (r *Registry) Resolve(ctx context.Context, dot string) (*ObjectIdentifier, error) {
+	if r == nil {
+		return nil, errorw(ErrNilInstance, "%T is nil", r)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.oids.Resolve(ctx, dot)
+}
+
+/*
+Delete removes the assignment bearing key from the receiver, returning a Boolean value indicative of success.
+*/
+func (r *Registry) Delete(key string) (deleted bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var x *ObjectIdentifier
+	if x, deleted = r.oids[key]; deleted {
+		delete(r.oids, key)
+		r.record(AuditDelete, key, x)
+	}
+
+	return
+}
+
+/*
+Len returns the number of assignments present within the receiver.
+*/
+func (r *Registry) Len() int {
+	if r == nil {
+		return 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.oids)
+}
+
+/*
+Map returns a shallow copy of the contents of the receiver as an ObjectIdentifierMap, suitable for use with the various ObjectIdentifierMap exporters.
+*/
+func (r *Registry) Map() (m ObjectIdentifierMap) {
+	m = make(ObjectIdentifierMap)
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for k, v := range r.oids {
+		m[k] = v
+	}
+
+	return
+}
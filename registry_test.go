@@ -0,0 +1,135 @@
+package oid
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+var benchRegistry *Registry
+
+func BenchmarkRegistryGet(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		m, dots := benchMap(b, n)
+		r := NewRegistry()
+		for k, v := range m {
+			if err := r.Set(k, v); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, found := r.Get(dots[i%n]); !found {
+					b.Fatal("not found")
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkRegistrySet(b *testing.B) {
+	for _, n := range []int{100, 1000, 10000} {
+		m, dots := benchMap(b, n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if i%n == 0 {
+					b.StopTimer()
+					r := NewRegistry()
+					b.StartTimer()
+					benchRegistry = r
+				}
+				if err := benchRegistry.Set(dots[i%n], m[dots[i%n]]); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRegistryOptions(t *testing.T) {
+	ent := NewEnterpriseOID(56521)
+	for _, tc := range []struct {
+		name string
+		opts []Option
+		dot  string
+		fail bool
+		err  error // the sentinel wrapped by the error, if any
+	}{
+		{`none`, nil, `1.3.6.1.4.1.56521.1`, false, nil},
+		{`duplicate reject`, []Option{WithDuplicatePolicy(DuplicateReject)}, `1.3.6.1.4.1.56521`, true, ErrConflict},
+		{`allowed parents`, []Option{WithAllowedParents(mustConstraint(t, ent))}, `1.3.6.1.4.1.1466`, true, ErrDisallowedParent},
+		{`max arcs`, []Option{WithLimits(Limits{MaxArcs: 7})}, `1.3.6.1.4.1.56521.1`, true, ErrLimitExceeded},
+		{`max arc bits`, []Option{WithLimits(Limits{MaxArcBits: 8})}, `1.3.6.1.4.1.56521`, true, ErrLimitExceeded},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewRegistry(tc.opts...)
+			if err := r.Set(`base`, NewEnterpriseOID(56521)); err != nil && !tc.fail {
+				t.Fatal(err)
+			}
+
+			x, _ := NewFromDot(tc.dot)
+			err := r.Set(`x`, x)
+			if (err != nil) != tc.fail {
+				t.Errorf("got %v, want failure %t", err, tc.fail)
+			} else if tc.err != nil && !errors.Is(err, tc.err) {
+				t.Errorf("got %v, want %v", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	for _, tc := range []struct {
+		key, dot string
+	}{
+		{`internet`, `1.3.6.1`},
+		{`private`, `1.3.6.1.4`},
+		{`enterprise`, `1.3.6.1.4.1`},
+	} {
+		if err := r.Set(tc.key, mustDot(t, tc.dot)); err != nil {
+			t.Fatalf("Set(%s): %v", tc.key, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		term  any
+		dot   string
+		found bool
+	}{
+		{`internet`, `1.3.6.1`, true},
+		{`1.3.6.1.4.1`, `1.3.6.1.4.1`, true},
+		{`bogus`, ``, false},
+	} {
+		o, found := r.Get(tc.term)
+		if found != tc.found || found && o.DotNotation() != tc.dot {
+			t.Errorf("Get(%v): got %v (%t), want %s (%t)", tc.term, o, found, tc.dot, tc.found)
+		}
+	}
+
+	if o, err := r.Resolve(context.Background(), `1.3.6.1.4`); err != nil || o.DotNotation() != `1.3.6.1.4` {
+		t.Errorf("Resolve: got %v (%v)", o, err)
+	}
+
+	if !r.Delete(`private`) || r.Delete(`private`) || r.Len() != 2 || len(r.Map()) != 2 {
+		t.Errorf("Delete: got %d entries, want 2", r.Len())
+	}
+
+	var nr *Registry
+	if err := nr.Set(`x`, mustDot(t, `1.3`)); !errors.Is(err, ErrNilInstance) {
+		t.Errorf("nil Set: got %v", err)
+	} else if _, found := nr.Get(`x`); found || nr.Len() != 0 || nr.Delete(`x`) {
+		t.Errorf("nil Registry: expected no entries")
+	}
+}
+
+func mustConstraint(t *testing.T, bases ...*ObjectIdentifier) *ParentConstraint {
+	c, err := NewParentConstraint(bases...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
@@ -0,0 +1,149 @@
+package oid
+
+/*
+registryjson.go deals with the JSON representation of Registry instances, suitable for file-backed persistence.
+*/
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+/*
+registryJSON is the JSON form of a Registry.
+*/
+type registryJSON struct {
+	Entries      []registryEntryJSON `json:"entries"`
+	Reservations []reservationJSON   `json:"reservations,omitempty"`
+}
+
+type registryEntryJSON struct {
+	Key string            `json:"key"`
+	OID *ObjectIdentifier `json:"oid"`
+}
+
+type reservationJSON struct {
+	Base  string `json:"base"`
+	Label string `json:"label"`
+	Low   uint   `json:"low"`
+	High  uint   `json:"high"`
+}
+
+/*
+MarshalJSON returns the JSON representation of the entries and reservations of the receiver alongside an error. Entries are ordered in the manner of ObjectIdentifierMap.SortedKeys, making successive outputs meaningful to diff, e.g.:
+
+	{"entries":[{"key":"example","oid":{"name":"example","dotNotation":"1.3.6.1.4.1.56521",...}}],"reservations":[{"base":"1.3.6.1.4.1.56521","label":"attributes","low":1,"high":99}]}
+
+Audit trails, version history and leases are not represented.
+*/
+func (r *Registry) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte(`null`), nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return json.Marshal(r.toJSON(nil))
+}
+
+/*
+UnmarshalJSON replaces the entries and reservations of the receiver with those of the JSON representation data, as produced by MarshalJSON, alongside an error. The receiver is left unchanged upon error. Any leases held by the receiver are discarded. The audit log and history settings of the receiver are retained, but no records are produced.
+*/
+func (r *Registry) UnmarshalJSON(data []byte) (err error) {
+	var j registryJSON
+	if err = json.Unmarshal(data, &j); err != nil {
+		return
+	}
+
+	var t *Registry
+	if t, err = j.registry(nil); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err = r.parents.checkMap(t.oids); err != nil {
+		return
+	}
+
+	r.oids = t.oids
+	r.plans = t.plans
+	r.lease = make(map[string]time.Time)
+
+	return
+}
+
+/*
+toJSON returns the JSON form of the entries and reservations of the receiver that are equal to, or descendants of, base. If base is nil, all entries and reservations are returned. The caller must hold the lock.
+*/
+func (r *Registry) toJSON(base *ObjectIdentifier) (j registryJSON) {
+	j.Entries = []registryEntryJSON{}
+	r.oids.Range(func(k string, v *ObjectIdentifier) bool {
+		if base == nil || v.under(base) {
+			j.Entries = append(j.Entries, registryEntryJSON{Key: k, OID: v})
+		}
+		return true
+	})
+
+	bases := make([]*ObjectIdentifier, 0, len(r.plans))
+	for dot := range r.plans {
+		if b, err := NewFromDot(dot); err == nil && (base == nil || b.under(base)) {
+			bases = append(bases, b)
+		}
+	}
+	sort.Slice(bases, func(i, k int) bool {
+		return compareArcs(bases[i], bases[k]) < 0
+	})
+
+	for i := 0; i < len(bases); i++ {
+		dot := bases[i].DotNotation()
+		plan := r.plans[dot]
+		for k := 0; k < len(plan); k++ {
+			j.Reservations = append(j.Reservations, reservationJSON{
+				Base:  dot,
+				Label: plan[k].Label,
+				Low:   plan[k].Low,
+				High:  plan[k].High,
+			})
+		}
+	}
+
+	return
+}
+
+/*
+registry returns a new *Registry bearing the entries and reservations of the receiver alongside an error. If base is non-nil, an error is returned should any entry or reservation fall outside of base.
+*/
+func (j registryJSON) registry(base *ObjectIdentifier) (t *Registry, err error) {
+	r := NewRegistry()
+	for i := 0; i < len(j.Entries); i++ {
+		e := j.Entries[i]
+		if e.OID.IsZero() {
+			err = errorw(ErrInvalidRoot, "Entry '%s' bears no OID", e.Key)
+			return
+		} else if base != nil && !e.OID.under(base) {
+			err = errorw(ErrDisallowedParent, "Entry '%s' (%s) is not within %s", e.Key, e.OID.DotNotation(), base.DotNotation())
+			return
+		}
+		r.oids[e.Key] = e.OID
+	}
+
+	for i := 0; i < len(j.Reservations); i++ {
+		res := j.Reservations[i]
+		var b *ObjectIdentifier
+		if b, err = NewFromDot(res.Base); err != nil {
+			return
+		} else if base != nil && !b.under(base) {
+			err = errorw(ErrDisallowedParent, "Reservation '%s' beneath %s is not within %s", res.Label, res.Base, base.DotNotation())
+			return
+		} else if err = r.Reserve(b, res.Label, res.Low, res.High); err != nil {
+			return
+		}
+	}
+	t = r
+
+	return
+}
@@ -0,0 +1,92 @@
+package oid
+
+/*
+render.go deals with the rendering of OIDTree and ObjectIdentifierMap contents as indented text trees.
+*/
+
+import "io"
+
+/*
+treeGlyphs contains the branch, final branch, continuation and blank prefixes used when rendering a tree.
+*/
+type treeGlyphs [4]string
+
+var (
+	unicodeGlyphs = treeGlyphs{`├─ `, `└─ `, `│  `, `   `}
+	asciiGlyphs   = treeGlyphs{`|- `, "`- ", `|  `, `   `}
+)
+
+/*
+Render writes the receiver to w as an indented tree, one arc per line, e.g.:
+
+	iso(1)
+	└─ identified-organization(3)
+	   └─ dod(6)
+	      └─ internet(1)
+	         ├─ directory(1)
+	         └─ mgmt(2)
+
+Should the principal name of a registered OID differ from the identifier of its arc, the name is appended in square brackets. If ascii is true, the branches are drawn using ASCII characters alone.
+*/
+func (t *OIDTree) Render(w io.Writer, ascii bool) (err error) {
+	glyphs := unicodeGlyphs
+	if ascii {
+		glyphs = asciiGlyphs
+	}
+
+	roots := t.Roots()
+	for i := 0; i < len(roots) && err == nil; i++ {
+		err = renderNode(w, roots[i], ``, ``, glyphs)
+	}
+
+	return
+}
+
+/*
+RenderTree writes each ObjectIdentifier within the receiver that is equal to, or a descendant of, base to w as an indented tree. If base is nil, all ObjectIdentifier instances are rendered. See OIDTree.Render for details.
+*/
+func (o ObjectIdentifierMap) RenderTree(w io.Writer, base *ObjectIdentifier, ascii bool) error {
+	t := NewOIDTree()
+	for _, v := range o {
+		if v.IsZero() || (base != nil && !v.under(base)) {
+			continue
+		}
+		t.Insert(v)
+	}
+
+	return t.Render(w, ascii)
+}
+
+/*
+renderNode writes the line for n, prefixed by lead, followed by those of its descendants, each of which is prefixed by indent.
+*/
+func renderNode(w io.Writer, n *OIDTreeNode, lead, indent string, glyphs treeGlyphs) (err error) {
+	if _, err = io.WriteString(w, lead+nodeLabel(n)+"\n"); err != nil {
+		return
+	}
+
+	for i := 0; i < len(n.children) && err == nil; i++ {
+		if i == len(n.children)-1 {
+			err = renderNode(w, n.children[i], indent+glyphs[1], indent+glyphs[3], glyphs)
+		} else {
+			err = renderNode(w, n.children[i], indent+glyphs[0], indent+glyphs[2], glyphs)
+		}
+	}
+
+	return
+}
+
+/*
+nodeLabel returns the text used to represent n within a rendered tree.
+*/
+func nodeLabel(n *OIDTreeNode) string {
+	label := n.nanf.String()
+	if name := n.oid.Name(); len(name) > 0 && !eq(name, n.nanf.identifier) {
+		label += ` [` + name + `]`
+	}
+	if text := annotationComment(n.nanf.annotation); len(text) > 0 {
+		label += ` -- ` + text
+	}
+
+	return label
+}
@@ -0,0 +1,58 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestRenderTree(t *testing.T) {
+	dod, _ := NewFromNaNF(`{ iso(1) identified-organization(3) dod(6) }`)
+	internet := mustDot(t, `1.3.6.1`)
+	internet.SetName(`internet`)
+	m := ObjectIdentifierMap{
+		`dod`:      dod,
+		`internet`: internet,
+		`1.3.7`:    mustDot(t, `1.3.7`),
+		`2.5`:      mustDot(t, `2.5`),
+	}
+
+	for _, tc := range []struct {
+		name  string
+		base  *ObjectIdentifier
+		ascii bool
+		want  string
+	}{
+		{`unicode`, nil, false, "iso(1)\n" +
+			"└─ identified-organization(3)\n" +
+			"   ├─ dod(6)\n" +
+			"   │  └─ 1 [internet]\n" +
+			"   └─ 7\n" +
+			"2\n" +
+			"└─ 5\n"},
+		{`ascii`, nil, true, "iso(1)\n" +
+			"`- identified-organization(3)\n" +
+			"   |- dod(6)\n" +
+			"   |  `- 1 [internet]\n" +
+			"   `- 7\n" +
+			"2\n" +
+			"`- 5\n"},
+		{`base`, mustDot(t, `2`), true, "2\n`- 5\n"},
+		{`empty`, mustDot(t, `0`), false, ``},
+	} {
+		var b strings.Builder
+		if err := m.RenderTree(&b, tc.base, tc.ascii); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		} else if b.String() != tc.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tc.name, b.String(), tc.want)
+		}
+	}
+
+	if err := m.RenderTree(failingWriter{}, nil, false); err == nil {
+		t.Errorf("RenderTree: expected write error")
+	}
+}
@@ -0,0 +1,87 @@
+package oid
+
+/*
+report.go deals with the export of ObjectIdentifierMap contents as Markdown tables and HTML pages, for publication to wikis and the like.
+*/
+
+import (
+	"html"
+	"io"
+)
+
+/*
+ExportMarkdown writes a Markdown table to w describing each ObjectIdentifier within the receiver that is equal to, or a descendant of, base. If base is nil, all ObjectIdentifier instances are exported. Rows are ordered by OID, e.g.:
+
+	| OID | Name | Description | Status |
+	| --- | --- | --- | --- |
+	| 1.3.6.1.4.1.56521 | example | Example, Inc. | current |
+
+Names are chosen in the manner described by ExportWireshark.
+*/
+func (o ObjectIdentifierMap) ExportMarkdown(w io.Writer, base *ObjectIdentifier) (err error) {
+	if _, err = io.WriteString(w, "| OID | Name | Description | Status |\n| --- | --- | --- | --- |\n"); err != nil {
+		return
+	}
+
+	oids, names := o.subtree(base)
+	for i := 0; i < len(oids); i++ {
+		line := sprintf("| %s | %s | %s | %s |\n",
+			oids[i].DotNotation(),
+			markdownCell(names[oids[i]]),
+			markdownCell(oids[i].Description()),
+			oids[i].Status())
+		if _, err = io.WriteString(w, line); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+/*
+ExportHTML writes a self-contained HTML page to w bearing a table which describes each ObjectIdentifier within the receiver that is equal to, or a descendant of, base. If base is nil, all ObjectIdentifier instances are exported. The columns are those of ExportMarkdown, and title is used as both the page title and its heading.
+*/
+func (o ObjectIdentifierMap) ExportHTML(w io.Writer, base *ObjectIdentifier, title string) (err error) {
+	title = html.EscapeString(title)
+	head := "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n" +
+		"<title>" + title + "</title>\n</head>\n<body>\n" +
+		"<h1>" + title + "</h1>\n<table>\n" +
+		"<tr><th>OID</th><th>Name</th><th>Description</th><th>Status</th></tr>\n"
+	if _, err = io.WriteString(w, head); err != nil {
+		return
+	}
+
+	oids, names := o.subtree(base)
+	for i := 0; i < len(oids); i++ {
+		line := sprintf("<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+			oids[i].DotNotation(),
+			html.EscapeString(names[oids[i]]),
+			html.EscapeString(oids[i].Description()),
+			oids[i].Status())
+		if _, err = io.WriteString(w, line); err != nil {
+			return
+		}
+	}
+
+	_, err = io.WriteString(w, "</table>\n</body>\n</html>\n")
+	return
+}
+
+/*
+markdownCell returns val escaped for use within a Markdown table cell. Pipes are escaped and line breaks are collapsed to spaces.
+*/
+func markdownCell(val string) string {
+	dst := make([]byte, 0, len(val))
+	for i := 0; i < len(val); i++ {
+		switch ch := val[i]; ch {
+		case '|', '\\':
+			dst = append(dst, '\\', ch)
+		case '\r', '\n':
+			dst = append(dst, ' ')
+		default:
+			dst = append(dst, ch)
+		}
+	}
+
+	return string(dst)
+}
@@ -0,0 +1,70 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportMarkdown(t *testing.T) {
+	ex := NewEnterpriseOID(56521)
+	ex.SetDescription("Example | Co.\nLtd.")
+	legacy := mustDot(t, `1.3.6.1.4.1.56521.9`)
+	legacy.SetStatus(StatusDeprecated)
+	m := ObjectIdentifierMap{`example`: ex, `legacy`: legacy, `cn`: mustDot(t, `2.5.4.3`)}
+
+	const head = "| OID | Name | Description | Status |\n| --- | --- | --- | --- |\n"
+	for _, tc := range []struct {
+		name string
+		base *ObjectIdentifier
+		want string
+	}{
+		{`subtree`, ex, head +
+			"| 1.3.6.1.4.1.56521 | example | Example \\| Co. Ltd. | current |\n" +
+			"| 1.3.6.1.4.1.56521.9 | legacy |  | deprecated |\n"},
+		{`all`, nil, head +
+			"| 1.3.6.1.4.1.56521 | example | Example \\| Co. Ltd. | current |\n" +
+			"| 1.3.6.1.4.1.56521.9 | legacy |  | deprecated |\n" +
+			"| 2.5.4.3 | cn |  | current |\n"},
+		{`empty`, mustDot(t, `2.999`), head},
+	} {
+		var b strings.Builder
+		if err := m.ExportMarkdown(&b, tc.base); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		} else if b.String() != tc.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tc.name, b.String(), tc.want)
+		}
+	}
+}
+
+func TestExportHTML(t *testing.T) {
+	ex := NewEnterpriseOID(56521)
+	ex.SetDescription(`Example <Co.>`)
+	m := ObjectIdentifierMap{`example`: ex}
+
+	for _, tc := range []struct {
+		name  string
+		title string
+		want  []string
+	}{
+		{`escaped`, `OIDs & more`, []string{
+			"<title>OIDs &amp; more</title>",
+			"<h1>OIDs &amp; more</h1>",
+			"<tr><td>1.3.6.1.4.1.56521</td><td>example</td><td>Example &lt;Co.&gt;</td><td>current</td></tr>\n",
+			"</table>\n</body>\n</html>\n",
+		}},
+	} {
+		var b strings.Builder
+		if err := m.ExportHTML(&b, nil, tc.title); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(b.String(), want) {
+				t.Errorf("%s: output lacks %q", tc.name, want)
+			}
+		}
+	}
+
+	if err := m.ExportHTML(failingWriter{}, nil, ``); err == nil {
+		t.Errorf("ExportHTML: expected write error")
+	}
+}
@@ -0,0 +1,165 @@
+package oid
+
+/*
+resolver.go contains the Resolver interface, by which the identifiers of unnamed arcs may be looked up.
+*/
+
+import (
+	"context"
+	"errors"
+)
+
+/*
+Resolver is implemented by types capable of returning the ObjectIdentifier registered for a given dotNotation value. Implementations should return an error wrapping ErrNotFound when no such ObjectIdentifier exists, and should honor cancellation of ctx.
+
+ObjectIdentifierMap implements Resolver.
+*/
+type Resolver interface {
+	Resolve(ctx context.Context, dot string) (*ObjectIdentifier, error)
+}
+
+/*
+Resolve returns the ObjectIdentifier within the receiver matching the dotNotation value dot, alongside an error. This method satisfies the Resolver interface.
+*/
+func (o ObjectIdentifierMap) Resolve(ctx context.Context, dot string) (x *ObjectIdentifier, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	if x = o[dot]; x.IsZero() {
+		var found bool
+		if x, found = o.Get(dot); !found {
+			err = errorw(ErrNotFound, "No %T registered for '%s'", x, dot)
+		}
+	}
+
+	return
+}
+
+/*
+resolverChain is a Resolver which consults each of its members in turn, returning the first answer other than one wrapping ErrNotFound.
+*/
+type resolverChain []Resolver
+
+func (c resolverChain) Resolve(ctx context.Context, dot string) (x *ObjectIdentifier, err error) {
+	err = errorw(ErrNotFound, "No %T registered for '%s'", x, dot)
+	for i := 0; i < len(c); i++ {
+		if c[i] == nil {
+			continue
+		} else if x, err = c[i].Resolve(ctx, dot); err == nil || !errors.Is(err, ErrNotFound) {
+			return
+		}
+	}
+
+	return
+}
+
+/*
+defaultInference returns the Resolver used for name inference when none is specified: the golden corpus, followed by the WellKnown database.
+*/
+func defaultInference() Resolver {
+	return resolverChain{corpusMap(), WellKnown()}
+}
+
+/*
+InferNames populates the identifier of every recognizable arc of the receiver lacking one, in the manner of the WithInferredNames constructor option, alongside an error. This is useful for OIDs constructed by other means, e.g.:
+
+	o, _ := oid.NewFromDot(`1.3.6.1.4.1.311`)
+	err := o.InferNames()
+*/
+func (o *ObjectIdentifier) InferNames(r ...Resolver) (err error) {
+	if !o.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid %T", o)
+		return
+	}
+
+	res := defaultInference()
+	if len(r) > 0 {
+		res = resolverChain(r)
+	}
+
+	o.setStandardArcNames()
+	return o.resolveNames(context.Background(), res)
+}
+
+/*
+NewObjectIdentifierContext returns an instance of *ObjectIdentifier alongside an error, as with NewObjectIdentifier. The identifier of each arc which lacks one, e.g. when parsing bare numeric input, is looked up using r. Cancellation of ctx aborts any outstanding lookups, in which case the error of ctx is returned.
+
+The r argument takes precedence over any WithResolver option present in opts.
+*/
+func NewObjectIdentifierContext(ctx context.Context, x any, r Resolver, opts ...Option) (o *ObjectIdentifier, err error) {
+	if err = ctx.Err(); err != nil {
+		return
+	}
+
+	opt := newOptions(opts...)
+	opt.ctx = ctx
+	if r != nil {
+		opt.resolver = r
+	}
+
+	return newObjectIdentifierOptions(x, opt)
+}
+
+/*
+resolveNames consults r for the identifier of each arc of the receiver which lacks one. Arcs unknown to r are left unnamed.
+*/
+func (o *ObjectIdentifier) resolveNames(ctx context.Context, r Resolver) (err error) {
+	var named bool
+	for i := 0; i < o.len(); i++ {
+		arcs := o.arcs()
+		if len(arcs[i].identifier) > 0 {
+			continue
+		} else if err = ctx.Err(); err != nil {
+			return
+		}
+
+		var x *ObjectIdentifier
+		if x, err = r.Resolve(ctx, dotArcs(arcs[:i+1])); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				err = nil
+				continue
+			}
+			return
+		}
+
+		if id := arcName(*x); len(id) > 0 {
+			o.lock()
+			if len(o.nANF[i].identifier) == 0 {
+				arcs = o.copyArcs()
+				arcs[i].identifier = intern(id)
+				o.nANF = arcs
+				named = true
+			}
+			o.unlock()
+		}
+	}
+
+	if named {
+		o.lock()
+		o.invalidate()
+		o.unlock()
+	}
+
+	return
+}
+
+/*
+arcName returns the identifier of the final arc of o or, failing that, its principal name or the first of its alt names that qualifies as an ASN.1 identifier.
+*/
+func arcName(o ObjectIdentifier) string {
+	if id := o.NameAndNumberForm().Identifier(); len(id) > 0 {
+		return id
+	} else if name := o.Name(); isIdentifier(name) {
+		return name
+	}
+
+	aka := o.AltNames()
+	for i := 0; i < len(aka); i++ {
+		if isIdentifier(aka[i]) {
+			return aka[i]
+		}
+	}
+
+	return ``
+}
@@ -0,0 +1,44 @@
+package oid
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type failingResolver struct{ err error }
+
+func (r failingResolver) Resolve(context.Context, string) (*ObjectIdentifier, error) {
+	return nil, r.err
+}
+
+func TestNewObjectIdentifierContext(t *testing.T) {
+	ex := mustDot(t, `1.3.6.1.4.1.56521`)
+	ex.SetName(`example`)
+	m := ObjectIdentifierMap{`example`: ex}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errBackend := errors.New("backend unavailable")
+
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		r    Resolver
+		want string
+		err  error
+	}{
+		{`map`, context.Background(), m, `{ 1 3 6 1 4 1 example(56521) 1 }`, nil},
+		{`not found`, context.Background(), failingResolver{ErrNotFound}, `{ 1 3 6 1 4 1 56521 1 }`, nil},
+		{`failure`, context.Background(), failingResolver{errBackend}, ``, errBackend},
+		{`canceled`, canceled, m, ``, context.Canceled},
+	} {
+		o, err := NewObjectIdentifierContext(tc.ctx, []int{1, 3, 6, 1, 4, 1, 56521, 1}, tc.r)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, tc.err)
+		} else if err == nil && o.String() != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, o, tc.want)
+		}
+	}
+}
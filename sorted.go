@@ -0,0 +1,49 @@
+package oid
+
+/*
+sorted.go deals with the iteration of ObjectIdentifierMap and Registry contents in OID order, rather than Go map order.
+*/
+
+import "sort"
+
+/*
+SortedKeys returns the keys of the receiver ordered by the arcs of their respective ObjectIdentifier instances, such that an OID sorts immediately before its descendants. Keys bearing the same OID are ordered lexically. Keys bearing a nil ObjectIdentifier are omitted.
+
+This ordering is stable across calls, making successive exports meaningful to diff.
+*/
+func (o ObjectIdentifierMap) SortedKeys() (keys []string) {
+	keys = make([]string, 0, len(o))
+	for k, v := range o {
+		if !v.IsZero() {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if c := compareArcs(o[keys[i]], o[keys[j]]); c != 0 {
+			return c < 0
+		}
+		return keys[i] < keys[j]
+	})
+
+	return
+}
+
+/*
+Range calls fn for each key and ObjectIdentifier within the receiver, in the order described by SortedKeys. Iteration stops should fn return false.
+*/
+func (o ObjectIdentifierMap) Range(fn func(key string, x *ObjectIdentifier) bool) {
+	keys := o.SortedKeys()
+	for i := 0; i < len(keys); i++ {
+		if !fn(keys[i], o[keys[i]]) {
+			return
+		}
+	}
+}
+
+/*
+Range calls fn for each key and ObjectIdentifier within a snapshot of the receiver, in the order described by ObjectIdentifierMap.SortedKeys. Iteration stops should fn return false. The receiver is not locked while fn is called, thus fn may safely modify the receiver.
+*/
+func (r *Registry) Range(fn func(key string, x *ObjectIdentifier) bool) {
+	r.Map().Range(fn)
+}
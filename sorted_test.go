@@ -0,0 +1,62 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+	cn := mustDot(t, `2.5.4.3`)
+	m := ObjectIdentifierMap{
+		`commonName`: cn,
+		`cn`:         cn,
+		`internet`:   mustDot(t, `1.3.6.1`),
+		`mgmt`:       mustDot(t, `1.3.6.1.2`),
+		`private`:    mustDot(t, `1.3.6.1.10`),
+		`dod`:        mustDot(t, `1.3.6`),
+		`empty`:      nil,
+	}
+
+	for _, tc := range []struct {
+		name string
+		stop string // the key at which to stop ranging, if any
+		want string
+	}{
+		{`all`, ``, `dod internet mgmt private cn commonName`},
+		{`stop`, `mgmt`, `dod internet mgmt`},
+	} {
+		var keys []string
+		m.Range(func(k string, x *ObjectIdentifier) bool {
+			if x != m[k] {
+				t.Errorf("%s: %s is mismatched", tc.name, k)
+			}
+			keys = append(keys, k)
+			return k != tc.stop
+		})
+
+		if got := strings.Join(keys, ` `); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+
+	if got := strings.Join(m.SortedKeys(), ` `); got != `dod internet mgmt private cn commonName` {
+		t.Errorf("SortedKeys: got %s", got)
+	}
+
+	r := NewRegistry()
+	for k, v := range m {
+		if v != nil {
+			r.Set(k, v)
+		}
+	}
+
+	var keys []string
+	r.Range(func(k string, _ *ObjectIdentifier) bool {
+		keys = append(keys, k)
+		r.Delete(k) // the receiver is not locked during the callback
+		return true
+	})
+	if got := strings.Join(keys, ` `); got != `dod internet mgmt private cn commonName` {
+		t.Errorf("Registry.Range: got %s", got)
+	}
+}
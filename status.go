@@ -0,0 +1,105 @@
+package oid
+
+/*
+status.go deals with the lifecycle status of OIDs, as tracked by schema and MIB documents.
+*/
+
+import "sort"
+
+/*
+Status describes the lifecycle status of an ObjectIdentifier. The zero value is StatusCurrent.
+*/
+type Status uint8
+
+const (
+	StatusCurrent    Status = iota // in active use
+	StatusDeprecated               // still recognized, but should not be used in new work
+	StatusObsolete                 // no longer in use
+	StatusReserved                 // set aside, but not yet assigned
+)
+
+var statusNames []string = []string{`current`, `deprecated`, `obsolete`, `reserved`}
+
+/*
+String returns the string name of the receiver, e.g. "deprecated".
+*/
+func (s Status) String() string {
+	if int(s) < len(statusNames) {
+		return statusNames[s]
+	}
+	return sprintf("Status(%d)", uint8(s))
+}
+
+/*
+ParseStatus returns the Status whose string name matches x in a case-folded manner, alongside an error.
+*/
+func ParseStatus(x string) (s Status, err error) {
+	for i := 0; i < len(statusNames); i++ {
+		if eq(statusNames[i], x) {
+			s = Status(i)
+			return
+		}
+	}
+
+	err = errorw(ErrInvalidValue, "Unknown %T '%s'", s, x)
+	return
+}
+
+/*
+Status returns the lifecycle status of the receiver. StatusCurrent is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) Status() Status {
+	if o.IsZero() {
+		return StatusCurrent
+	}
+
+	o.rlock()
+	defer o.runlock()
+
+	return o.status
+}
+
+/*
+SetStatus assigns s as the lifecycle status of the receiver.
+*/
+func (o *ObjectIdentifier) SetStatus(s Status) (err error) {
+	if o.IsZero() {
+		err = errorw(ErrInvalidRoot, "%T instance is nil", o)
+		return
+	} else if int(s) >= len(statusNames) {
+		err = errorw(ErrInvalidValue, "Unknown %T '%d'", s, uint8(s))
+		return
+	}
+
+	o.lock()
+	o.status = s
+	o.unlock()
+
+	return
+}
+
+/*
+ByStatus returns each ObjectIdentifier within the receiver bearing any of the provided lifecycle statuses, ordered by OID.
+*/
+func (o ObjectIdentifierMap) ByStatus(status ...Status) (oids []*ObjectIdentifier) {
+	seen := make(map[*ObjectIdentifier]bool)
+	for _, v := range o {
+		if v.IsZero() || seen[v] {
+			continue
+		}
+
+		for i := 0; i < len(status); i++ {
+			if v.Status() == status[i] {
+				oids = append(oids, v)
+				seen[v] = true
+				break
+			}
+		}
+	}
+
+	sort.Slice(oids, func(i, j int) bool {
+		return compareArcs(oids[i], oids[j]) < 0
+	})
+
+	return
+}
@@ -0,0 +1,74 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseStatus(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want Status
+		err  error
+	}{
+		{`current`, StatusCurrent, nil},
+		{`DEPRECATED`, StatusDeprecated, nil},
+		{`Obsolete`, StatusObsolete, nil},
+		{`reserved`, StatusReserved, nil},
+		{`retired`, StatusCurrent, ErrInvalidValue},
+		{``, StatusCurrent, ErrInvalidValue},
+	} {
+		s, err := ParseStatus(tc.in)
+		if !errors.Is(err, tc.err) || s != tc.want {
+			t.Errorf("%q: got %s (%v), want %s (%v)", tc.in, s, err, tc.want, tc.err)
+		} else if err == nil && !eq(s.String(), tc.in) {
+			t.Errorf("%q: String returned %q", tc.in, s)
+		}
+	}
+
+	if s := Status(9).String(); s != `Status(9)` {
+		t.Errorf("String: got %q, want Status(9)", s)
+	}
+	if err := mustDot(t, `2.999`).SetStatus(Status(9)); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("SetStatus: got %v, want %v", err, ErrInvalidValue)
+	}
+}
+
+func TestByStatus(t *testing.T) {
+	m := make(ObjectIdentifierMap)
+	for dot, s := range map[string]Status{
+		`2.999.1`: StatusCurrent,
+		`2.999.3`: StatusDeprecated,
+		`2.999.2`: StatusDeprecated,
+		`2.999.4`: StatusObsolete,
+	} {
+		o := mustDot(t, dot)
+		if err := o.SetStatus(s); err != nil {
+			t.Fatal(err)
+		}
+		m[dot] = o
+	}
+	// an alias must not produce a duplicate
+	m[`alias`] = m[`2.999.2`]
+
+	for _, tc := range []struct {
+		status []Status
+		want   []string
+	}{
+		{[]Status{StatusDeprecated}, []string{`2.999.2`, `2.999.3`}},
+		{[]Status{StatusObsolete, StatusCurrent}, []string{`2.999.1`, `2.999.4`}},
+		{[]Status{StatusReserved}, nil},
+		{nil, nil},
+	} {
+		got := m.ByStatus(tc.status...)
+		if len(got) != len(tc.want) {
+			t.Errorf("%v: got %d OIDs, want %v", tc.status, len(got), tc.want)
+			continue
+		}
+		for i := 0; i < len(got); i++ {
+			if d := got[i].DotNotation(); d != tc.want[i] {
+				t.Errorf("%v: got %s at #%d, want %s", tc.status, d, i, tc.want[i])
+			}
+		}
+	}
+}
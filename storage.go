@@ -0,0 +1,74 @@
+package oid
+
+/*
+storage.go deals with the allocation of ObjectIdentifier instances.
+*/
+
+import "sync"
+
+/*
+inlineArcs is the greatest number of arcs that may be stored alongside an ObjectIdentifier within a single allocation. Nearly all OIDs found in the wild, such as the X.520 attribute types, the PKIX arcs and the Microsoft certificate template extensions, have no more arcs than this.
+
+Every inline slot is paid for regardless of use, thus storage is sized in tiers of four (4), eight (8) and twelve (12) arcs, occupying the 416, 640 and 896 byte size classes respectively. A three-arc OID therefore pays for four arcs rather than twelve.
+*/
+const inlineArcs = 12
+
+/*
+storageHeader contains an ObjectIdentifier and its contents, its render cache and its lock, allowing all four to be allocated at once. It is embedded within each inlineStorage tier, and used alone for OIDs whose arcs do not fit within any tier.
+*/
+type storageHeader struct {
+	oid   ObjectIdentifier
+	state oidState
+	cache renderCache
+	mu    sync.RWMutex
+}
+
+/*
+inlineStorage4, inlineStorage8 and inlineStorage12 contain a storageHeader and a fixed-size array for the arcs of its ObjectIdentifier.
+*/
+type (
+	inlineStorage4 struct {
+		storageHeader
+		arcs [4]NameAndNumberForm
+	}
+	inlineStorage8 struct {
+		storageHeader
+		arcs [8]NameAndNumberForm
+	}
+	inlineStorage12 struct {
+		storageHeader
+		arcs [inlineArcs]NameAndNumberForm
+	}
+)
+
+/*
+newObjectIdentifier returns a new *ObjectIdentifier whose arcs slice is empty, with a capacity of at least n. When n is no greater than inlineArcs, the arcs are stored inline using the smallest tier that fits; otherwise they spill over into a slice of exactly n arcs, allocated separately.
+*/
+func newObjectIdentifier(n int) *ObjectIdentifier {
+	switch {
+	case n <= 4:
+		st := new(inlineStorage4)
+		return st.init(st.arcs[:0:4])
+	case n <= 8:
+		st := new(inlineStorage8)
+		return st.init(st.arcs[:0:8])
+	case n <= inlineArcs:
+		st := new(inlineStorage12)
+		return st.init(st.arcs[:0:inlineArcs])
+	}
+
+	return new(storageHeader).init(make([]NameAndNumberForm, 0, n))
+}
+
+/*
+init wires the ObjectIdentifier of the receiver to its contents, render cache and lock, assigns it arcs and returns it.
+*/
+func (h *storageHeader) init(arcs []NameAndNumberForm) (o *ObjectIdentifier) {
+	o = &h.oid
+	o.oidState = &h.state
+	o.cache = &h.cache
+	o.mu = &h.mu
+	o.nANF = arcs
+
+	return
+}
@@ -0,0 +1,72 @@
+package oid
+
+/*
+stringform.go deals with the selection of the representation returned by the String method of ObjectIdentifier.
+*/
+
+import "sync/atomic"
+
+/*
+StringForm describes a textual representation of an ObjectIdentifier.
+*/
+type StringForm uint32
+
+const (
+	FormNaNF     StringForm = iota // ASN.1 NameAndNumberForm sequence, e.g. { iso(1) identified-organization(3) dod(6) internet(1) }
+	FormDot                        // dotNotation, e.g. 1.3.6.1
+	FormNamedDot                   // named-dotted MIB name path, e.g. iso.org.dod.internet; see NamedDotNotation
+)
+
+var stringFormNames = [...]string{
+	FormNaNF:     `nanf`,
+	FormDot:      `dot`,
+	FormNamedDot: `named-dot`,
+}
+
+/*
+String returns the string name of the receiver, e.g. "nanf".
+*/
+func (f StringForm) String() string {
+	if int(f) < len(stringFormNames) {
+		return stringFormNames[f]
+	}
+	return `StringForm(` + itoa(int(f)) + `)`
+}
+
+var defaultStringForm atomic.Uint32
+
+/*
+SetDefaultStringForm sets the representation returned by the String method of all ObjectIdentifier instances, and thus by the fmt package's %s and %v verbs. The default is FormNaNF.
+
+This allows codebases whose logs and other output bear dotNotation to adopt this package without altering their formats, e.g.:
+
+	oid.SetDefaultStringForm(oid.FormDot)
+
+The default may be overridden on a per-call basis using StringAs. Unknown forms are ignored. This function is safe for concurrent use.
+*/
+func SetDefaultStringForm(f StringForm) {
+	if int(f) < len(stringFormNames) {
+		defaultStringForm.Store(uint32(f))
+	}
+}
+
+/*
+DefaultStringForm returns the representation currently returned by the String method of ObjectIdentifier. See SetDefaultStringForm.
+*/
+func DefaultStringForm() StringForm {
+	return StringForm(defaultStringForm.Load())
+}
+
+/*
+StringAs returns the representation of the receiver described by f, regardless of the package default. See SetDefaultStringForm. Unknown forms yield the ASN.1 NameAndNumberForm sequence. A zero string is returned if the receiver is nil.
+*/
+func (o *ObjectIdentifier) StringAs(f StringForm) string {
+	switch f {
+	case FormDot:
+		return o.DotNotation()
+	case FormNamedDot:
+		return o.NamedDotNotation()
+	}
+
+	return o.nanfString()
+}
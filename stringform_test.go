@@ -0,0 +1,54 @@
+package oid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStringForm(t *testing.T) {
+	t.Cleanup(func() { SetDefaultStringForm(FormNaNF) })
+
+	nanf := `{ iso(1) identified-organization(3) dod(6) internet(1) }`
+	o, err := NewObjectIdentifier(nanf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		def       StringForm
+		wantDef   StringForm
+		str, name string
+	}{
+		{FormNaNF, FormNaNF, nanf, `nanf`},
+		{FormDot, FormDot, `1.3.6.1`, `dot`},
+		{FormNamedDot, FormNamedDot, `iso.org.dod.internet`, `named-dot`},
+		{StringForm(99), FormNamedDot, `iso.org.dod.internet`, `StringForm(99)`},
+	} {
+		SetDefaultStringForm(tc.def)
+		if d := DefaultStringForm(); d != tc.wantDef {
+			t.Errorf("%s: got default %s, want %s", tc.def, d, tc.wantDef)
+		}
+		if s := o.String(); s != tc.str {
+			t.Errorf("%s: got %s, want %s", tc.def, s, tc.str)
+		} else if s = fmt.Sprint(o); s != tc.str {
+			t.Errorf("%s: printed as %s, want %s", tc.def, s, tc.str)
+		}
+		if n := tc.def.String(); n != tc.name {
+			t.Errorf("got name %q, want %q", n, tc.name)
+		}
+
+		// per-call overrides disregard the default
+		if s := o.StringAs(FormDot); s != `1.3.6.1` {
+			t.Errorf("%s: StringAs(FormDot) got %s", tc.def, s)
+		} else if s = o.StringAs(FormNaNF); s != nanf {
+			t.Errorf("%s: StringAs(FormNaNF) got %s", tc.def, s)
+		} else if s = o.StringAs(StringForm(99)); s != nanf {
+			t.Errorf("%s: StringAs(unknown) got %s", tc.def, s)
+		}
+	}
+
+	var nilOID *ObjectIdentifier
+	if s := nilOID.StringAs(FormDot); s != `` {
+		t.Errorf("nil receiver: got %q", s)
+	}
+}
@@ -0,0 +1,98 @@
+package oid
+
+/*
+subtree.go deals with the exchange of the portion of a Registry beneath a given arc, allowing slices of a shared registry to be maintained independently.
+*/
+
+import (
+	"encoding/json"
+	"io"
+)
+
+/*
+DumpSubtree writes the entries and reservations of the receiver that are equal to, or descendants of, base to w, in the JSON form produced by MarshalJSON. The result is suitable for use with LoadSubtree, e.g. by the team responsible for base within another copy of the registry.
+*/
+func (r *Registry) DumpSubtree(base *ObjectIdentifier, w io.Writer) (err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if !base.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid base %T", base)
+		return
+	}
+
+	r.mu.RLock()
+	j := r.toJSON(base)
+	r.mu.RUnlock()
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent(``, "\t")
+	err = enc.Encode(j)
+
+	return
+}
+
+/*
+LoadSubtree reads entries and reservations in the form written by DumpSubtree from rd, and replaces the portion of the receiver that is equal to, or a descendant of, base with them. Entries and reservations of the receiver beneath base that are absent from rd are removed, while the remainder of the receiver is left untouched.
+
+An error is returned, and the receiver left unchanged, should any entry or reservation within rd fall outside of base, or should the key of any entry within rd already be assigned to an OID outside of base. The replacement is recorded to the audit trail and history of the receiver, if enabled, but is not subject to its DuplicatePolicy.
+*/
+func (r *Registry) LoadSubtree(base *ObjectIdentifier, rd io.Reader) (err error) {
+	if r == nil || r.oids == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if !base.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid base %T", base)
+		return
+	}
+
+	var j registryJSON
+	if err = json.NewDecoder(rd).Decode(&j); err != nil {
+		return
+	}
+
+	var t *Registry
+	if t, err = j.registry(base); err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err = r.parents.checkMap(t.oids); err != nil {
+		return
+	}
+
+	for k := range t.oids {
+		if v, found := r.oids[k]; found && !v.under(base) {
+			err = errorw(ErrDisallowedParent, "Key '%s' is assigned to %s, which is not within %s", k, v.DotNotation(), base.DotNotation())
+			return
+		}
+	}
+
+	keys := r.oids.SortedKeys()
+	for i := 0; i < len(keys); i++ {
+		x := r.oids[keys[i]]
+		if _, keep := t.oids[keys[i]]; !keep && x.under(base) {
+			delete(r.oids, keys[i])
+			r.record(AuditDelete, keys[i], x)
+		}
+	}
+
+	keys = t.oids.SortedKeys()
+	for i := 0; i < len(keys); i++ {
+		r.oids[keys[i]] = t.oids[keys[i]]
+		r.record(AuditSet, keys[i], t.oids[keys[i]])
+	}
+
+	for dot := range r.plans {
+		if b, e := NewFromDot(dot); e == nil && b.under(base) {
+			delete(r.plans, dot)
+		}
+	}
+	for dot, plan := range t.plans {
+		r.plans[dot] = plan
+	}
+
+	return
+}
@@ -0,0 +1,75 @@
+package oid
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+/*
+newSubtreeRegistry returns a *Registry bearing each key and dotNotation pair within kv.
+*/
+func newSubtreeRegistry(t *testing.T, kv ...string) *Registry {
+	t.Helper()
+	r := NewRegistry()
+	for i := 0; i+1 < len(kv); i += 2 {
+		if err := r.Set(kv[i], mustDot(t, kv[i+1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return r
+}
+
+func TestSubtree(t *testing.T) {
+	base := NewEnterpriseOID(56521)
+	src := newSubtreeRegistry(t,
+		`example`, `1.3.6.1.4.1.56521`,
+		`attrs`, `1.3.6.1.4.1.56521.1`,
+		`classes`, `1.3.6.1.4.1.56521.2`,
+		`cn`, `2.5.4.3`)
+	if err := src.Reserve(base, `attributes`, 1, 99); err != nil {
+		t.Fatal(err)
+	}
+
+	var dump bytes.Buffer
+	if err := src.DumpSubtree(base, &dump); err != nil {
+		t.Fatal(err)
+	} else if strings.Contains(dump.String(), `2.5.4.3`) {
+		t.Errorf("DumpSubtree: output bears an OID outside of the base")
+	}
+
+	for _, tc := range []struct {
+		name string
+		dst  []string // key and dotNotation pairs of the target
+		base *ObjectIdentifier
+		keys string
+		err  error
+	}{
+		{`replace`, []string{`old`, `1.3.6.1.4.1.56521.9`, `sn`, `2.5.4.4`}, base,
+			`example attrs classes sn`, nil},
+		{`narrower base`, []string{`sn`, `2.5.4.4`}, mustDot(t, `1.3.6.1.4.1.56521.1`),
+			`sn`, ErrDisallowedParent},
+		{`key conflict`, []string{`attrs`, `2.5.4.4`}, base,
+			`attrs`, ErrDisallowedParent},
+	} {
+		dst := newSubtreeRegistry(t, tc.dst...)
+		err := dst.LoadSubtree(tc.base, bytes.NewReader(dump.Bytes()))
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, tc.err)
+		}
+		if keys := strings.Join(dst.Map().SortedKeys(), ` `); keys != tc.keys {
+			t.Errorf("%s: got keys %s, want %s", tc.name, keys, tc.keys)
+		}
+		if err == nil && len(dst.Reservations(base)) != 1 {
+			t.Errorf("%s: reservations were not loaded", tc.name)
+		}
+	}
+
+	if err := src.DumpSubtree(nil, &dump); !errors.Is(err, ErrInvalidRoot) {
+		t.Errorf("DumpSubtree: got %v, want %v", err, ErrInvalidRoot)
+	}
+	if err := (*Registry)(nil).LoadSubtree(base, &dump); !errors.Is(err, ErrNilInstance) {
+		t.Errorf("LoadSubtree: got %v, want %v", err, ErrNilInstance)
+	}
+}
@@ -0,0 +1,126 @@
+package oid
+
+/*
+subtreespec.go deals with SubtreeSpec, a subtree specification in the manner of RFC 3672, applied to OIDs rather than to directory names.
+*/
+
+/*
+SubtreeSpec describes a scoped portion of the OID tree in the manner of the SubtreeSpecification of RFC 3672, as used to delimit the administrative areas of directory services, e.g.:
+
+	spec := oid.SubtreeSpec{
+		Base:       oid.NewEnterpriseOID(56521),
+		ChopBefore: []*oid.ObjectIdentifier{oid.NewEnterpriseOID(56521, 99)},
+		Minimum:    1,
+		Maximum:    2,
+	}
+
+	spec.Matches(oid.NewEnterpriseOID(56521, 1, 5))    // true
+	spec.Matches(oid.NewEnterpriseOID(56521, 99, 1))   // false (chopped)
+	spec.Matches(oid.NewEnterpriseOID(56521, 1, 5, 3)) // false (too deep)
+
+Depth is measured in arcs beneath Base, which resides at depth zero (0). Unlike RFC 3672, whose chops are names relative to the base, the chops of a SubtreeSpec are complete OIDs, each of which must be equal to, or a descendant of, Base. Only number forms are considered.
+
+Instances are not modified by their methods, and are safe for concurrent use so long as their fields are not modified.
+*/
+type SubtreeSpec struct {
+	// Base is the root of the subtree. A nil Base matches nothing.
+	Base *ObjectIdentifier
+
+	// ChopBefore contains the OIDs which, alongside all of their
+	// descendants, are excluded from the subtree.
+	ChopBefore []*ObjectIdentifier
+
+	// ChopAfter contains the OIDs whose descendants are excluded
+	// from the subtree. The OIDs themselves are not excluded.
+	ChopAfter []*ObjectIdentifier
+
+	// Minimum is the depth beneath Base at which the subtree begins.
+	Minimum int
+
+	// Maximum is the depth beneath Base at which the subtree ends.
+	// Zero (0) denotes no limit; use ChopAfter with Base to limit
+	// the subtree to Base alone.
+	Maximum int
+}
+
+/*
+Validate returns an error if the receiver is malformed, i.e. if its Base is invalid, if any chop does not reside within Base, or if its depths are negative or contradictory. A nil error is returned otherwise.
+*/
+func (s SubtreeSpec) Validate() (err error) {
+	if !s.Base.valid() {
+		err = errorw(ErrInvalidRoot, "%T base did not pass validity checks", s)
+		return
+	} else if s.Minimum < 0 || s.Maximum < 0 {
+		err = errorw(ErrInvalidValue, "%T depths must not be negative (minimum %d, maximum %d)", s, s.Minimum, s.Maximum)
+		return
+	} else if s.Maximum > 0 && s.Minimum > s.Maximum {
+		err = errorw(ErrInvalidValue, "%T minimum %d exceeds maximum %d", s, s.Minimum, s.Maximum)
+		return
+	}
+
+	chops := append(append([]*ObjectIdentifier(nil), s.ChopBefore...), s.ChopAfter...)
+	for i := 0; i < len(chops); i++ {
+		if !chops[i].valid() {
+			err = errorw(ErrInvalidIdentifier, "%T chop #%d did not pass validity checks", s, i)
+			return
+		} else if chops[i].depthBeneath(s.Base) < 0 {
+			err = errorw(ErrDisallowedParent, "%T chop %s is not within base %s", s, chops[i].DotNotation(), s.Base.DotNotation())
+			return
+		}
+	}
+
+	return
+}
+
+/*
+Matches returns a Boolean value indicative of whether o falls within the subtree described by the receiver. An OID matches if it is equal to, or a descendant of, Base, if its depth beneath Base falls within Minimum and Maximum, and if it is not excluded by any chop.
+
+False is returned if o or Base is nil. The receiver is not checked for validity; see Validate.
+*/
+func (s SubtreeSpec) Matches(o *ObjectIdentifier) bool {
+	depth := o.depthBeneath(s.Base)
+	if depth < s.Minimum || (s.Maximum > 0 && depth > s.Maximum) {
+		// a negative depth, indicating that o is outside of
+		// Base, is always less than the minimum.
+		return false
+	}
+
+	for i := 0; i < len(s.ChopBefore); i++ {
+		if o.depthBeneath(s.ChopBefore[i]) >= 0 {
+			return false
+		}
+	}
+
+	for i := 0; i < len(s.ChopAfter); i++ {
+		if o.depthBeneath(s.ChopAfter[i]) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+Filter returns those of x that match the receiver, in their original order. See Matches.
+*/
+func (s SubtreeSpec) Filter(x []*ObjectIdentifier) (matched []*ObjectIdentifier) {
+	for i := 0; i < len(x); i++ {
+		if s.Matches(x[i]) {
+			matched = append(matched, x[i])
+		}
+	}
+
+	return
+}
+
+/*
+depthBeneath returns the number of arcs by which the receiver descends from base, or -1 if the receiver is neither equal to, nor a descendant of, base, or if either is nil.
+*/
+func (o *ObjectIdentifier) depthBeneath(base *ObjectIdentifier) int {
+	arcs, b := o.arcs(), base.arcs()
+	if !arcsUnder(arcs, b) {
+		return -1
+	}
+
+	return len(arcs) - len(b)
+}
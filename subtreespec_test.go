@@ -0,0 +1,67 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSubtreeSpecValidate(t *testing.T) {
+	base := NewEnterpriseOID(56521)
+	for _, tc := range []struct {
+		name string
+		spec SubtreeSpec
+		err  error
+	}{
+		{`base only`, SubtreeSpec{Base: base}, nil},
+		{`bounded`, SubtreeSpec{Base: base, Minimum: 1, Maximum: 2}, nil},
+		{`chops`, SubtreeSpec{Base: base, ChopBefore: []*ObjectIdentifier{NewEnterpriseOID(56521, 99)}, ChopAfter: []*ObjectIdentifier{base}}, nil},
+		{`nil base`, SubtreeSpec{}, ErrInvalidRoot},
+		{`negative`, SubtreeSpec{Base: base, Minimum: -1}, ErrInvalidValue},
+		{`contradictory`, SubtreeSpec{Base: base, Minimum: 3, Maximum: 2}, ErrInvalidValue},
+		{`nil chop`, SubtreeSpec{Base: base, ChopAfter: []*ObjectIdentifier{nil}}, ErrInvalidIdentifier},
+		{`chop outside base`, SubtreeSpec{Base: base, ChopBefore: []*ObjectIdentifier{NewEnterpriseOID(1)}}, ErrDisallowedParent},
+	} {
+		if err := tc.spec.Validate(); !errors.Is(err, tc.err) {
+			t.Errorf("%s: got error %v, want %v", tc.name, err, tc.err)
+		}
+	}
+}
+
+func TestSubtreeSpecMatches(t *testing.T) {
+	spec := SubtreeSpec{
+		Base:       NewEnterpriseOID(56521),
+		ChopBefore: []*ObjectIdentifier{NewEnterpriseOID(56521, 99)},
+		ChopAfter:  []*ObjectIdentifier{NewEnterpriseOID(56521, 7)},
+		Minimum:    1,
+		Maximum:    2,
+	}
+
+	for _, tc := range []struct {
+		o    *ObjectIdentifier
+		want bool
+	}{
+		{NewEnterpriseOID(56521, 1), true},
+		{NewEnterpriseOID(56521, 1, 5), true},
+		{NewEnterpriseOID(56521), false},          // above minimum
+		{NewEnterpriseOID(56521, 1, 5, 3), false}, // beneath maximum
+		{NewEnterpriseOID(56521, 99), false},      // chopped before
+		{NewEnterpriseOID(56521, 99, 1), false},   // chopped before
+		{NewEnterpriseOID(56521, 7), true},        // chopped after
+		{NewEnterpriseOID(56521, 7, 1), false},    // chopped after
+		{NewEnterpriseOID(1, 1), false},           // outside base
+		{nil, false},
+	} {
+		if got := spec.Matches(tc.o); got != tc.want {
+			t.Errorf("%s: got %t, want %t", tc.o.DotNotation(), got, tc.want)
+		}
+	}
+
+	if (SubtreeSpec{}).Matches(NewEnterpriseOID(1)) {
+		t.Errorf("nil base matched")
+	}
+
+	x := []*ObjectIdentifier{NewEnterpriseOID(56521, 2), NewEnterpriseOID(1), NewEnterpriseOID(56521, 1)}
+	if got := spec.Filter(x); len(got) != 2 || got[0] != x[0] || got[1] != x[2] {
+		t.Errorf("Filter: got %v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package oid
+
+/*
+template.go deals with the export of ObjectIdentifierMap contents by way of user-supplied text/template instances.
+*/
+
+import (
+	"io"
+	"text/template"
+)
+
+/*
+TemplateEntry is the data made available to a template executed by ExportTemplate.
+*/
+type TemplateEntry struct {
+	Key         string            // map key of the entry
+	Name        string            // name, chosen in the manner of ExportWireshark
+	Dot         string            // dotNotation, e.g. 1.3.6.1
+	NaNF        string            // nameAndNumberForm sequence
+	Identifier  string            // identifier of the final arc, if any
+	Annotation  string            // annotation of the final arc, if any
+	AltNames    []string          // alt names
+	Description string            // description, if any
+	Status      Status            // lifecycle status
+	Depth       int               // number of arcs
+	OID         *ObjectIdentifier // the ObjectIdentifier itself
+}
+
+/*
+ExportTemplate executes tmpl once per ObjectIdentifier within the receiver that is equal to, or a descendant of, base, writing the results to w. If base is nil, all ObjectIdentifier instances are exported. Entries are ordered by OID, and each is provided to tmpl as a TemplateEntry.
+
+This allows bespoke formats to be produced without the need for a dedicated exporter, e.g. a C header:
+
+	tmpl := template.Must(template.New(`h`).Parse("#define OID_{{.Name}} \"{{.Dot}}\"\n"))
+	err := myOIDs.ExportTemplate(os.Stdout, nil, tmpl)
+*/
+func (o ObjectIdentifierMap) ExportTemplate(w io.Writer, base *ObjectIdentifier, tmpl *template.Template) (err error) {
+	if tmpl == nil {
+		err = errorw(ErrNilInstance, "%T is nil", tmpl)
+		return
+	}
+
+	keys := make(map[*ObjectIdentifier]string, len(o))
+	for k, v := range o {
+		keys[v] = k
+	}
+
+	oids, names := o.subtree(base)
+	for i := 0; i < len(oids); i++ {
+		x := oids[i]
+		entry := TemplateEntry{
+			Key:         keys[x],
+			Name:        names[x],
+			Dot:         x.DotNotation(),
+			NaNF:        x.StringAs(FormNaNF),
+			Identifier:  x.NameAndNumberForm().Identifier(),
+			Annotation:  x.NameAndNumberForm().Annotation(),
+			AltNames:    x.AltNames(),
+			Description: x.Description(),
+			Status:      x.Status(),
+			Depth:       x.len(),
+			OID:         x,
+		}
+
+		if err = tmpl.Execute(w, entry); err != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,47 @@
+package oid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestExportTemplate(t *testing.T) {
+	cn, _ := NewFromNaNF(`{ joint-iso-itu-t(2) ds(5) attributeType(4) commonName(3) }`)
+	cn.SetAltNames(`cn`)
+	sn := mustDot(t, `2.5.4.4`)
+	sn.SetStatus(StatusObsolete)
+	m := ObjectIdentifierMap{`commonName`: cn, `sn`: sn, `example`: NewEnterpriseOID(56521)}
+
+	for _, tc := range []struct {
+		name string
+		text string
+		base *ObjectIdentifier
+		want string
+		err  bool
+	}{
+		{`header`, "#define OID_{{.Name}} \"{{.Dot}}\"\n", mustDot(t, `2.5.4`),
+			"#define OID_commonName \"2.5.4.3\"\n#define OID_sn \"2.5.4.4\"\n", false},
+		{`fields`, "{{.Key}} {{.Identifier}} {{.Depth}} {{.Status}} {{len .AltNames}}\n", mustDot(t, `2.5`),
+			"commonName commonName 4 current 1\nsn  4 obsolete 0\n", false},
+		{`nanf`, "{{.NaNF}}\n", cn,
+			"{ joint-iso-itu-t(2) ds(5) attributeType(4) commonName(3) }\n", false},
+		{`all`, "{{.Dot}} ", nil, "1.3.6.1.4.1.56521 2.5.4.3 2.5.4.4 ", false},
+		{`failure`, "{{.Missing}}", nil, ``, true},
+	} {
+		tmpl := template.Must(template.New(tc.name).Parse(tc.text))
+
+		var b strings.Builder
+		err := m.ExportTemplate(&b, tc.base, tmpl)
+		if (err != nil) != tc.err {
+			t.Errorf("%s: got error %v, want %t", tc.name, err, tc.err)
+		} else if err == nil && b.String() != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, b.String(), tc.want)
+		}
+	}
+
+	if err := m.ExportTemplate(&strings.Builder{}, nil, nil); !errors.Is(err, ErrNilInstance) {
+		t.Errorf("ExportTemplate: got %v, want %v", err, ErrNilInstance)
+	}
+}
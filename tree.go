@@ -0,0 +1,271 @@
+package oid
+
+/*
+tree.go deals with OIDTree, a hierarchical view of an OID space.
+*/
+
+/*
+OIDTree is a navigable hierarchy of OIDs. Inserting an OID creates any intermediate nodes needed to reach it, each of which bears the NameAndNumberForm of its arc.
+
+The zero value is not ready for use; see NewOIDTree.
+*/
+type OIDTree struct {
+	root *OIDTreeNode
+	len  int
+}
+
+/*
+OIDTreeNode is a single arc within an OIDTree. A node bears the NameAndNumberForm of its arc, the *ObjectIdentifier registered at that position (if any) and its children, ordered by number form.
+*/
+type OIDTreeNode struct {
+	nanf     NameAndNumberForm
+	oid      *ObjectIdentifier
+	parent   *OIDTreeNode
+	children []*OIDTreeNode
+}
+
+/*
+NewOIDTree returns a new, empty instance of *OIDTree.
+*/
+func NewOIDTree() *OIDTree {
+	return &OIDTree{root: new(OIDTreeNode)}
+}
+
+/*
+Tree returns an *OIDTree populated with each *ObjectIdentifier present within the receiver. Invalid instances are skipped.
+*/
+func (o ObjectIdentifierMap) Tree() *OIDTree {
+	t := NewOIDTree()
+	for _, v := range o {
+		t.Insert(v)
+	}
+
+	return t
+}
+
+/*
+Len returns the number of registered OIDs present within the receiver. Intermediate nodes bearing no *ObjectIdentifier are not counted.
+*/
+func (t *OIDTree) Len() int {
+	if t == nil {
+		return 0
+	}
+	return t.len
+}
+
+/*
+Roots returns the top-level nodes of the receiver, ordered by number form.
+*/
+func (t *OIDTree) Roots() []*OIDTreeNode {
+	if t == nil || t.root == nil {
+		return nil
+	}
+	return append([]*OIDTreeNode(nil), t.root.children...)
+}
+
+/*
+Insert registers o within the receiver, creating any intermediate nodes as needed. Should o already be present, the existing registration is replaced.
+
+Identifiers and annotations borne by the arcs of o are assigned to any node which lacks one.
+*/
+func (t *OIDTree) Insert(o *ObjectIdentifier) (err error) {
+	if t == nil || t.root == nil {
+		err = errorw(ErrNilInstance, "%T is nil", t)
+		return
+	} else if !o.valid() {
+		err = errorw(ErrInvalidRoot, "Cannot insert invalid %T into %T", o, t)
+		return
+	}
+
+	node := t.root
+	arcs := o.arcs()
+	for i := 0; i < len(arcs); i++ {
+		node = node.child(arcs[i], true)
+	}
+
+	if node.oid == nil {
+		t.len++
+	}
+	node.oid = o
+
+	return
+}
+
+/*
+Find returns the node at the position described by o, or nil if no such node exists. The node returned may be an intermediate node bearing no *ObjectIdentifier.
+*/
+func (t *OIDTree) Find(o *ObjectIdentifier) *OIDTreeNode {
+	if t == nil || t.root == nil || o.IsZero() {
+		return nil
+	}
+
+	node := t.root
+	arcs := o.arcs()
+	for i := 0; i < len(arcs) && node != nil; i++ {
+		node = node.child(arcs[i], false)
+	}
+
+	return node
+}
+
+/*
+Delete removes the registration of o from the receiver, returning a Boolean value indicative of success. Nodes left bearing neither an *ObjectIdentifier nor children are pruned.
+*/
+func (t *OIDTree) Delete(o *ObjectIdentifier) (deleted bool) {
+	node := t.Find(o)
+	if node == nil || node.oid == nil {
+		return
+	}
+
+	node.oid = nil
+	t.len--
+	deleted = true
+
+	for node.parent != nil && node.oid == nil && len(node.children) == 0 {
+		node.parent.removeChild(node)
+		node = node.parent
+	}
+
+	return
+}
+
+/*
+NameAndNumberForm returns the NameAndNumberForm of the arc represented by the receiver.
+*/
+func (n *OIDTreeNode) NameAndNumberForm() (nanf NameAndNumberForm) {
+	if n != nil {
+		nanf = n.nanf
+	}
+	return
+}
+
+/*
+ObjectIdentifier returns the *ObjectIdentifier registered at the receiver, or nil if the receiver is an intermediate node.
+*/
+func (n *OIDTreeNode) ObjectIdentifier() *ObjectIdentifier {
+	if n == nil {
+		return nil
+	}
+	return n.oid
+}
+
+/*
+Parent returns the parent node of the receiver, or nil if the receiver is a top-level node.
+*/
+func (n *OIDTreeNode) Parent() *OIDTreeNode {
+	if n == nil || n.parent == nil || n.parent.parent == nil {
+		return nil
+	}
+	return n.parent
+}
+
+/*
+Children returns the child nodes of the receiver, ordered by number form.
+*/
+func (n *OIDTreeNode) Children() []*OIDTreeNode {
+	if n == nil {
+		return nil
+	}
+	return append([]*OIDTreeNode(nil), n.children...)
+}
+
+/*
+Child returns the child node of the receiver bearing number form num, or nil if not found.
+*/
+func (n *OIDTreeNode) Child(num uint) *OIDTreeNode {
+	if n == nil {
+		return nil
+	}
+	return n.child(NameAndNumberForm{primaryIdentifier: num}, false)
+}
+
+/*
+Depth returns the number of arcs leading to the receiver, e.g.: the node for 1.3.6 has a depth of three (3).
+*/
+func (n *OIDTreeNode) Depth() (depth int) {
+	for ; n != nil && n.parent != nil; n = n.parent {
+		depth++
+	}
+	return
+}
+
+/*
+IsLeaf returns a Boolean value indicative of whether the receiver has no children.
+*/
+func (n *OIDTreeNode) IsLeaf() bool {
+	return n == nil || len(n.children) == 0
+}
+
+/*
+dotNotation returns the dotNotation of the arcs leading to the receiver.
+*/
+func (n *OIDTreeNode) dotNotation() string {
+	var nodes []*OIDTreeNode
+	for ; n != nil && n.parent != nil; n = n.parent {
+		nodes = append(nodes, n)
+	}
+
+	dst := make([]byte, 0, 32)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		dst = nodes[i].nanf.appendNumber(dst)
+		if i > 0 {
+			dst = append(dst, '.')
+		}
+	}
+
+	return string(dst)
+}
+
+/*
+child returns the child of the receiver bearing the number form of nanf. If create is true, a missing child is created, and a child lacking an identifier or annotation adopts that of nanf.
+*/
+func (n *OIDTreeNode) child(nanf NameAndNumberForm, create bool) *OIDTreeNode {
+	idx, found := n.search(nanf)
+	if found {
+		c := n.children[idx]
+		if create && len(c.nanf.identifier) == 0 {
+			c.nanf.identifier = nanf.identifier
+		}
+		if create && len(c.nanf.annotation) == 0 {
+			c.nanf.annotation = nanf.annotation
+		}
+		return c
+	} else if !create {
+		return nil
+	}
+
+	c := &OIDTreeNode{nanf: nanf, parent: n}
+	n.children = append(n.children, nil)
+	copy(n.children[idx+1:], n.children[idx:])
+	n.children[idx] = c
+
+	return c
+}
+
+/*
+search returns the index at which a child bearing the number form of nanf resides or, if not found, the index at which it would be inserted.
+*/
+func (n *OIDTreeNode) search(nanf NameAndNumberForm) (idx int, found bool) {
+	lo, hi := 0, len(n.children)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if n.children[mid].nanf.cmp(nanf) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	idx = lo
+	found = idx < len(n.children) && n.children[idx].nanf.cmp(nanf) == 0
+	return
+}
+
+/*
+removeChild removes c from the children of the receiver.
+*/
+func (n *OIDTreeNode) removeChild(c *OIDTreeNode) {
+	if idx, found := n.search(c.nanf); found {
+		n.children = append(n.children[:idx], n.children[idx+1:]...)
+	}
+}
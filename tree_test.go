@@ -0,0 +1,116 @@
+package oid
+
+import (
+	"errors"
+	"testing"
+)
+
+/*
+newTestTree returns an *OIDTree bearing each of the provided dotNotation values.
+*/
+func newTestTree(t *testing.T, dots ...string) *OIDTree {
+	t.Helper()
+	tree := NewOIDTree()
+	for _, dot := range dots {
+		if err := tree.Insert(mustDot(t, dot)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tree
+}
+
+func TestOIDTreeFind(t *testing.T) {
+	tree := newTestTree(t, `1.3.6.1.4.1`, `1.3.6.1`, `1.3.6.1.2`, `2.5.4.3`)
+	if n := tree.Len(); n != 4 {
+		t.Errorf("Len: got %d, want 4", n)
+	}
+	if n := len(tree.Roots()); n != 2 {
+		t.Errorf("Roots: got %d, want 2", n)
+	}
+
+	for _, tc := range []struct {
+		dot      string
+		found    bool
+		oid      bool
+		depth    int
+		children int
+	}{
+		{`1`, true, false, 1, 1},
+		{`1.3.6.1`, true, true, 4, 2},
+		{`1.3.6.1.4`, true, false, 5, 1},
+		{`1.3.6.1.4.1`, true, true, 6, 0},
+		{`2.5.4.3`, true, true, 4, 0},
+		{`2.5.4.4`, false, false, 0, 0},
+		{`0`, false, false, 0, 0},
+	} {
+		n := tree.Find(mustDot(t, tc.dot))
+		if found := n != nil; found != tc.found {
+			t.Errorf("%s: got found %t, want %t", tc.dot, found, tc.found)
+			continue
+		} else if !found {
+			continue
+		}
+
+		if oid := n.ObjectIdentifier() != nil; oid != tc.oid {
+			t.Errorf("%s: got registered %t, want %t", tc.dot, oid, tc.oid)
+		}
+		if d := n.Depth(); d != tc.depth {
+			t.Errorf("%s: got depth %d, want %d", tc.dot, d, tc.depth)
+		}
+		if c := len(n.Children()); c != tc.children || n.IsLeaf() != (c == 0) {
+			t.Errorf("%s: got %d children, want %d", tc.dot, c, tc.children)
+		}
+		if d := n.dotNotation(); d != tc.dot {
+			t.Errorf("%s: got dotNotation %s", tc.dot, d)
+		}
+		if p := n.Parent(); p != nil && p.Child(n.NameAndNumberForm().primaryIdentifier) != n {
+			t.Errorf("%s: not found among the children of its parent", tc.dot)
+		}
+	}
+
+	if err := tree.Insert(nil); !errors.Is(err, ErrInvalidRoot) {
+		t.Errorf("Insert: got %v, want %v", err, ErrInvalidRoot)
+	}
+	if err := (*OIDTree)(nil).Insert(mustDot(t, `2.999`)); !errors.Is(err, ErrNilInstance) {
+		t.Errorf("Insert: got %v, want %v", err, ErrNilInstance)
+	}
+}
+
+func TestOIDTreeDelete(t *testing.T) {
+	for _, tc := range []struct {
+		dot     string
+		deleted bool
+		len     int
+		pruned  string // the highest node expected to be pruned, if any
+	}{
+		{`1.3.6.1.4.1`, true, 2, `1.3.6.1.4`},
+		{`1.3.6.1`, true, 2, ``},
+		{`1.3.6`, false, 3, ``},
+		{`2.5.4.3`, true, 2, `2`},
+		{`2.999`, false, 3, ``},
+	} {
+		tree := newTestTree(t, `1.3.6.1.4.1`, `1.3.6.1`, `2.5.4.3`)
+		if deleted := tree.Delete(mustDot(t, tc.dot)); deleted != tc.deleted {
+			t.Errorf("%s: got deleted %t, want %t", tc.dot, deleted, tc.deleted)
+		}
+		if n := tree.Len(); n != tc.len {
+			t.Errorf("%s: got Len %d, want %d", tc.dot, n, tc.len)
+		}
+		if len(tc.pruned) > 0 && tree.Find(mustDot(t, tc.pruned)) != nil {
+			t.Errorf("%s: %s was not pruned", tc.dot, tc.pruned)
+		}
+	}
+}
+
+func TestObjectIdentifierMapTree(t *testing.T) {
+	cn := mustDot(t, `2.5.4.3`)
+	m := ObjectIdentifierMap{`cn`: cn, `commonName`: cn, `sn`: mustDot(t, `2.5.4.4`)}
+
+	tree := m.Tree()
+	if n := tree.Len(); n != 2 {
+		t.Errorf("Len: got %d, want 2", n)
+	}
+	if n := tree.Find(cn); n == nil || n.ObjectIdentifier() != cn {
+		t.Errorf("Find: got %v, want %s", n, cn.DotNotation())
+	}
+}
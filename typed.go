@@ -0,0 +1,230 @@
+package oid
+
+/*
+typed.go deals with TypedRegistry, a concurrency-safe store of arbitrary values keyed by OID.
+*/
+
+import (
+	"encoding/asn1"
+	"sort"
+	"sync"
+)
+
+/*
+TypedRegistry is a concurrency-safe store of values of type T, each keyed by an ObjectIdentifier. This allows applications to index their own types, such as attribute definitions, handlers or codecs, by OID while retaining the lookup conveniences of this package, e.g.:
+
+	codecs := oid.NewTypedRegistry[Codec]()
+	codecs.Set(oid.CN(), directoryStringCodec)
+
+	c, found := codecs.Get(`commonName`)
+
+Values are keyed by the dotNotation of their ObjectIdentifier, thus setting a value for an OID already present replaces it. The zero value is not ready for use; see NewTypedRegistry.
+*/
+type TypedRegistry[T any] struct {
+	mu      sync.RWMutex
+	entries map[string]typedEntry[T]
+}
+
+/*
+typedEntry is a single assignment within a TypedRegistry.
+*/
+type typedEntry[T any] struct {
+	oid *ObjectIdentifier
+	val T
+}
+
+/*
+NewTypedRegistry returns a new, empty instance of *TypedRegistry[T].
+*/
+func NewTypedRegistry[T any]() *TypedRegistry[T] {
+	return &TypedRegistry[T]{entries: make(map[string]typedEntry[T])}
+}
+
+/*
+Set assigns val to the receiver under o, replacing any value already assigned to the same OID.
+*/
+func (r *TypedRegistry[T]) Set(o *ObjectIdentifier, val T) (err error) {
+	if r == nil || r.entries == nil {
+		err = errorw(ErrNilInstance, "%T is nil", r)
+		return
+	} else if !o.valid() {
+		err = errorw(ErrInvalidRoot, "Invalid %T", o)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[o.DotNotation()] = typedEntry[T]{oid: o, val: val}
+
+	return
+}
+
+/*
+Get returns the value assigned to the OID matching term alongside a Boolean value indicative of a successful match. Supported term types are those of ObjectIdentifier.Equal, as well as *ObjectIdentifier, thus an entry may be found by its dotNotation, its NameAndNumberForm sequence or any of its names.
+*/
+func (r *TypedRegistry[T]) Get(term any) (val T, found bool) {
+	_, val, found = r.Lookup(term)
+	return
+}
+
+/*
+Lookup returns the ObjectIdentifier matching term, and the value assigned to it, alongside a Boolean value indicative of a successful match. Supported term types are those of Get.
+*/
+func (r *TypedRegistry[T]) Lookup(term any) (o *ObjectIdentifier, val T, found bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var e typedEntry[T]
+	if e, found = r.find(term); found {
+		o, val = e.oid, e.val
+	}
+
+	return
+}
+
+/*
+LongestMatch returns the ObjectIdentifier, and assigned value, of the entry which is equal to, or the nearest ancestor of, the OID described by term, alongside a Boolean value indicative of a successful match. Supported term types are *ObjectIdentifier, asn1.ObjectIdentifier, []int and the string forms accepted by ParseAny.
+*/
+func (r *TypedRegistry[T]) LongestMatch(term any) (o *ObjectIdentifier, val T, found bool) {
+	if r == nil {
+		return
+	}
+
+	t := typedTermOID(term)
+	if !t.valid() {
+		return
+	}
+
+	arcs := t.arcs()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for j := len(arcs); j > 0; j-- {
+		if e, ok := r.entries[dotArcs(arcs[:j])]; ok {
+			o, val, found = e.oid, e.val, true
+			return
+		}
+	}
+
+	return
+}
+
+/*
+Delete removes the entry matching term, returning a Boolean value indicative of whether an entry was removed. Supported term types are those of Get.
+*/
+func (r *TypedRegistry[T]) Delete(term any) (deleted bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var e typedEntry[T]
+	if e, deleted = r.find(term); deleted {
+		delete(r.entries, e.oid.DotNotation())
+	}
+
+	return
+}
+
+/*
+Len returns the integer length of the receiver.
+*/
+func (r *TypedRegistry[T]) Len() int {
+	if r == nil {
+		return 0
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return len(r.entries)
+}
+
+/*
+Range calls fn for each ObjectIdentifier and assigned value within a snapshot of the receiver, in OID order. Iteration stops should fn return false. The receiver is not locked while fn is called, thus fn may safely modify the receiver.
+*/
+func (r *TypedRegistry[T]) Range(fn func(o *ObjectIdentifier, val T) bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.RLock()
+	snap := make([]typedEntry[T], 0, len(r.entries))
+	for _, e := range r.entries {
+		snap = append(snap, e)
+	}
+	r.mu.RUnlock()
+
+	sort.Slice(snap, func(i, j int) bool {
+		return compareArcs(snap[i].oid, snap[j].oid) < 0
+	})
+
+	for i := 0; i < len(snap); i++ {
+		if !fn(snap[i].oid, snap[i].val) {
+			return
+		}
+	}
+}
+
+/*
+find returns the entry matching term. The caller must hold the lock.
+*/
+func (r *TypedRegistry[T]) find(term any) (e typedEntry[T], found bool) {
+	if dot, ok := typedTermDot(term); ok {
+		e, found = r.entries[dot]
+		return
+	}
+
+	for _, v := range r.entries {
+		if v.oid.Equal(term) {
+			e, found = v, true
+			return
+		}
+	}
+
+	return
+}
+
+/*
+typedTermDot returns the dotNotation of term, alongside a Boolean value indicative of whether term is of a type from which it can be derived directly.
+*/
+func typedTermDot(term any) (dot string, ok bool) {
+	switch tv := term.(type) {
+	case *ObjectIdentifier:
+		dot, ok = tv.DotNotation(), tv.valid()
+	case asn1.ObjectIdentifier:
+		dot, ok = tv.String(), len(tv) > 0
+	case []int:
+		dot, ok = asn1.ObjectIdentifier(tv).String(), len(tv) > 0
+	case string:
+		dot, ok = tv, isDotNotation(tv)
+	}
+
+	return
+}
+
+/*
+typedTermOID returns the *ObjectIdentifier described by term, or nil if none could be derived.
+*/
+func typedTermOID(term any) (o *ObjectIdentifier) {
+	switch tv := term.(type) {
+	case *ObjectIdentifier:
+		o = tv
+	case asn1.ObjectIdentifier:
+		o, _ = NewFromInts([]int(tv))
+	case []int:
+		o, _ = NewFromInts(tv)
+	case string:
+		o, _ = ParseAny(tv)
+	}
+
+	return
+}
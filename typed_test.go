@@ -0,0 +1,76 @@
+package oid
+
+import (
+	"encoding/asn1"
+	"errors"
+	"testing"
+)
+
+func TestTypedRegistry(t *testing.T) {
+	r := NewTypedRegistry[int]()
+	cn := mustDot(t, `2.5.4.3`)
+	cn.SetName(`cn`)
+	for _, tc := range []struct {
+		o   *ObjectIdentifier
+		val int
+	}{
+		{cn, 3},
+		{mustDot(t, `2.5.4`), 4},
+		{mustDot(t, `1.3.6.1.4.1.56521`), 56521},
+	} {
+		if err := r.Set(tc.o, tc.val); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tc := range []struct {
+		term    any
+		get     int
+		found   bool
+		longest int
+	}{
+		{`2.5.4.3`, 3, true, 3},
+		{`cn`, 3, true, 3},
+		{cn, 3, true, 3},
+		{asn1.ObjectIdentifier{2, 5, 4}, 4, true, 4},
+		{[]int{2, 5, 4, 10}, 0, false, 4},
+		{`2.5.4.3.1`, 0, false, 3},
+		{`{ 1 3 6 1 4 1 56521 1 }`, 0, false, 56521},
+		{`2.5`, 0, false, 0},
+	} {
+		val, found := r.Get(tc.term)
+		if found != tc.found || val != tc.get {
+			t.Errorf("Get(%v): got %d (%t), want %d (%t)", tc.term, val, found, tc.get, tc.found)
+		}
+		if _, val, found = r.LongestMatch(tc.term); found != (tc.longest != 0) || val != tc.longest {
+			t.Errorf("LongestMatch(%v): got %d (%t), want %d", tc.term, val, found, tc.longest)
+		}
+	}
+
+	var order []int
+	r.Range(func(_ *ObjectIdentifier, val int) bool {
+		order = append(order, val)
+		return len(order) < 2
+	})
+	if len(order) != 2 || order[0] != 56521 || order[1] != 4 {
+		t.Errorf("Range: got %v, want [56521 4]", order)
+	}
+
+	if !r.Delete(`cn`) || r.Delete(`cn`) || r.Len() != 2 {
+		t.Errorf("Delete: got %d entries, want 2", r.Len())
+	}
+
+	var nr *TypedRegistry[int]
+	for _, tc := range []struct {
+		name string
+		err  error
+		want error
+	}{
+		{`nil registry`, nr.Set(cn, 1), ErrNilInstance},
+		{`nil OID`, r.Set(nil, 1), ErrInvalidRoot},
+	} {
+		if !errors.Is(tc.err, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, tc.err, tc.want)
+		}
+	}
+}
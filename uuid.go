@@ -0,0 +1,45 @@
+package oid
+
+/*
+uuid.go deals with the conversion of UUIDs to and from OIDs beneath the {joint-iso-itu-t uuid(25)} arc, as described in ITU-T Rec. X.667.
+*/
+
+import "math/big"
+
+/*
+FromUUID returns an instance of *ObjectIdentifier representing the provided UUID beneath the {joint-iso-itu-t(2) uuid(25)} arc. The UUID is expressed as a single arc whose value is the unsigned 128-bit integer form of uuid, e.g.:
+
+	{ joint-iso-itu-t(2) uuid(25) 329800735698586629295641978511506172918 }
+*/
+func FromUUID(uuid [16]byte) (o *ObjectIdentifier) {
+	o = newObjectIdentifier(3)
+	o.nANF = append(o.nANF,
+		NameAndNumberForm{identifier: `joint-iso-itu-t`, primaryIdentifier: 2},
+		NameAndNumberForm{identifier: `uuid`, primaryIdentifier: 25},
+		NameAndNumberForm{},
+	)
+	o.nANF[2].setBig(new(big.Int).SetBytes(uuid[:]))
+
+	return
+}
+
+/*
+ToUUID returns the UUID represented by the receiver alongside an error. An error is returned if the receiver is not a child of the {joint-iso-itu-t(2) uuid(25)} arc, or if its final arc exceeds 128 bits.
+*/
+func (o *ObjectIdentifier) ToUUID() (uuid [16]byte, err error) {
+	arcs := o.arcs()
+	if len(arcs) != 3 || arcs[0].huge != nil || arcs[1].huge != nil ||
+		arcs[0].primaryIdentifier != 2 || arcs[1].primaryIdentifier != 25 {
+		err = errorw(ErrInvalidRoot, "%T is not a child of the {joint-iso-itu-t(2) uuid(25)} arc", o)
+		return
+	}
+
+	b := arcs[2].Big()
+	if b.BitLen() > 128 {
+		err = errorw(ErrInvalidNumberForm, "UUID arc '%s' exceeds 128 bits", b)
+		return
+	}
+	b.FillBytes(uuid[:])
+
+	return
+}
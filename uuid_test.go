@@ -0,0 +1,47 @@
+package oid
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestUUID(t *testing.T) {
+	for _, tc := range []struct {
+		uuid string
+		dot  string
+	}{
+		{`f81d4fae7dec11d0a76500a0c91e6bf6`, `2.25.329800735698586629295641978511506172918`},
+		{`00000000000000000000000000000000`, `2.25.0`},
+		{`00000000000000000000000000000001`, `2.25.1`},
+		{`ffffffffffffffffffffffffffffffff`, `2.25.340282366920938463463374607431768211455`},
+	} {
+		var uuid [16]byte
+		hex.Decode(uuid[:], []byte(tc.uuid))
+
+		o := FromUUID(uuid)
+		if got := o.DotNotation(); got != tc.dot {
+			t.Errorf("FromUUID(%s): got %s, want %s", tc.uuid, got, tc.dot)
+		} else if nanf := o.StringAs(FormNaNF); nanf[:29] != `{ joint-iso-itu-t(2) uuid(25)` {
+			t.Errorf("FromUUID(%s): got %s", tc.uuid, nanf)
+		}
+
+		if back, err := mustDot(t, tc.dot).ToUUID(); err != nil || back != uuid {
+			t.Errorf("ToUUID(%s): got %x (%v), want %s", tc.dot, back, err, tc.uuid)
+		}
+	}
+
+	for _, tc := range []struct {
+		dot string
+		err error
+	}{
+		{`2.25`, ErrInvalidRoot},
+		{`2.25.1.1`, ErrInvalidRoot},
+		{`2.26.1`, ErrInvalidRoot},
+		{`2.25.340282366920938463463374607431768211456`, ErrInvalidNumberForm},
+	} {
+		if _, err := mustDot(t, tc.dot).ToUUID(); !errors.Is(err, tc.err) {
+			t.Errorf("ToUUID(%s): got %v, want %v", tc.dot, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package oid
+
+/*
+walk.go deals with the traversal of OIDTree instances.
+*/
+
+/*
+WalkOrder describes the order in which the nodes of an OIDTree are visited.
+*/
+type WalkOrder uint8
+
+const (
+	PreOrder     WalkOrder = iota // depth-first; a node is visited before its children
+	BreadthFirst                  // all nodes of a given depth are visited before those of the next
+)
+
+/*
+Visitor is a function invoked for each node visited during a walk. Returning false terminates the walk.
+*/
+type Visitor func(node *OIDTreeNode) bool
+
+/*
+Walk visits each node of the receiver in the specified order, children being visited in order of number form. Intermediate nodes bearing no *ObjectIdentifier are visited as well.
+
+The return value is false if the walk was terminated by visit.
+*/
+func (t *OIDTree) Walk(order WalkOrder, visit Visitor) bool {
+	if t == nil || t.root == nil || visit == nil {
+		return true
+	}
+	return walkNodes(t.root.children, order, visit)
+}
+
+/*
+Walk visits the receiver and each of its descendants in the specified order. See OIDTree.Walk for details.
+*/
+func (n *OIDTreeNode) Walk(order WalkOrder, visit Visitor) bool {
+	if n == nil || visit == nil {
+		return true
+	}
+	return walkNodes([]*OIDTreeNode{n}, order, visit)
+}
+
+func walkNodes(nodes []*OIDTreeNode, order WalkOrder, visit Visitor) bool {
+	switch order {
+	case PreOrder:
+		for i := 0; i < len(nodes); i++ {
+			if !visit(nodes[i]) || !walkNodes(nodes[i].children, order, visit) {
+				return false
+			}
+		}
+	case BreadthFirst:
+		queue := append([]*OIDTreeNode(nil), nodes...)
+		for i := 0; i < len(queue); i++ {
+			if !visit(queue[i]) {
+				return false
+			}
+			queue = append(queue, queue[i].children...)
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWalk(t *testing.T) {
+	tree := newTestTree(t, `1.3.6`, `1.3.7`, `1.2`, `2.5`)
+
+	for _, tc := range []struct {
+		name  string
+		start string // a zero string walks the whole tree
+		order WalkOrder
+		stop  string // the dotNotation at which to terminate
+		want  string
+	}{
+		{`pre-order`, ``, PreOrder, ``, `1 1.2 1.3 1.3.6 1.3.7 2 2.5`},
+		{`breadth-first`, ``, BreadthFirst, ``, `1 2 1.2 1.3 2.5 1.3.6 1.3.7`},
+		{`pre-order stop`, ``, PreOrder, `1.3.6`, `1 1.2 1.3 1.3.6`},
+		{`breadth-first stop`, ``, BreadthFirst, `1.3`, `1 2 1.2 1.3`},
+		{`subtree`, `1.3`, PreOrder, ``, `1.3 1.3.6 1.3.7`},
+		{`subtree breadth-first`, `1`, BreadthFirst, ``, `1 1.2 1.3 1.3.6 1.3.7`},
+	} {
+		var seen []string
+		visit := func(n *OIDTreeNode) bool {
+			seen = append(seen, n.dotNotation())
+			return n.dotNotation() != tc.stop
+		}
+
+		var done bool
+		if len(tc.start) == 0 {
+			done = tree.Walk(tc.order, visit)
+		} else {
+			done = tree.Find(mustDot(t, tc.start)).Walk(tc.order, visit)
+		}
+
+		if got := strings.Join(seen, ` `); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+		if done != (len(tc.stop) == 0) {
+			t.Errorf("%s: got completion %t", tc.name, done)
+		}
+	}
+
+	if !(*OIDTree)(nil).Walk(PreOrder, nil) || !(*OIDTreeNode)(nil).Walk(PreOrder, nil) {
+		t.Errorf("Walk: nil instances must report completion")
+	}
+}
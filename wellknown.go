@@ -0,0 +1,128 @@
+package oid
+
+/*
+wellknown.go contains the well-known OID database and the means by which its tables are loaded.
+*/
+
+//go:generate go run gencorpus.go wellknown.tsv
+
+import (
+	_ "embed"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+wellKnownGZ is the gzip-compressed form of wellknown.tsv, which is decompressed upon first use of the WellKnown database. Following any change to wellknown.tsv, run go generate to refresh it.
+*/
+//go:embed wellknown.tsv.gz
+var wellKnownGZ []byte
+
+var (
+	wellKnown     ObjectIdentifierMap = make(ObjectIdentifierMap)
+	wellKnownOnce sync.Once
+	wellKnownRows []wellKnownOID
+	embedDisabled atomic.Bool
+)
+
+/*
+WellKnown returns the well-known OID database, which contains ObjectIdentifier instances that are commonly encountered in the wild, keyed by their dotNotation. The preferred name of an OID is available through its Name method, while any other names by which it is known are available through its AltNames method, e.g.:
+
+	certTmpl, found := oid.WellKnown().Get(`szOID_CERTIFICATE_TEMPLATE`)
+
+The database is populated from the tables embedded within this package upon the first call of this function, such that programs which never consult it do not pay for its contents. This function is safe for concurrent use, and the database it returns is shared process-wide.
+
+An empty database is returned if DisableEmbeddedTables was called beforehand.
+*/
+func WellKnown() ObjectIdentifierMap {
+	wellKnownOnce.Do(func() {
+		if embedDisabled.Load() {
+			return
+		}
+
+		wellKnownRows = parseWellKnown(inflate(wellKnownGZ))
+		loadWellKnownTable(wellKnownRows)
+	})
+
+	return wellKnown
+}
+
+/*
+DisableEmbeddedTables prevents the loading of the WellKnown database and the golden corpus, such that both remain empty for the life of the process, which spares their memory in programs that have no use for them. Name inference, Canonicalize, ParseNamedDot and other functions that consult them behave as though they know no OIDs, and accessors drawn from them, such as CN and GSSMechanism, return nil.
+
+This function must be called before either is first used, e.g. at the start of main, and has no effect afterwards.
+*/
+func DisableEmbeddedTables() {
+	embedDisabled.Store(true)
+}
+
+/*
+wellKnownOID describes a single row within a well-known OID table.
+*/
+type wellKnownOID struct {
+	table string
+	dot   string
+	aka   []string
+}
+
+/*
+parseWellKnown parses the TAB-delimited well-known OID tables, retaining the order of their rows. A malformed line indicates a corrupt build and results in a panic.
+*/
+func parseWellKnown(tsv string) (rows []wellKnownOID) {
+	lines := split(tsv, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := trimR(lines[i], "\r")
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+
+		f := split(line, "\t")
+		if len(f) != 3 {
+			panic(errorw(ErrSyntax, "Malformed well-known OID table line #%d: '%s'", i+1, line))
+		}
+		rows = append(rows, wellKnownOID{
+			table: f[0],
+			dot:   f[1],
+			aka:   split(f[2], ","),
+		})
+	}
+
+	return
+}
+
+/*
+wellKnownTable returns the rows of the well-known OID table bearing the provided name, e.g. "gssapi", loading the WellKnown database as needed. A nil slice is returned if DisableEmbeddedTables was called beforehand.
+*/
+func wellKnownTable(name string) (rows []wellKnownOID) {
+	WellKnown()
+	for i := 0; i < len(wellKnownRows); i++ {
+		if wellKnownRows[i].table == name {
+			rows = append(rows, wellKnownRows[i])
+		}
+	}
+
+	return
+}
+
+/*
+loadWellKnownTable loads the provided rows into the WellKnown database. The first name of each row is used as the principal name, and any others as alt names. Should an OID already be present, all names of the table row are added to the alt names of the existing instance.
+*/
+func loadWellKnownTable(table []wellKnownOID) {
+	for i := 0; i < len(table); i++ {
+		if x, found := wellKnown[table[i].dot]; found {
+			x.SetAltNames(table[i].aka...)
+			continue
+		}
+
+		x, err := NewFromDot(table[i].dot)
+		if err != nil {
+			panic(err)
+		}
+		if len(table[i].aka) > 0 {
+			x.SetName(table[i].aka[0])
+			x.SetAltNames(table[i].aka[1:]...)
+		}
+
+		wellKnown.Set(table[i].dot, x)
+	}
+}
@@ -0,0 +1,197 @@
+package oid
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+/*
+TestEmbeddedTablesCaseDuplicates guards against names within the embedded tables which differ from another name of the same OID only in case, which name folding renders redundant.
+*/
+func TestEmbeddedTablesCaseDuplicates(t *testing.T) {
+	names := make(map[string][]string)
+	for _, row := range parseWellKnown(inflate(wellKnownGZ)) {
+		names[row.dot] = append(names[row.dot], row.aka...)
+	}
+	for _, e := range parseCorpus(inflate(corpusGZ)) {
+		names[`corpus `+e.Dot] = e.Names
+	}
+
+	for dot, aka := range names {
+		seen := make(map[string]string, len(aka))
+		for _, name := range aka {
+			if prev, found := seen[strings.ToLower(name)]; found && prev != name {
+				t.Errorf("%s: names %q and %q differ only in case", dot, prev, name)
+			}
+			seen[strings.ToLower(name)] = name
+		}
+	}
+
+	if o, found := WellKnown().Get(`KRB5`); !found || o.DotNotation() != `1.2.840.113554.1.2.2` {
+		t.Errorf("Get(KRB5): got %v, want 1.2.840.113554.1.2.2", o)
+	}
+}
+
+/*
+TestWellKnownAccessor verifies that the methods of the database returned by WellKnown see its contents, and that the accessors drawn from it resolve.
+*/
+func TestWellKnownAccessor(t *testing.T) {
+	wk := WellKnown()
+	if keys := wk.SortedKeys(); len(keys) == 0 || len(keys) != len(wk) {
+		t.Fatalf("SortedKeys: got %d keys for %d entries", len(keys), len(wk))
+	}
+
+	for _, tc := range []struct {
+		name string
+		got  *ObjectIdentifier
+		dot  string
+	}{
+		{`CN`, CN(), `2.5.4.3`},
+		{`O`, O(), `2.5.4.10`},
+		{`DC`, DC(), `0.9.2342.19200300.100.1.25`},
+		{`Get`, func() *ObjectIdentifier { o, _ := wk.Get(`commonName`); return o }(), `2.5.4.3`},
+	} {
+		if tc.got.IsZero() || tc.got.DotNotation() != tc.dot {
+			t.Errorf("%s: got %v, want %s", tc.name, tc.got, tc.dot)
+		}
+	}
+}
+
+/*
+TestMicrosoftAliases verifies that the Microsoft szOID names of the AD and AD CS extensions resolve within the WellKnown database, regardless of case, and are borne by the instances found.
+*/
+func TestMicrosoftAliases(t *testing.T) {
+	for _, tc := range []struct {
+		alias string
+		dot   string
+	}{
+		{`szOID_CERTSRV_CA_VERSION`, `1.3.6.1.4.1.311.21.1`},
+		{`szOID_CERTIFICATE_TEMPLATE`, `1.3.6.1.4.1.311.21.7`},
+		{`szOID_APPLICATION_CERT_POLICIES`, `1.3.6.1.4.1.311.21.10`},
+		{`szOID_NTDS_CA_SECURITY_EXT`, `1.3.6.1.4.1.311.25.2`},
+		{`szOID_KP_EFS`, `1.3.6.1.4.1.311.10.3.4`},
+		{`szoid_kp_efs`, `1.3.6.1.4.1.311.10.3.4`},
+	} {
+		o, found := WellKnown().Get(tc.alias)
+		if !found || o.DotNotation() != tc.dot {
+			t.Errorf("Get(%s): got %v, want %s", tc.alias, o, tc.dot)
+			continue
+		}
+
+		names := append([]string{o.Name()}, o.AltNames()...)
+		if !strInSlice(tc.alias, names) {
+			t.Errorf("%s: names %v lack alias", tc.dot, names)
+		}
+	}
+}
+
+/*
+TestCMSNames verifies that the CMS content types, PKCS #9 attributes and S/MIME capabilities resolve within the WellKnown database by each of their names.
+*/
+func TestCMSNames(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		dot  string
+	}{
+		{`id-data`, `1.2.840.113549.1.7.1`},
+		{`signedData`, `1.2.840.113549.1.7.2`},
+		{`id-ct-authEnvelopedData`, `1.2.840.113549.1.9.16.1.23`},
+		{`id-ct-TSTInfo`, `1.2.840.113549.1.9.16.1.4`},
+		{`contentType`, `1.2.840.113549.1.9.3`},
+		{`pkcs-9-at-messageDigest`, `1.2.840.113549.1.9.4`},
+		{`signingTime`, `1.2.840.113549.1.9.5`},
+		{`smimeCapabilities`, `1.2.840.113549.1.9.15`},
+		{`preferSignedData`, `1.2.840.113549.1.9.15.1`},
+		{`id-aa-signingCertificateV2`, `1.2.840.113549.1.9.16.2.47`},
+		{`aes256-GCM`, `2.16.840.1.101.3.4.1.46`},
+	} {
+		if o, found := WellKnown().Get(tc.name); !found || o.DotNotation() != tc.dot {
+			t.Errorf("Get(%s): got %v, want %s", tc.name, o, tc.dot)
+		}
+	}
+}
+
+/*
+TestRevocationNames verifies that the Certificate Transparency, OCSP and CRL extension names resolve within the WellKnown database.
+*/
+func TestRevocationNames(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		dot  string
+	}{
+		{`embeddedSCTList`, `1.3.6.1.4.1.11129.2.4.2`},
+		{`ct-precert-poison`, `1.3.6.1.4.1.11129.2.4.3`},
+		{`ocspSCTList`, `1.3.6.1.4.1.11129.2.4.5`},
+		{`id-pkix-ocsp-nonce`, `1.3.6.1.5.5.7.48.1.2`},
+		{`ocspNoCheck`, `1.3.6.1.5.5.7.48.1.5`},
+		{`caIssuers`, `1.3.6.1.5.5.7.48.2`},
+		{`cRLReason`, `2.5.29.21`},
+		{`invalidityDate`, `2.5.29.24`},
+		{`id-ce-issuingDistributionPoint`, `2.5.29.28`},
+		{`freshestCRL`, `2.5.29.46`},
+		{`holdInstructionReject`, `1.2.840.10040.2.3`},
+	} {
+		if o, found := WellKnown().Get(tc.name); !found || o.DotNotation() != tc.dot {
+			t.Errorf("Get(%s): got %v, want %s", tc.name, o, tc.dot)
+		}
+	}
+}
+
+/*
+TestLDAPSchemaNames verifies that the standard LDAP matching rules and syntaxes resolve within the WellKnown database, the latter by way of their descriptions.
+*/
+func TestLDAPSchemaNames(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		dot  string
+	}{
+		{`caseIgnoreMatch`, `2.5.13.2`},
+		{`distinguishedNameMatch`, `2.5.13.1`},
+		{`integerMatch`, `2.5.13.14`},
+		{`objectIdentifierFirstComponentMatch`, `2.5.13.30`},
+		{`Directory String`, `1.3.6.1.4.1.1466.115.121.1.15`},
+		{`DN`, `1.3.6.1.4.1.1466.115.121.1.12`},
+	} {
+		if o, found := WellKnown().Get(tc.name); !found || o.DotNotation() != tc.dot {
+			t.Errorf("Get(%s): got %v, want %s", tc.name, o, tc.dot)
+		}
+	}
+}
+
+/*
+TestDisableEmbeddedTables runs within a child process, as the embedded tables cannot be disabled once loaded by any other test.
+*/
+func TestDisableEmbeddedTables(t *testing.T) {
+	if os.Getenv(`OID_TEST_DISABLE_EMBEDDED`) != `1` {
+		cmd := exec.Command(os.Args[0], `-test.run=^TestDisableEmbeddedTables$`)
+		cmd.Env = append(os.Environ(), `OID_TEST_DISABLE_EMBEDDED=1`)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("%v\n%s", err, out)
+		}
+		return
+	}
+
+	DisableEmbeddedTables()
+	for _, tc := range []struct {
+		name  string
+		empty bool
+	}{
+		{`WellKnown`, len(WellKnown()) == 0},
+		{`GoldenCorpus`, GoldenCorpus() == nil},
+		{`GoldenCorpusTSV`, GoldenCorpusTSV() == ``},
+		{`CN`, CN() == nil},
+		{`ParseAny descriptor`, func() bool { _, err := ParseAny(`commonName`); return err != nil }()},
+		{`NamedDotNotation`, mustDot(t, `1.3.6.1.2.1`).NamedDotNotation() == `iso.org.6.1.2.1`},
+	} {
+		if !tc.empty {
+			t.Errorf("%s: embedded tables were consulted", tc.name)
+		}
+	}
+
+	// parsing of numeric input is unaffected
+	if _, err := ParseAny(`2.5.4.3`); err != nil {
+		t.Error(err)
+	}
+}
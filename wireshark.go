@@ -0,0 +1,81 @@
+package oid
+
+/*
+wireshark.go deals with the export of ObjectIdentifierMap contents in the format of the Wireshark "Object Identifiers" user table.
+*/
+
+import "io"
+
+/*
+ExportWireshark writes an entry to w for each ObjectIdentifier within the receiver that is equal to, or a descendant of, base, in the format of the Wireshark "Object Identifiers" user table (Preferences > Name Resolution). If base is nil, all ObjectIdentifier instances are exported. Entries are ordered by OID, e.g.:
+
+	"1.3.6.1.4.1.56521","example"
+	"1.3.6.1.4.1.56521.1","exampleAttributes"
+
+The name of each entry is, in order of preference, the map key (unless it is merely the dotNotation of the OID), the principal name, the identifier of the final arc or the first alt name. ObjectIdentifier instances lacking any name are skipped.
+*/
+func (o ObjectIdentifierMap) ExportWireshark(w io.Writer, base *ObjectIdentifier) (err error) {
+	oids, names := o.subtree(base)
+	for i := 0; i < len(oids); i++ {
+		if len(names[oids[i]]) == 0 {
+			continue
+		}
+
+		line := sprintf("%s,%s\n", uatString(oids[i].DotNotation()), uatString(names[oids[i]]))
+		if _, err = io.WriteString(w, line); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+/*
+subtree returns each ObjectIdentifier within the receiver that is equal to, or a descendant of, base, ordered by OID, alongside the entryName of each. If base is nil, all ObjectIdentifier instances are returned.
+*/
+func (o ObjectIdentifierMap) subtree(base *ObjectIdentifier) (oids []*ObjectIdentifier, names map[*ObjectIdentifier]string) {
+	names = make(map[*ObjectIdentifier]string, len(o))
+	o.Range(func(k string, v *ObjectIdentifier) bool {
+		if base == nil || v.under(base) {
+			oids = append(oids, v)
+			names[v] = entryName(k, *v)
+		}
+		return true
+	})
+
+	return
+}
+
+/*
+entryName returns the most suitable name for the provided ObjectIdentifier, or a zero string if it has none.
+*/
+func entryName(key string, o ObjectIdentifier) string {
+	if len(key) > 0 && key != o.DotNotation() && !isDigit(key) {
+		return key
+	} else if name := o.Name(); len(name) > 0 {
+		return name
+	} else if id := o.NameAndNumberForm().Identifier(); len(id) > 0 {
+		return id
+	} else if aka := o.AltNames(); len(aka) > 0 {
+		return aka[0]
+	}
+
+	return ``
+}
+
+/*
+uatString returns val as a quoted Wireshark user table string, escaping quotes, backslashes and non-printable characters as \xNN sequences.
+*/
+func uatString(val string) string {
+	q := `"`
+	for i := 0; i < len(val); i++ {
+		ch := val[i]
+		if ch < 0x20 || ch > 0x7E || ch == '"' || ch == '\\' {
+			q += sprintf("\\x%02x", ch)
+			continue
+		}
+		q += string(ch)
+	}
+
+	return q + `"`
+}
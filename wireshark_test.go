@@ -0,0 +1,37 @@
+package oid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportWireshark(t *testing.T) {
+	named := mustDot(t, `1.3.6.1.4.1.56521.1`)
+	named.SetName(`exampleAttributes`)
+	aliased := mustDot(t, `1.3.6.1.4.1.56521.2`)
+	aliased.SetAltNames(`exampleAlias`)
+	m := ObjectIdentifierMap{
+		`example`:             NewEnterpriseOID(56521),
+		`1.3.6.1.4.1.56521.1`: named,
+		`1.3.6.1.4.1.56521.2`: aliased,
+		`1.3.6.1.4.1.56521.3`: mustDot(t, `1.3.6.1.4.1.56521.3`),
+		`quote "me"`:          mustDot(t, `2.5.4.3`),
+	}
+
+	for _, tc := range []struct {
+		name string
+		base *ObjectIdentifier
+		want string
+	}{
+		{`subtree`, NewEnterpriseOID(56521), "\"1.3.6.1.4.1.56521\",\"example\"\n" +
+			"\"1.3.6.1.4.1.56521.1\",\"exampleAttributes\"\n" +
+			"\"1.3.6.1.4.1.56521.2\",\"exampleAlias\"\n"},
+		{`escaped`, mustDot(t, `2.5`), "\"2.5.4.3\",\"quote \\x22me\\x22\"\n"},
+		{`empty`, mustDot(t, `2.999`), ``},
+	} {
+		var b strings.Builder
+		if err := m.ExportWireshark(&b, tc.base); err != nil || b.String() != tc.want {
+			t.Errorf("%s: got %q (%v), want %q", tc.name, b.String(), err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package wk
+
+/*
+Cryptographic Message Syntax and PKCS #9 (RFC 5652 and RFC 2985)
+*/
+const (
+	CMSPkcs7                         = "1.2.840.113549.1.7"         // pkcs-7
+	CMSData                          = "1.2.840.113549.1.7.1"       // id-data, data
+	CMSSignedData                    = "1.2.840.113549.1.7.2"       // id-signedData, signedData
+	CMSEnvelopedData                 = "1.2.840.113549.1.7.3"       // id-envelopedData, envelopedData
+	CMSSignedAndEnvelopedData        = "1.2.840.113549.1.7.4"       // signedAndEnvelopedData
+	CMSDigestedData                  = "1.2.840.113549.1.7.5"       // id-digestedData, digestedData
+	CMSEncryptedData                 = "1.2.840.113549.1.7.6"       // id-encryptedData, encryptedData
+	CMSPkcs9                         = "1.2.840.113549.1.9"         // pkcs-9
+	CMSEmailAddress                  = "1.2.840.113549.1.9.1"       // emailAddress, pkcs-9-at-emailAddress
+	CMSUnstructuredName              = "1.2.840.113549.1.9.2"       // unstructuredName, pkcs-9-at-unstructuredName
+	CMSContentType                   = "1.2.840.113549.1.9.3"       // contentType, id-contentType, pkcs-9-at-contentType
+	CMSMessageDigest                 = "1.2.840.113549.1.9.4"       // messageDigest, id-messageDigest, pkcs-9-at-messageDigest
+	CMSSigningTime                   = "1.2.840.113549.1.9.5"       // signingTime, id-signingTime, pkcs-9-at-signingTime
+	CMSCountersignature              = "1.2.840.113549.1.9.6"       // countersignature, id-countersignature, pkcs-9-at-counterSignature
+	CMSChallengePassword             = "1.2.840.113549.1.9.7"       // challengePassword, pkcs-9-at-challengePassword
+	CMSUnstructuredAddress           = "1.2.840.113549.1.9.8"       // unstructuredAddress, pkcs-9-at-unstructuredAddress
+	CMSExtendedCertificateAttributes = "1.2.840.113549.1.9.9"       // extendedCertificateAttributes, pkcs-9-at-extendedCertificateAttributes
+	CMSSigningDescription            = "1.2.840.113549.1.9.13"      // signingDescription, pkcs-9-at-signingDescription
+	CMSExtensionRequest              = "1.2.840.113549.1.9.14"      // extensionRequest, pkcs-9-at-extensionRequest
+	CMSSmimeCapabilities             = "1.2.840.113549.1.9.15"      // smimeCapabilities, pkcs-9-at-smimeCapabilities
+	CMSPreferSignedData              = "1.2.840.113549.1.9.15.1"    // preferSignedData
+	CMSCanNotDecryptAny              = "1.2.840.113549.1.9.15.2"    // canNotDecryptAny
+	CMSSMIMECapabilitiesVersions     = "1.2.840.113549.1.9.15.3"    // sMIMECapabilitiesVersions
+	CMSSmime                         = "1.2.840.113549.1.9.16"      // smime, id-smime
+	CMSCtReceipt                     = "1.2.840.113549.1.9.16.1.1"  // id-ct-receipt
+	CMSCtAuthData                    = "1.2.840.113549.1.9.16.1.2"  // id-ct-authData, authenticatedData
+	CMSCtTSTInfo                     = "1.2.840.113549.1.9.16.1.4"  // id-ct-TSTInfo
+	CMSCtContentInfo                 = "1.2.840.113549.1.9.16.1.6"  // id-ct-contentInfo
+	CMSCtCompressedData              = "1.2.840.113549.1.9.16.1.9"  // id-ct-compressedData, compressedData
+	CMSCtAuthEnvelopedData           = "1.2.840.113549.1.9.16.1.23" // id-ct-authEnvelopedData, authEnvelopedData
+	CMSAaReceiptRequest              = "1.2.840.113549.1.9.16.2.1"  // id-aa-receiptRequest
+	CMSAaEncrypKeyPref               = "1.2.840.113549.1.9.16.2.11" // id-aa-encrypKeyPref
+	CMSAaSigningCertificate          = "1.2.840.113549.1.9.16.2.12" // id-aa-signingCertificate
+	CMSAaTimeStampToken              = "1.2.840.113549.1.9.16.2.14" // id-aa-timeStampToken
+	CMSAaSigningCertificateV2        = "1.2.840.113549.1.9.16.2.47" // id-aa-signingCertificateV2
+	CMSAlgAEADChaCha20Poly1305       = "1.2.840.113549.1.9.16.3.18" // id-alg-AEADChaCha20Poly1305
+	CMSFriendlyName                  = "1.2.840.113549.1.9.20"      // friendlyName, pkcs-9-at-friendlyName
+	CMSLocalKeyId                    = "1.2.840.113549.1.9.21"      // localKeyId, pkcs-9-at-localKeyId
+	CMSAaCMSAlgorithmProtection      = "1.2.840.113549.1.9.52"      // id-aa-CMSAlgorithmProtection
+)
@@ -0,0 +1,10 @@
+/*
+Package wk contains named constants for hundreds of well-known OIDs, grouped by domain, allowing common OIDs to be referenced in a compile-time checked manner rather than by string literal, e.g.:
+
+	o, err := oid.NewFromDot(wk.PKIXKpServerAuth)
+
+Each constant is the dotNotation form of its OID, and is named after the OID's ASN.1 identifier, prefixed by its domain: PKIX, X520, SNMP, LDAP or CMS. The constants are generated from the WellKnown database and golden corpus of package oid; see gen.go.
+*/
+package wk
+
+//go:generate go run gen.go
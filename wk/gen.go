@@ -0,0 +1,201 @@
+//go:build ignore
+
+/*
+gen.go generates the constants of package wk from the WellKnown database and the golden corpus of package oid. It is invoked by way of go generate.
+*/
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+/*
+domain describes a group of constants, each of which is written to its own file. Each OID beneath a base of the domain is named using the prefix of the most specific such base.
+*/
+type domain struct {
+	file  string
+	title string
+	bases []base
+}
+
+type base struct {
+	dot    string
+	prefix string
+}
+
+var domains = []domain{
+	{`pkix.go`, `Internet X.509 PKI (RFC 5280 and related)`, []base{
+		{`1.3.6.1.5.5.7`, `PKIX`},
+	}},
+	{`x520.go`, `X.520 selected attribute types`, []base{
+		{`2.5.4`, `X520`},
+	}},
+	{`snmp.go`, `SNMP management information (RFC 1213 and RFC 3411)`, []base{
+		{`1.3.6.1.2`, `SNMP`},
+		{`1.3.6.1.6`, `SNMP`},
+	}},
+	{`ldap.go`, `LDAP schema elements (RFC 4512, RFC 4517 and RFC 4524)`, []base{
+		{`1.3.6.1.4.1.1466`, `LDAP`},
+		{`1.3.6.1.4.1.1466.115.121.1`, `LDAPSyntax`},
+		{`1.3.6.1.1`, `LDAP`},
+		{`2.5.6`, `LDAP`},
+		{`2.5.13`, `LDAP`},
+		{`2.5.18`, `LDAP`},
+		{`2.5.21`, `LDAP`},
+		{`0.9.2342.19200300.100`, `LDAP`},
+	}},
+	{`cms.go`, `Cryptographic Message Syntax and PKCS #9 (RFC 5652 and RFC 2985)`, []base{
+		{`1.2.840.113549.1.7`, `CMS`},
+		{`1.2.840.113549.1.9`, `CMS`},
+	}},
+}
+
+/*
+entry is a single OID known to WellKnown or the golden corpus.
+*/
+type entry struct {
+	dot   string
+	arcs  []int
+	names []string
+}
+
+func main() {
+	entries := collect()
+	for _, d := range domains {
+		if err := write(d, entries); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+/*
+collect returns each OID known to WellKnown or the golden corpus, ordered by OID. The identifier of the final arc within the golden corpus, when present, is the first name of each entry.
+*/
+func collect() (entries []entry) {
+	seen := make(map[string]int)
+	add := func(o *oid.ObjectIdentifier, names ...string) {
+		dot := o.DotNotation()
+		idx, found := seen[dot]
+		if !found {
+			a, err := o.ASN1E()
+			if err != nil {
+				return
+			}
+			idx = len(entries)
+			seen[dot] = idx
+			entries = append(entries, entry{dot: dot, arcs: []int(a)})
+		}
+
+		for _, name := range names {
+			if len(name) > 0 && !has(entries[idx].names, name) {
+				entries[idx].names = append(entries[idx].names, name)
+			}
+		}
+	}
+
+	for _, c := range oid.GoldenCorpus() {
+		o, err := oid.NewFromNaNF(c.NaNF)
+		if err != nil {
+			log.Fatalf("%s: %v", c.Dot, err)
+		}
+		add(o, append([]string{o.NameAndNumberForm().Identifier()}, c.Names...)...)
+	}
+
+	for _, o := range oid.WellKnown() {
+		add(o, append([]string{o.NameAndNumberForm().Identifier(), o.Name()}, o.AltNames()...)...)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].arcs, entries[j].arcs
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+
+	return
+}
+
+/*
+write generates the file of d, bearing a constant for each entry beneath any of its bases.
+*/
+func write(d domain, entries []entry) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go; DO NOT EDIT.\n\npackage wk\n\n")
+	fmt.Fprintf(&buf, "/*\n%s\n*/\nconst (\n", d.title)
+
+	used := make(map[string]string)
+	for _, e := range entries {
+		prefix := d.prefix(e.dot)
+		if len(prefix) == 0 || len(e.names) == 0 {
+			continue
+		}
+
+		name := prefix + goName(e.names[0])
+		if prev, dup := used[name]; dup {
+			return fmt.Errorf("%s: %s collides with %s", name, e.dot, prev)
+		}
+		used[name] = e.dot
+
+		fmt.Fprintf(&buf, "\t%s = %q // %s\n", name, e.dot, strings.Join(e.names, `, `))
+	}
+	fmt.Fprintf(&buf, ")\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(d.file, src, 0644)
+}
+
+/*
+goName returns the exported Go form of name, e.g. ServerAuth for id-kp-serverAuth. The "id-" prefix is removed, and each remaining word is capitalized.
+*/
+func goName(name string) string {
+	name = strings.TrimPrefix(name, `id-`)
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9')
+	})
+
+	var b strings.Builder
+	for _, w := range words {
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+
+	return b.String()
+}
+
+/*
+prefix returns the prefix of the most specific base of d beneath which dot resides, or a zero string if none.
+*/
+func (d domain) prefix(dot string) (prefix string) {
+	var longest int
+	for _, b := range d.bases {
+		if (dot == b.dot || strings.HasPrefix(dot, b.dot+`.`)) && len(b.dot) > longest {
+			prefix, longest = b.prefix, len(b.dot)
+		}
+	}
+
+	return
+}
+
+func has(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,183 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package wk
+
+/*
+LDAP schema elements (RFC 4512, RFC 4517 and RFC 4524)
+*/
+const (
+	LDAPUid                                 = "0.9.2342.19200300.100.1.1"     // uid, userid
+	LDAPTextEncodedORAddress                = "0.9.2342.19200300.100.1.2"     // textEncodedORAddress
+	LDAPMail                                = "0.9.2342.19200300.100.1.3"     // mail, rfc822Mailbox
+	LDAPInfo                                = "0.9.2342.19200300.100.1.4"     // info
+	LDAPDrink                               = "0.9.2342.19200300.100.1.5"     // drink, favouriteDrink
+	LDAPRoomNumber                          = "0.9.2342.19200300.100.1.6"     // roomNumber
+	LDAPPhoto                               = "0.9.2342.19200300.100.1.7"     // photo
+	LDAPUserClass                           = "0.9.2342.19200300.100.1.8"     // userClass
+	LDAPHost                                = "0.9.2342.19200300.100.1.9"     // host
+	LDAPManager                             = "0.9.2342.19200300.100.1.10"    // manager
+	LDAPDocumentIdentifier                  = "0.9.2342.19200300.100.1.11"    // documentIdentifier
+	LDAPDocumentTitle                       = "0.9.2342.19200300.100.1.12"    // documentTitle
+	LDAPDocumentVersion                     = "0.9.2342.19200300.100.1.13"    // documentVersion
+	LDAPDocumentAuthor                      = "0.9.2342.19200300.100.1.14"    // documentAuthor
+	LDAPDocumentLocation                    = "0.9.2342.19200300.100.1.15"    // documentLocation
+	LDAPHomePhone                           = "0.9.2342.19200300.100.1.20"    // homePhone, homeTelephoneNumber
+	LDAPSecretary                           = "0.9.2342.19200300.100.1.21"    // secretary
+	LDAPOtherMailbox                        = "0.9.2342.19200300.100.1.22"    // otherMailbox
+	LDAPDc                                  = "0.9.2342.19200300.100.1.25"    // dc, domainComponent
+	LDAPARecord                             = "0.9.2342.19200300.100.1.26"    // aRecord
+	LDAPAssociatedDomain                    = "0.9.2342.19200300.100.1.37"    // associatedDomain
+	LDAPAssociatedName                      = "0.9.2342.19200300.100.1.38"    // associatedName
+	LDAPHomePostalAddress                   = "0.9.2342.19200300.100.1.39"    // homePostalAddress
+	LDAPPersonalTitle                       = "0.9.2342.19200300.100.1.40"    // personalTitle
+	LDAPMobile                              = "0.9.2342.19200300.100.1.41"    // mobile, mobileTelephoneNumber
+	LDAPPager                               = "0.9.2342.19200300.100.1.42"    // pager, pagerTelephoneNumber
+	LDAPCo                                  = "0.9.2342.19200300.100.1.43"    // co, friendlyCountryName
+	LDAPUniqueIdentifier                    = "0.9.2342.19200300.100.1.44"    // uniqueIdentifier
+	LDAPOrganizationalStatus                = "0.9.2342.19200300.100.1.45"    // organizationalStatus
+	LDAPBuildingName                        = "0.9.2342.19200300.100.1.48"    // buildingName
+	LDAPAudio                               = "0.9.2342.19200300.100.1.55"    // audio
+	LDAPDocumentPublisher                   = "0.9.2342.19200300.100.1.56"    // documentPublisher
+	LDAPJpegPhoto                           = "0.9.2342.19200300.100.1.60"    // jpegPhoto
+	LDAPAccount                             = "0.9.2342.19200300.100.4.3"     // account
+	LDAPDocument                            = "0.9.2342.19200300.100.4.4"     // document
+	LDAPRoom                                = "0.9.2342.19200300.100.4.5"     // room
+	LDAPDocumentSeries                      = "0.9.2342.19200300.100.4.6"     // documentSeries
+	LDAPDomain                              = "0.9.2342.19200300.100.4.13"    // domain
+	LDAPRFC822localPart                     = "0.9.2342.19200300.100.4.14"    // rFC822localPart
+	LDAPDNSDomain                           = "0.9.2342.19200300.100.4.15"    // dNSDomain
+	LDAPDomainRelatedObject                 = "0.9.2342.19200300.100.4.17"    // domainRelatedObject
+	LDAPFriendlyCountry                     = "0.9.2342.19200300.100.4.18"    // friendlyCountry
+	LDAPSimpleSecurityObject                = "0.9.2342.19200300.100.4.19"    // simpleSecurityObject
+	LDAPPilotOrganization                   = "0.9.2342.19200300.100.4.20"    // pilotOrganization
+	LDAPPilotDSA                            = "0.9.2342.19200300.100.4.21"    // pilotDSA
+	LDAPDirectory                           = "1.3.6.1.1"                     // directory
+	LDAPX509CertificateExactAssertion       = "1.3.6.1.1.15.1"                // X.509 Certificate Exact Assertion
+	LDAPX509CertificateAssertion            = "1.3.6.1.1.15.2"                // X.509 Certificate Assertion
+	LDAPX509CertificatePairExactAssertion   = "1.3.6.1.1.15.3"                // X.509 Certificate Pair Exact Assertion
+	LDAPX509CertificatePairAssertion        = "1.3.6.1.1.15.4"                // X.509 Certificate Pair Assertion
+	LDAPX509CertificateListExactAssertion   = "1.3.6.1.1.15.5"                // X.509 Certificate List Exact Assertion
+	LDAPX509CertificateListAssertion        = "1.3.6.1.1.15.6"                // X.509 Certificate List Assertion
+	LDAPX509AlgorithmIdentifier             = "1.3.6.1.1.15.7"                // X.509 Algorithm Identifier
+	LDAPUUID                                = "1.3.6.1.1.16.1"                // UUID
+	LDAPUuidMatch                           = "1.3.6.1.1.16.2"                // uuidMatch
+	LDAPUuidOrderingMatch                   = "1.3.6.1.1.16.3"                // uuidOrderingMatch
+	LDAPCaseExactIA5Match                   = "1.3.6.1.4.1.1466.109.114.1"    // caseExactIA5Match
+	LDAPCaseIgnoreIA5Match                  = "1.3.6.1.4.1.1466.109.114.2"    // caseIgnoreIA5Match
+	LDAPCaseIgnoreIA5SubstringsMatch        = "1.3.6.1.4.1.1466.109.114.3"    // caseIgnoreIA5SubstringsMatch
+	LDAPSyntaxACIItem                       = "1.3.6.1.4.1.1466.115.121.1.1"  // ACI Item
+	LDAPSyntaxAccessPoint                   = "1.3.6.1.4.1.1466.115.121.1.2"  // Access Point
+	LDAPSyntaxAttributeTypeDescription      = "1.3.6.1.4.1.1466.115.121.1.3"  // Attribute Type Description
+	LDAPSyntaxAudio                         = "1.3.6.1.4.1.1466.115.121.1.4"  // Audio
+	LDAPSyntaxBinary                        = "1.3.6.1.4.1.1466.115.121.1.5"  // Binary
+	LDAPSyntaxBitString                     = "1.3.6.1.4.1.1466.115.121.1.6"  // Bit String
+	LDAPSyntaxBoolean                       = "1.3.6.1.4.1.1466.115.121.1.7"  // Boolean
+	LDAPSyntaxCertificate                   = "1.3.6.1.4.1.1466.115.121.1.8"  // Certificate
+	LDAPSyntaxCertificateList               = "1.3.6.1.4.1.1466.115.121.1.9"  // Certificate List
+	LDAPSyntaxCertificatePair               = "1.3.6.1.4.1.1466.115.121.1.10" // Certificate Pair
+	LDAPSyntaxCountryString                 = "1.3.6.1.4.1.1466.115.121.1.11" // Country String
+	LDAPSyntaxDN                            = "1.3.6.1.4.1.1466.115.121.1.12" // DN
+	LDAPSyntaxDataQualitySyntax             = "1.3.6.1.4.1.1466.115.121.1.13" // Data Quality Syntax
+	LDAPSyntaxDeliveryMethod                = "1.3.6.1.4.1.1466.115.121.1.14" // Delivery Method
+	LDAPSyntaxDirectoryString               = "1.3.6.1.4.1.1466.115.121.1.15" // Directory String
+	LDAPSyntaxDITContentRuleDescription     = "1.3.6.1.4.1.1466.115.121.1.16" // DIT Content Rule Description
+	LDAPSyntaxDITStructureRuleDescription   = "1.3.6.1.4.1.1466.115.121.1.17" // DIT Structure Rule Description
+	LDAPSyntaxDLSubmitPermission            = "1.3.6.1.4.1.1466.115.121.1.18" // DL Submit Permission
+	LDAPSyntaxDSAQualitySyntax              = "1.3.6.1.4.1.1466.115.121.1.19" // DSA Quality Syntax
+	LDAPSyntaxDSEType                       = "1.3.6.1.4.1.1466.115.121.1.20" // DSE Type
+	LDAPSyntaxEnhancedGuide                 = "1.3.6.1.4.1.1466.115.121.1.21" // Enhanced Guide
+	LDAPSyntaxFacsimileTelephoneNumber      = "1.3.6.1.4.1.1466.115.121.1.22" // Facsimile Telephone Number
+	LDAPSyntaxFax                           = "1.3.6.1.4.1.1466.115.121.1.23" // Fax
+	LDAPSyntaxGeneralizedTime               = "1.3.6.1.4.1.1466.115.121.1.24" // Generalized Time
+	LDAPSyntaxGuide                         = "1.3.6.1.4.1.1466.115.121.1.25" // Guide
+	LDAPSyntaxIA5String                     = "1.3.6.1.4.1.1466.115.121.1.26" // IA5 String
+	LDAPSyntaxINTEGER                       = "1.3.6.1.4.1.1466.115.121.1.27" // INTEGER
+	LDAPSyntaxJPEG                          = "1.3.6.1.4.1.1466.115.121.1.28" // JPEG
+	LDAPSyntaxMasterAndShadowAccessPoints   = "1.3.6.1.4.1.1466.115.121.1.29" // Master And Shadow Access Points
+	LDAPSyntaxMatchingRuleDescription       = "1.3.6.1.4.1.1466.115.121.1.30" // Matching Rule Description
+	LDAPSyntaxMatchingRuleUseDescription    = "1.3.6.1.4.1.1466.115.121.1.31" // Matching Rule Use Description
+	LDAPSyntaxMailPreference                = "1.3.6.1.4.1.1466.115.121.1.32" // Mail Preference
+	LDAPSyntaxMHSORAddress                  = "1.3.6.1.4.1.1466.115.121.1.33" // MHS OR Address
+	LDAPSyntaxNameAndOptionalUID            = "1.3.6.1.4.1.1466.115.121.1.34" // Name And Optional UID
+	LDAPSyntaxNameFormDescription           = "1.3.6.1.4.1.1466.115.121.1.35" // Name Form Description
+	LDAPSyntaxNumericString                 = "1.3.6.1.4.1.1466.115.121.1.36" // Numeric String
+	LDAPSyntaxObjectClassDescription        = "1.3.6.1.4.1.1466.115.121.1.37" // Object Class Description
+	LDAPSyntaxOID                           = "1.3.6.1.4.1.1466.115.121.1.38" // OID
+	LDAPSyntaxOtherMailbox                  = "1.3.6.1.4.1.1466.115.121.1.39" // Other Mailbox
+	LDAPSyntaxOctetString                   = "1.3.6.1.4.1.1466.115.121.1.40" // Octet String
+	LDAPSyntaxPostalAddress                 = "1.3.6.1.4.1.1466.115.121.1.41" // Postal Address
+	LDAPSyntaxProtocolInformation           = "1.3.6.1.4.1.1466.115.121.1.42" // Protocol Information
+	LDAPSyntaxPresentationAddress           = "1.3.6.1.4.1.1466.115.121.1.43" // Presentation Address
+	LDAPSyntaxPrintableString               = "1.3.6.1.4.1.1466.115.121.1.44" // Printable String
+	LDAPSyntaxSubtreeSpecification          = "1.3.6.1.4.1.1466.115.121.1.45" // Subtree Specification
+	LDAPSyntaxSupplierInformation           = "1.3.6.1.4.1.1466.115.121.1.46" // Supplier Information
+	LDAPSyntaxSupplierOrConsumer            = "1.3.6.1.4.1.1466.115.121.1.47" // Supplier Or Consumer
+	LDAPSyntaxSupplierAndConsumer           = "1.3.6.1.4.1.1466.115.121.1.48" // Supplier And Consumer
+	LDAPSyntaxSupportedAlgorithm            = "1.3.6.1.4.1.1466.115.121.1.49" // Supported Algorithm
+	LDAPSyntaxTelephoneNumber               = "1.3.6.1.4.1.1466.115.121.1.50" // Telephone Number
+	LDAPSyntaxTeletexTerminalIdentifier     = "1.3.6.1.4.1.1466.115.121.1.51" // Teletex Terminal Identifier
+	LDAPSyntaxTelexNumber                   = "1.3.6.1.4.1.1466.115.121.1.52" // Telex Number
+	LDAPSyntaxUTCTime                       = "1.3.6.1.4.1.1466.115.121.1.53" // UTC Time
+	LDAPSyntaxLDAPSyntaxDescription         = "1.3.6.1.4.1.1466.115.121.1.54" // LDAP Syntax Description
+	LDAPSyntaxModifyRights                  = "1.3.6.1.4.1.1466.115.121.1.55" // Modify Rights
+	LDAPSyntaxLDAPSchemaDefinition          = "1.3.6.1.4.1.1466.115.121.1.56" // LDAP Schema Definition
+	LDAPSyntaxLDAPSchemaDescription         = "1.3.6.1.4.1.1466.115.121.1.57" // LDAP Schema Description
+	LDAPSyntaxSubstringAssertion            = "1.3.6.1.4.1.1466.115.121.1.58" // Substring Assertion
+	LDAPObjectClass                         = "2.5.6"                         // objectClass, id-oc
+	LDAPTop                                 = "2.5.6.0"                       // top
+	LDAPAlias                               = "2.5.6.1"                       // alias
+	LDAPCountry                             = "2.5.6.2"                       // country
+	LDAPLocality                            = "2.5.6.3"                       // locality
+	LDAPOrganization                        = "2.5.6.4"                       // organization
+	LDAPOrganizationalUnit                  = "2.5.6.5"                       // organizationalUnit
+	LDAPPerson                              = "2.5.6.6"                       // person
+	LDAPOrganizationalPerson                = "2.5.6.7"                       // organizationalPerson
+	LDAPOrganizationalRole                  = "2.5.6.8"                       // organizationalRole
+	LDAPGroupOfNames                        = "2.5.6.9"                       // groupOfNames
+	LDAPResidentialPerson                   = "2.5.6.10"                      // residentialPerson
+	LDAPApplicationProcess                  = "2.5.6.11"                      // applicationProcess
+	LDAPApplicationEntity                   = "2.5.6.12"                      // applicationEntity
+	LDAPDSA                                 = "2.5.6.13"                      // dSA
+	LDAPDevice                              = "2.5.6.14"                      // device
+	LDAPStrongAuthenticationUser            = "2.5.6.15"                      // strongAuthenticationUser
+	LDAPCertificationAuthority              = "2.5.6.16"                      // certificationAuthority
+	LDAPGroupOfUniqueNames                  = "2.5.6.17"                      // groupOfUniqueNames
+	LDAPUserSecurityInformation             = "2.5.6.18"                      // userSecurityInformation
+	LDAPCRLDistributionPoint                = "2.5.6.19"                      // cRLDistributionPoint
+	LDAPObjectIdentifierMatch               = "2.5.13.0"                      // objectIdentifierMatch
+	LDAPDistinguishedNameMatch              = "2.5.13.1"                      // distinguishedNameMatch
+	LDAPCaseIgnoreMatch                     = "2.5.13.2"                      // caseIgnoreMatch
+	LDAPCaseIgnoreOrderingMatch             = "2.5.13.3"                      // caseIgnoreOrderingMatch
+	LDAPCaseIgnoreSubstringsMatch           = "2.5.13.4"                      // caseIgnoreSubstringsMatch
+	LDAPCaseExactMatch                      = "2.5.13.5"                      // caseExactMatch
+	LDAPCaseExactOrderingMatch              = "2.5.13.6"                      // caseExactOrderingMatch
+	LDAPCaseExactSubstringsMatch            = "2.5.13.7"                      // caseExactSubstringsMatch
+	LDAPNumericStringMatch                  = "2.5.13.8"                      // numericStringMatch
+	LDAPNumericStringOrderingMatch          = "2.5.13.9"                      // numericStringOrderingMatch
+	LDAPNumericStringSubstringsMatch        = "2.5.13.10"                     // numericStringSubstringsMatch
+	LDAPCaseIgnoreListMatch                 = "2.5.13.11"                     // caseIgnoreListMatch
+	LDAPCaseIgnoreListSubstringsMatch       = "2.5.13.12"                     // caseIgnoreListSubstringsMatch
+	LDAPBooleanMatch                        = "2.5.13.13"                     // booleanMatch
+	LDAPIntegerMatch                        = "2.5.13.14"                     // integerMatch
+	LDAPIntegerOrderingMatch                = "2.5.13.15"                     // integerOrderingMatch
+	LDAPBitStringMatch                      = "2.5.13.16"                     // bitStringMatch
+	LDAPOctetStringMatch                    = "2.5.13.17"                     // octetStringMatch
+	LDAPOctetStringOrderingMatch            = "2.5.13.18"                     // octetStringOrderingMatch
+	LDAPTelephoneNumberMatch                = "2.5.13.20"                     // telephoneNumberMatch
+	LDAPTelephoneNumberSubstringsMatch      = "2.5.13.21"                     // telephoneNumberSubstringsMatch
+	LDAPUniqueMemberMatch                   = "2.5.13.23"                     // uniqueMemberMatch
+	LDAPGeneralizedTimeMatch                = "2.5.13.27"                     // generalizedTimeMatch
+	LDAPGeneralizedTimeOrderingMatch        = "2.5.13.28"                     // generalizedTimeOrderingMatch
+	LDAPIntegerFirstComponentMatch          = "2.5.13.29"                     // integerFirstComponentMatch
+	LDAPObjectIdentifierFirstComponentMatch = "2.5.13.30"                     // objectIdentifierFirstComponentMatch
+	LDAPDirectoryStringFirstComponentMatch  = "2.5.13.31"                     // directoryStringFirstComponentMatch
+	LDAPWordMatch                           = "2.5.13.32"                     // wordMatch
+	LDAPKeywordMatch                        = "2.5.13.33"                     // keywordMatch
+	LDAPCertificateExactMatch               = "2.5.13.34"                     // certificateExactMatch
+	LDAPCertificateMatch                    = "2.5.13.35"                     // certificateMatch
+	LDAPCertificatePairExactMatch           = "2.5.13.36"                     // certificatePairExactMatch
+	LDAPCertificatePairMatch                = "2.5.13.37"                     // certificatePairMatch
+	LDAPCertificateListExactMatch           = "2.5.13.38"                     // certificateListExactMatch
+	LDAPCertificateListMatch                = "2.5.13.39"                     // certificateListMatch
+	LDAPAlgorithmIdentifierMatch            = "2.5.13.40"                     // algorithmIdentifierMatch
+)
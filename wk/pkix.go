@@ -0,0 +1,44 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package wk
+
+/*
+Internet X.509 PKI (RFC 5280 and related)
+*/
+const (
+	PKIXPkix                   = "1.3.6.1.5.5.7"        // pkix
+	PKIXPe                     = "1.3.6.1.5.5.7.1"      // id-pe
+	PKIXPeAuthorityInfoAccess  = "1.3.6.1.5.5.7.1.1"    // id-pe-authorityInfoAccess, authorityInfoAccess
+	PKIXPeQcStatements         = "1.3.6.1.5.5.7.1.3"    // id-pe-qcStatements, qcStatements
+	PKIXPeSubjectInfoAccess    = "1.3.6.1.5.5.7.1.11"   // id-pe-subjectInfoAccess, subjectInfoAccess
+	PKIXPeTlsfeature           = "1.3.6.1.5.5.7.1.24"   // id-pe-tlsfeature, tlsFeature
+	PKIXQt                     = "1.3.6.1.5.5.7.2"      // id-qt
+	PKIXQtCps                  = "1.3.6.1.5.5.7.2.1"    // id-qt-cps, cps
+	PKIXQtUnotice              = "1.3.6.1.5.5.7.2.2"    // id-qt-unotice, unotice
+	PKIXKp                     = "1.3.6.1.5.5.7.3"      // id-kp
+	PKIXKpServerAuth           = "1.3.6.1.5.5.7.3.1"    // id-kp-serverAuth, serverAuth
+	PKIXKpClientAuth           = "1.3.6.1.5.5.7.3.2"    // id-kp-clientAuth, clientAuth
+	PKIXKpCodeSigning          = "1.3.6.1.5.5.7.3.3"    // id-kp-codeSigning, codeSigning
+	PKIXKpEmailProtection      = "1.3.6.1.5.5.7.3.4"    // id-kp-emailProtection, emailProtection
+	PKIXKpTimeStamping         = "1.3.6.1.5.5.7.3.8"    // id-kp-timeStamping, timeStamping
+	PKIXKpOCSPSigning          = "1.3.6.1.5.5.7.3.9"    // id-kp-OCSPSigning, OCSPSigning
+	PKIXKpIpsecIKE             = "1.3.6.1.5.5.7.3.17"   // id-kp-ipsecIKE, ipsecIKE
+	PKIXKpSecureShellClient    = "1.3.6.1.5.5.7.3.28"   // id-kp-secureShellClient, secureShellClient
+	PKIXKpSecureShellServer    = "1.3.6.1.5.5.7.3.29"   // id-kp-secureShellServer, secureShellServer
+	PKIXOn                     = "1.3.6.1.5.5.7.8"      // id-on
+	PKIXOnHardwareModuleName   = "1.3.6.1.5.5.7.8.4"    // id-on-hardwareModuleName
+	PKIXAd                     = "1.3.6.1.5.5.7.48"     // id-ad
+	PKIXAdOcsp                 = "1.3.6.1.5.5.7.48.1"   // id-ad-ocsp, ocsp, id-pkix-ocsp
+	PKIXPkixOcspBasic          = "1.3.6.1.5.5.7.48.1.1" // id-pkix-ocsp-basic, ocspBasic
+	PKIXPkixOcspNonce          = "1.3.6.1.5.5.7.48.1.2" // id-pkix-ocsp-nonce, ocspNonce
+	PKIXPkixOcspCrl            = "1.3.6.1.5.5.7.48.1.3" // id-pkix-ocsp-crl, ocspCRL
+	PKIXPkixOcspResponse       = "1.3.6.1.5.5.7.48.1.4" // id-pkix-ocsp-response, ocspResponse
+	PKIXPkixOcspNocheck        = "1.3.6.1.5.5.7.48.1.5" // id-pkix-ocsp-nocheck, ocspNoCheck
+	PKIXPkixOcspArchiveCutoff  = "1.3.6.1.5.5.7.48.1.6" // id-pkix-ocsp-archive-cutoff, ocspArchiveCutoff
+	PKIXPkixOcspServiceLocator = "1.3.6.1.5.5.7.48.1.7" // id-pkix-ocsp-service-locator, ocspServiceLocator
+	PKIXPkixOcspPrefSigAlgs    = "1.3.6.1.5.5.7.48.1.8" // id-pkix-ocsp-pref-sig-algs, ocspPrefSigAlgs
+	PKIXPkixOcspExtendedRevoke = "1.3.6.1.5.5.7.48.1.9" // id-pkix-ocsp-extended-revoke, ocspExtendedRevoke
+	PKIXAdCaIssuers            = "1.3.6.1.5.5.7.48.2"   // id-ad-caIssuers, caIssuers
+	PKIXAdTimeStamping         = "1.3.6.1.5.5.7.48.3"   // id-ad-timeStamping, adTimeStamping
+	PKIXAdCaRepository         = "1.3.6.1.5.5.7.48.5"   // id-ad-caRepository, caRepository
+)
@@ -0,0 +1,33 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package wk
+
+/*
+SNMP management information (RFC 1213 and RFC 3411)
+*/
+const (
+	SNMPMgmt         = "1.3.6.1.2"       // mgmt
+	SNMPMib2         = "1.3.6.1.2.1"     // mib-2
+	SNMPSystem       = "1.3.6.1.2.1.1"   // system
+	SNMPSysDescr     = "1.3.6.1.2.1.1.1" // sysDescr
+	SNMPSysObjectID  = "1.3.6.1.2.1.1.2" // sysObjectID
+	SNMPSysUpTime    = "1.3.6.1.2.1.1.3" // sysUpTime
+	SNMPSysContact   = "1.3.6.1.2.1.1.4" // sysContact
+	SNMPSysName      = "1.3.6.1.2.1.1.5" // sysName
+	SNMPSysLocation  = "1.3.6.1.2.1.1.6" // sysLocation
+	SNMPSysServices  = "1.3.6.1.2.1.1.7" // sysServices
+	SNMPInterfaces   = "1.3.6.1.2.1.2"   // interfaces
+	SNMPAt           = "1.3.6.1.2.1.3"   // at
+	SNMPIp           = "1.3.6.1.2.1.4"   // ip
+	SNMPIcmp         = "1.3.6.1.2.1.5"   // icmp
+	SNMPTcp          = "1.3.6.1.2.1.6"   // tcp
+	SNMPUdp          = "1.3.6.1.2.1.7"   // udp
+	SNMPEgp          = "1.3.6.1.2.1.8"   // egp
+	SNMPTransmission = "1.3.6.1.2.1.10"  // transmission
+	SNMPSnmp         = "1.3.6.1.2.1.11"  // snmp
+	SNMPIfMIB        = "1.3.6.1.2.1.31"  // ifMIB
+	SNMPSnmpV2       = "1.3.6.1.6"       // snmpV2
+	SNMPSnmpDomains  = "1.3.6.1.6.1"     // snmpDomains
+	SNMPSnmpProxys   = "1.3.6.1.6.2"     // snmpProxys
+	SNMPSnmpModules  = "1.3.6.1.6.3"     // snmpModules
+)
@@ -0,0 +1,50 @@
+package wk
+
+import (
+	"testing"
+
+	"github.com/JesseCoretta/go-oid"
+)
+
+/*
+TestConstants verifies that a selection of constants from each domain bear valid OIDs known to package oid by the names cited in their comments, such that a regeneration which shifts or mislabels constants is caught.
+*/
+func TestConstants(t *testing.T) {
+	known := make(map[string][]string)
+	for _, c := range oid.GoldenCorpus() {
+		known[c.Dot] = append(known[c.Dot], c.Names...)
+	}
+	for _, o := range oid.WellKnown() {
+		known[o.DotNotation()] = append(known[o.DotNotation()], append([]string{o.Name()}, o.AltNames()...)...)
+	}
+
+	for _, tc := range []struct {
+		dot  string
+		name string
+	}{
+		{X520CommonName, `commonName`},
+		{X520OrganizationName, `organizationName`},
+		{PKIXPkix, `pkix`},
+		{PKIXPeAuthorityInfoAccess, `authorityInfoAccess`},
+		{SNMPMib2, `mib-2`},
+		{SNMPSysDescr, `sysDescr`},
+		{LDAPUid, `uid`},
+		{LDAPMail, `mail`},
+		{CMSData, `data`},
+		{CMSSignedData, `signedData`},
+	} {
+		o, err := oid.NewFromDot(tc.dot)
+		if err != nil {
+			t.Errorf("%s: %v", tc.dot, err)
+			continue
+		}
+
+		found := false
+		for _, name := range known[o.DotNotation()] {
+			found = found || name == tc.name
+		}
+		if !found {
+			t.Errorf("%s: got names %v, want %s", tc.dot, known[tc.dot], tc.name)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package wk
+
+/*
+X.520 selected attribute types
+*/
+const (
+	X520AttributeType               = "2.5.4"    // attributeType, id-at
+	X520ObjectClass                 = "2.5.4.0"  // objectClass
+	X520AliasedEntryName            = "2.5.4.1"  // aliasedEntryName
+	X520KnowledgeInformation        = "2.5.4.2"  // knowledgeInformation
+	X520CommonName                  = "2.5.4.3"  // commonName, cn, id-at-commonName
+	X520Surname                     = "2.5.4.4"  // surname, sn, id-at-surname
+	X520SerialNumber                = "2.5.4.5"  // serialNumber, id-at-serialNumber
+	X520CountryName                 = "2.5.4.6"  // countryName, c, id-at-countryName
+	X520LocalityName                = "2.5.4.7"  // localityName, l, id-at-localityName
+	X520StateOrProvinceName         = "2.5.4.8"  // stateOrProvinceName, st, id-at-stateOrProvinceName
+	X520StreetAddress               = "2.5.4.9"  // streetAddress, street, id-at-streetAddress
+	X520OrganizationName            = "2.5.4.10" // organizationName, o, id-at-organizationName
+	X520OrganizationalUnitName      = "2.5.4.11" // organizationalUnitName, ou, id-at-organizationalUnitName
+	X520Title                       = "2.5.4.12" // title, id-at-title
+	X520Description                 = "2.5.4.13" // description
+	X520SearchGuide                 = "2.5.4.14" // searchGuide
+	X520BusinessCategory            = "2.5.4.15" // businessCategory
+	X520PostalAddress               = "2.5.4.16" // postalAddress
+	X520PostalCode                  = "2.5.4.17" // postalCode, id-at-postalCode
+	X520PostOfficeBox               = "2.5.4.18" // postOfficeBox
+	X520PhysicalDeliveryOfficeName  = "2.5.4.19" // physicalDeliveryOfficeName
+	X520TelephoneNumber             = "2.5.4.20" // telephoneNumber
+	X520TelexNumber                 = "2.5.4.21" // telexNumber
+	X520TeletexTerminalIdentifier   = "2.5.4.22" // teletexTerminalIdentifier
+	X520FacsimileTelephoneNumber    = "2.5.4.23" // facsimileTelephoneNumber
+	X520X121Address                 = "2.5.4.24" // x121Address
+	X520InternationalISDNNumber     = "2.5.4.25" // internationalISDNNumber
+	X520RegisteredAddress           = "2.5.4.26" // registeredAddress
+	X520DestinationIndicator        = "2.5.4.27" // destinationIndicator
+	X520PreferredDeliveryMethod     = "2.5.4.28" // preferredDeliveryMethod
+	X520PresentationAddress         = "2.5.4.29" // presentationAddress
+	X520SupportedApplicationContext = "2.5.4.30" // supportedApplicationContext
+	X520Member                      = "2.5.4.31" // member
+	X520Owner                       = "2.5.4.32" // owner
+	X520RoleOccupant                = "2.5.4.33" // roleOccupant
+	X520SeeAlso                     = "2.5.4.34" // seeAlso
+	X520UserPassword                = "2.5.4.35" // userPassword
+	X520UserCertificate             = "2.5.4.36" // userCertificate
+	X520CACertificate               = "2.5.4.37" // cACertificate
+	X520AuthorityRevocationList     = "2.5.4.38" // authorityRevocationList
+	X520CertificateRevocationList   = "2.5.4.39" // certificateRevocationList
+	X520CrossCertificatePair        = "2.5.4.40" // crossCertificatePair
+	X520Name                        = "2.5.4.41" // name
+	X520GivenName                   = "2.5.4.42" // givenName, gn, id-at-givenName
+	X520Initials                    = "2.5.4.43" // initials, id-at-initials
+	X520GenerationQualifier         = "2.5.4.44" // generationQualifier, id-at-generationQualifier
+	X520X500UniqueIdentifier        = "2.5.4.45" // x500UniqueIdentifier
+	X520DnQualifier                 = "2.5.4.46" // dnQualifier, id-at-dnQualifier
+	X520EnhancedSearchGuide         = "2.5.4.47" // enhancedSearchGuide
+	X520ProtocolInformation         = "2.5.4.48" // protocolInformation
+	X520DistinguishedName           = "2.5.4.49" // distinguishedName
+	X520UniqueMember                = "2.5.4.50" // uniqueMember
+	X520HouseIdentifier             = "2.5.4.51" // houseIdentifier
+	X520SupportedAlgorithms         = "2.5.4.52" // supportedAlgorithms
+	X520DeltaRevocationList         = "2.5.4.53" // deltaRevocationList
+	X520Pseudonym                   = "2.5.4.65" // pseudonym, id-at-pseudonym
+	X520OrganizationIdentifier      = "2.5.4.97" // organizationIdentifier
+)
@@ -0,0 +1,151 @@
+package oid
+
+/*
+x520.go contains accessors for the X.520 and RFC 4519 attribute types commonly found within distinguished names, which reside within the "x520" table of wellknown.tsv.
+*/
+
+/*
+x520 returns the shared well-known *ObjectIdentifier registered for dot, or nil if DisableEmbeddedTables was called beforehand.
+*/
+func x520(dot string) *ObjectIdentifier {
+	return WellKnown()[dot]
+}
+
+/*
+CN returns the shared well-known *ObjectIdentifier of the commonName (cn) attribute type (2.5.4.3), allowing DN-building code to read naturally, e.g.:
+
+	atv := pkix.AttributeTypeAndValue{Type: oid.CN().ASN1(), Value: `Example`}
+
+The principal name of each attribute type returned by these accessors is its LDAP short name, e.g. "cn", while its long name and ASN.1 value reference, e.g. "commonName" and "id-at-commonName", are available as alt names. The return value is shared with the WellKnown database, and must not be modified.
+
+Each of these accessors returns nil should DisableEmbeddedTables have been called before the WellKnown database was first used, as the attribute types are drawn from it. Programs which disable the embedded tables should construct the attribute types they need, e.g. using NewFromDot.
+*/
+func CN() *ObjectIdentifier {
+	return x520(`2.5.4.3`)
+}
+
+/*
+SN returns the shared well-known *ObjectIdentifier of the surname (sn) attribute type (2.5.4.4). See CN.
+*/
+func SN() *ObjectIdentifier {
+	return x520(`2.5.4.4`)
+}
+
+/*
+SerialNumber returns the shared well-known *ObjectIdentifier of the serialNumber attribute type (2.5.4.5). See CN.
+*/
+func SerialNumber() *ObjectIdentifier {
+	return x520(`2.5.4.5`)
+}
+
+/*
+C returns the shared well-known *ObjectIdentifier of the countryName (c) attribute type (2.5.4.6). See CN.
+*/
+func C() *ObjectIdentifier {
+	return x520(`2.5.4.6`)
+}
+
+/*
+L returns the shared well-known *ObjectIdentifier of the localityName (l) attribute type (2.5.4.7). See CN.
+*/
+func L() *ObjectIdentifier {
+	return x520(`2.5.4.7`)
+}
+
+/*
+ST returns the shared well-known *ObjectIdentifier of the stateOrProvinceName (st) attribute type (2.5.4.8). See CN.
+*/
+func ST() *ObjectIdentifier {
+	return x520(`2.5.4.8`)
+}
+
+/*
+Street returns the shared well-known *ObjectIdentifier of the streetAddress (street) attribute type (2.5.4.9). See CN.
+*/
+func Street() *ObjectIdentifier {
+	return x520(`2.5.4.9`)
+}
+
+/*
+O returns the shared well-known *ObjectIdentifier of the organizationName (o) attribute type (2.5.4.10). See CN.
+*/
+func O() *ObjectIdentifier {
+	return x520(`2.5.4.10`)
+}
+
+/*
+OU returns the shared well-known *ObjectIdentifier of the organizationalUnitName (ou) attribute type (2.5.4.11). See CN.
+*/
+func OU() *ObjectIdentifier {
+	return x520(`2.5.4.11`)
+}
+
+/*
+Title returns the shared well-known *ObjectIdentifier of the title attribute type (2.5.4.12). See CN.
+*/
+func Title() *ObjectIdentifier {
+	return x520(`2.5.4.12`)
+}
+
+/*
+PostalCode returns the shared well-known *ObjectIdentifier of the postalCode attribute type (2.5.4.17). See CN.
+*/
+func PostalCode() *ObjectIdentifier {
+	return x520(`2.5.4.17`)
+}
+
+/*
+GivenName returns the shared well-known *ObjectIdentifier of the givenName attribute type (2.5.4.42). See CN.
+*/
+func GivenName() *ObjectIdentifier {
+	return x520(`2.5.4.42`)
+}
+
+/*
+Initials returns the shared well-known *ObjectIdentifier of the initials attribute type (2.5.4.43). See CN.
+*/
+func Initials() *ObjectIdentifier {
+	return x520(`2.5.4.43`)
+}
+
+/*
+GenerationQualifier returns the shared well-known *ObjectIdentifier of the generationQualifier attribute type (2.5.4.44). See CN.
+*/
+func GenerationQualifier() *ObjectIdentifier {
+	return x520(`2.5.4.44`)
+}
+
+/*
+DNQualifier returns the shared well-known *ObjectIdentifier of the dnQualifier attribute type (2.5.4.46). See CN.
+*/
+func DNQualifier() *ObjectIdentifier {
+	return x520(`2.5.4.46`)
+}
+
+/*
+Pseudonym returns the shared well-known *ObjectIdentifier of the pseudonym attribute type (2.5.4.65). See CN.
+*/
+func Pseudonym() *ObjectIdentifier {
+	return x520(`2.5.4.65`)
+}
+
+/*
+UID returns the shared well-known *ObjectIdentifier of the userid (uid) attribute type (0.9.2342.19200300.100.1.1). See CN.
+*/
+func UID() *ObjectIdentifier {
+	return x520(`0.9.2342.19200300.100.1.1`)
+}
+
+/*
+Mail returns the shared well-known *ObjectIdentifier of the rfc822Mailbox (mail) attribute type (0.9.2342.19200300.100.1.3). See CN.
+*/
+func Mail() *ObjectIdentifier {
+	return x520(`0.9.2342.19200300.100.1.3`)
+}
+
+/*
+DC returns the shared well-known *ObjectIdentifier of the domainComponent (dc) attribute type (0.9.2342.19200300.100.1.25). See CN.
+*/
+func DC() *ObjectIdentifier {
+	return x520(`0.9.2342.19200300.100.1.25`)
+}
@@ -0,0 +1,47 @@
+package oid
+
+import "testing"
+
+func TestX520(t *testing.T) {
+	for _, tc := range []struct {
+		fn   func() *ObjectIdentifier
+		dot  string
+		name string
+		alt  string
+	}{
+		{CN, `2.5.4.3`, `cn`, `commonName`},
+		{SN, `2.5.4.4`, `sn`, `surname`},
+		{SerialNumber, `2.5.4.5`, `serialNumber`, `id-at-serialNumber`},
+		{C, `2.5.4.6`, `c`, `countryName`},
+		{L, `2.5.4.7`, `l`, `localityName`},
+		{ST, `2.5.4.8`, `st`, `stateOrProvinceName`},
+		{Street, `2.5.4.9`, `street`, `streetAddress`},
+		{O, `2.5.4.10`, `o`, `organizationName`},
+		{OU, `2.5.4.11`, `ou`, `organizationalUnitName`},
+		{Title, `2.5.4.12`, `title`, `id-at-title`},
+		{PostalCode, `2.5.4.17`, `postalCode`, `id-at-postalCode`},
+		{GivenName, `2.5.4.42`, `givenName`, `gn`},
+		{Initials, `2.5.4.43`, `initials`, `id-at-initials`},
+		{GenerationQualifier, `2.5.4.44`, `generationQualifier`, `id-at-generationQualifier`},
+		{DNQualifier, `2.5.4.46`, `dnQualifier`, `id-at-dnQualifier`},
+		{Pseudonym, `2.5.4.65`, `pseudonym`, `id-at-pseudonym`},
+		{UID, `0.9.2342.19200300.100.1.1`, `uid`, `userid`},
+		{Mail, `0.9.2342.19200300.100.1.3`, `mail`, `rfc822Mailbox`},
+		{DC, `0.9.2342.19200300.100.1.25`, `dc`, `domainComponent`},
+	} {
+		o := tc.fn()
+		if o.IsZero() || o.DotNotation() != tc.dot {
+			t.Errorf("%s: got %v, want %s", tc.name, o, tc.dot)
+			continue
+		}
+		if n := o.Name(); n != tc.name {
+			t.Errorf("%s: got name %q", tc.dot, n)
+		}
+		if !o.HasAltName(tc.alt) {
+			t.Errorf("%s: lacks alt name %q", tc.dot, tc.alt)
+		}
+		if o != tc.fn() {
+			t.Errorf("%s: instance is not shared", tc.dot)
+		}
+	}
+}